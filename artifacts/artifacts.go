@@ -0,0 +1,225 @@
+package artifacts
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/bitrise-io/replica/vagrantcli"
+)
+
+const (
+	// KindDMG is the kind of the auto-installer DMGs
+	KindDMG = "dmg"
+	// KindBox is the kind of the vagrant boxes
+	KindBox = "box"
+)
+
+// skippedDirNames are the directories of the output directory which never contain artifacts
+var skippedDirNames = map[string]bool{
+	"logs":         true,
+	"packer_cache": true,
+}
+
+// ArtifactModel is a DMG or a vagrant box of the output directory
+type ArtifactModel struct {
+	Kind         string    `json:"kind"`
+	Path         string    `json:"path"`
+	MacOSVersion string    `json:"macos_version,omitempty"`
+	MacOSBuild   string    `json:"macos_build,omitempty"`
+	Size         int64     `json:"size"`
+	CreatedAt    time.Time `json:"created_at"`
+	// Checksum is the SHA-256 checksum of the file, empty if it's not calculated yet
+	Checksum string `json:"sha256,omitempty"`
+	// SourcePath is the artifact this one was created from, e.g. the DMG of a box
+	SourcePath string            `json:"source,omitempty"`
+	Settings   map[string]string `json:"settings,omitempty"`
+	// RegisteredAs is the name of the vagrant box, if the box is registered in vagrant
+	RegisteredAs string `json:"registered_as,omitempty"`
+	// UsedByVMs are the directories of the vagrant VMs created from the box
+	UsedByVMs []string `json:"used_by_vms,omitempty"`
+	// UnverifiedRegistrations are the vagrant boxes (NAME/PROVIDER) the box may be registered as:
+	// no box has a registration for them in its manifest (e.g. they were added with vagrant box add),
+	// so they may have been added from any of the boxes without a registration
+	UnverifiedRegistrations []string `json:"unverified_registrations,omitempty"`
+
+	manifest ManifestModel
+}
+
+// IsInUse returns true if the artifact is a box registered in vagrant or used by a VM
+func (artifact ArtifactModel) IsInUse() bool {
+	return artifact.RegisteredAs != "" || len(artifact.UsedByVMs) > 0 || len(artifact.UnverifiedRegistrations) > 0
+}
+
+// ScanOptions ...
+type ScanOptions struct {
+	// IsSkipChecksum if true the checksums are not calculated, only the cached ones are reported
+	IsSkipChecksum bool
+	// VagrantBoxes are the boxes registered in vagrant
+	VagrantBoxes []vagrantcli.BoxModel
+	// VMs are the vagrant VMs, the boxes they were created from are marked as used
+	VMs []vagrantcli.MachineModel
+}
+
+// Scan returns the DMGs and the vagrant boxes of the output directory.
+// The calculated checksums are cached in the manifests of the artifacts.
+func Scan(outDir string, opts ScanOptions) ([]ArtifactModel, error) {
+	artifacts := []ArtifactModel{}
+	err := filepath.Walk(outDir, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if pth != outDir && (skippedDirNames[info.Name()] || strings.HasPrefix(info.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		kind := ""
+		if _, _, isDMG := ParseDMGFileName(info.Name()); isDMG {
+			kind = KindDMG
+		} else if strings.HasSuffix(info.Name(), ".box") {
+			kind = KindBox
+		} else {
+			return nil
+		}
+
+		artifact, err := artifactOfFile(pth, info, kind, opts)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, artifact)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to scan the output directory (%s), error: %s", outDir, err)
+	}
+
+	setVagrantReferences(artifacts, opts.VagrantBoxes, opts.VMs)
+	// DMGs first, then the boxes, by macOS version and creation time
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		if artifacts[i].Kind != artifacts[j].Kind {
+			return artifacts[i].Kind > artifacts[j].Kind
+		}
+		if cmp := catalog.CompareVersions(artifacts[i].MacOSVersion, artifacts[j].MacOSVersion); cmp != 0 {
+			return cmp < 0
+		}
+		return artifacts[i].CreatedAt.Before(artifacts[j].CreatedAt)
+	})
+	return artifacts, nil
+}
+
+func artifactOfFile(pth string, info os.FileInfo, kind string, opts ScanOptions) (ArtifactModel, error) {
+	manifest, isFound, err := ReadManifest(pth)
+	if err != nil {
+		return ArtifactModel{}, err
+	}
+	if !isFound {
+		manifest = ManifestModel{Kind: kind, CreatedAt: info.ModTime()}
+	}
+
+	artifact := ArtifactModel{
+		Kind:         kind,
+		Path:         pth,
+		MacOSVersion: manifest.MacOSVersion,
+		MacOSBuild:   manifest.MacOSBuild,
+		Size:         info.Size(),
+		CreatedAt:    manifest.CreatedAt,
+		SourcePath:   manifest.SourcePath,
+		Settings:     manifest.Settings,
+		manifest:     manifest,
+	}
+	if artifact.MacOSVersion == "" {
+		// older artifacts have no manifest, the DMG's file name has the version in it
+		fileName := info.Name()
+		if kind == KindBox {
+			fileName = filepath.Base(manifest.SourcePath)
+		}
+		artifact.MacOSVersion, artifact.MacOSBuild, _ = ParseDMGFileName(fileName)
+	}
+
+	if cached, isCached := manifest.CachedChecksum(info.Size(), info.ModTime()); isCached {
+		artifact.Checksum = cached
+	} else if !opts.IsSkipChecksum {
+		checksum, err := catalog.SHA256OfFile(pth)
+		if err != nil {
+			return ArtifactModel{}, err
+		}
+		artifact.Checksum = checksum
+		manifest.Checksum = &ChecksumModel{SHA256: checksum, Size: info.Size(), ModTime: info.ModTime()}
+		if err := WriteManifest(pth, manifest); err != nil {
+			return ArtifactModel{}, err
+		}
+	}
+	return artifact, nil
+}
+
+// setVagrantReferences marks the boxes which are registered in vagrant, and which are used by VMs.
+// vagrant does not know which file a box was added from, so the box registered the latest
+// with the name and the provider of a vagrant box is the registered one. If no box has a registration
+// for a vagrant box, every box without a registration is marked, as any of them may have been added.
+func setVagrantReferences(artifacts []ArtifactModel, vagrantBoxes []vagrantcli.BoxModel, vms []vagrantcli.MachineModel) {
+	latestByBox := map[vagrantcli.BoxModel]int{}
+	for idx, anArtifact := range artifacts {
+		registration := anArtifact.manifest.VagrantBox
+		if anArtifact.Kind != KindBox || registration == nil {
+			continue
+		}
+		box := vagrantcli.BoxModel{Name: registration.Name, Provider: registration.Provider}
+		latestIdx, isFound := latestByBox[box]
+		if !isFound || artifacts[latestIdx].manifest.VagrantBox.RegisteredAt.Before(registration.RegisteredAt) {
+			latestByBox[box] = idx
+		}
+	}
+
+	unverified := map[vagrantcli.BoxModel]bool{}
+	for _, aVagrantBox := range vagrantBoxes {
+		box := vagrantcli.BoxModel{Name: aVagrantBox.Name, Provider: aVagrantBox.Provider}
+		if idx, isFound := latestByBox[box]; isFound {
+			artifacts[idx].RegisteredAs = box.Name
+		} else {
+			unverified[box] = true
+		}
+	}
+	for _, aVM := range vms {
+		box := vagrantcli.BoxModel{Name: aVM.Box.Name, Provider: aVM.Box.Provider}
+		if idx, isFound := latestByBox[box]; isFound {
+			artifacts[idx].UsedByVMs = append(artifacts[idx].UsedByVMs, aVM.Dir)
+		} else if box.Name != "" {
+			unverified[box] = true
+		}
+	}
+
+	unverifiedNames := []string{}
+	for aBox := range unverified {
+		unverifiedNames = append(unverifiedNames, aBox.Name+"/"+aBox.Provider)
+	}
+	sort.Strings(unverifiedNames)
+	if len(unverifiedNames) == 0 {
+		return
+	}
+	for idx, anArtifact := range artifacts {
+		if anArtifact.Kind == KindBox && anArtifact.manifest.VagrantBox == nil {
+			artifacts[idx].UnverifiedRegistrations = unverifiedNames
+		}
+	}
+}
+
+// HumanSize formats the size in bytes, e.g. 8.9 GB
+func HumanSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value, exp := float64(size)/unit, 0
+	for value >= unit && exp < 3 {
+		value /= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", value, "KMGT"[exp])
+}
@@ -0,0 +1,213 @@
+package artifacts
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, pth, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+	require.NoError(t, ioutil.WriteFile(pth, []byte(content), 0644))
+}
+
+func TestParseDMGFileName(t *testing.T) {
+	version, build, isDMG := ParseDMGFileName("OSX_InstallESD_10.12.4_16E195.dmg")
+	require.True(t, isDMG)
+	require.Equal(t, "10.12.4", version)
+	require.Equal(t, "16E195", build)
+
+	for _, aFileName := range []string{"clt.dmg", "OSX_InstallESD_10.12.4_16E195.dmg.tmp", "OSX_InstallESD_.dmg"} {
+		_, _, isDMG := ParseDMGFileName(aFileName)
+		require.False(t, isDMG, aFileName)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	boxPath := filepath.Join(tmpDir, "packer_virtualbox-iso_virtualbox.box")
+	require.Equal(t, filepath.Join(tmpDir, "packer_virtualbox-iso_virtualbox.replica.json"), ManifestPathFor(boxPath))
+
+	t.Log("no manifest")
+	{
+		_, isFound, err := ReadManifest(boxPath)
+		require.NoError(t, err)
+		require.False(t, isFound)
+	}
+
+	t.Log("write and read")
+	{
+		manifest := ManifestModel{
+			Kind:         KindBox,
+			MacOSVersion: "10.12.4",
+			MacOSBuild:   "16E195",
+			CreatedAt:    time.Date(2017, 4, 1, 10, 0, 0, 0, time.UTC),
+			Settings:     map[string]string{"provider": "virtualbox"},
+		}
+		require.NoError(t, WriteManifest(boxPath, manifest))
+		read, isFound, err := ReadManifest(boxPath)
+		require.NoError(t, err)
+		require.True(t, isFound)
+		require.Equal(t, manifest, read)
+
+		registration := VagrantBoxModel{Name: "bitrise-replica-macos", Provider: "virtualbox", RegisteredAt: time.Date(2017, 4, 2, 10, 0, 0, 0, time.UTC)}
+		require.NoError(t, MarkRegistered(boxPath, registration))
+		read, _, err = ReadManifest(boxPath)
+		require.NoError(t, err)
+		manifest.VagrantBox = &registration
+		require.Equal(t, manifest, read)
+	}
+}
+
+func TestScan(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	dmgPath := filepath.Join(tmpDir, "OSX_InstallESD_10.12.4_16E195.dmg")
+	olderDMGPath := filepath.Join(tmpDir, "OSX_InstallESD_10.11.6_15G31.dmg")
+	boxPath := filepath.Join(tmpDir, "packer", "packer_virtualbox-iso_virtualbox.box")
+	otherBoxPath := filepath.Join(tmpDir, "boxes", "old.box")
+	writeFile(t, dmgPath, "dmg")
+	writeFile(t, olderDMGPath, "older dmg")
+	writeFile(t, boxPath, "box")
+	writeFile(t, otherBoxPath, "other box")
+	// not artifacts
+	writeFile(t, filepath.Join(tmpDir, "packer", "clt", "clt.dmg"), "clt")
+	writeFile(t, filepath.Join(tmpDir, "logs", "20170401-100000", "cached.box"), "box")
+
+	createdAt := time.Date(2017, 4, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, WriteManifest(dmgPath, ManifestModel{
+		Kind: KindDMG, MacOSVersion: "10.12.4", MacOSBuild: "16E195", CreatedAt: createdAt,
+		Settings: map[string]string{"profiles": "com.example.wifi"},
+	}))
+	require.NoError(t, WriteManifest(boxPath, ManifestModel{Kind: KindBox, CreatedAt: createdAt, SourcePath: dmgPath}))
+	require.NoError(t, MarkRegistered(otherBoxPath, VagrantBoxModel{Name: "bitrise-replica-macos", Provider: "virtualbox", RegisteredAt: createdAt}))
+	require.NoError(t, MarkRegistered(boxPath, VagrantBoxModel{Name: "bitrise-replica-macos", Provider: "virtualbox", RegisteredAt: createdAt.Add(time.Hour)}))
+
+	t.Log("without checksums")
+	{
+		artifacts, err := Scan(tmpDir, ScanOptions{IsSkipChecksum: true})
+		require.NoError(t, err)
+		paths := []string{}
+		for _, anArtifact := range artifacts {
+			paths = append(paths, anArtifact.Path)
+			require.Equal(t, "", anArtifact.Checksum)
+			require.Equal(t, "", anArtifact.RegisteredAs)
+		}
+		require.Equal(t, []string{olderDMGPath, dmgPath, otherBoxPath, boxPath}, paths)
+
+		require.Equal(t, "10.11.6", artifacts[0].MacOSVersion)
+		require.Equal(t, "15G31", artifacts[0].MacOSBuild)
+		require.Equal(t, int64(9), artifacts[0].Size)
+		require.Equal(t, map[string]string{"profiles": "com.example.wifi"}, artifacts[1].Settings)
+		require.Equal(t, createdAt, artifacts[1].CreatedAt)
+		// the version of the box comes from the name of its DMG
+		require.Equal(t, "10.12.4", artifacts[3].MacOSVersion)
+		require.Equal(t, dmgPath, artifacts[3].SourcePath)
+	}
+
+	t.Log("with checksums, registered in vagrant")
+	{
+		vagrantBoxes := []vagrantcli.BoxModel{{Name: "bitrise-replica-macos", Provider: "virtualbox", Version: "0"}}
+		vms := []vagrantcli.MachineModel{{ID: "0f3c", Name: "default", Dir: "/Users/ci/vm1", Box: vagrantBoxes[0]}}
+		artifacts, err := Scan(tmpDir, ScanOptions{VagrantBoxes: vagrantBoxes, VMs: vms})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(artifacts))
+		// sha256 of "box"
+		require.Equal(t, "26f8567f2569182294c3fa5b9f9cb2270b554eef628b4c149cf82a42888ff4ae", artifacts[3].Checksum)
+		require.Equal(t, "", artifacts[2].RegisteredAs)
+		require.Equal(t, "bitrise-replica-macos", artifacts[3].RegisteredAs)
+		require.Equal(t, []string{"/Users/ci/vm1"}, artifacts[3].UsedByVMs)
+		require.True(t, artifacts[3].IsInUse())
+		require.False(t, artifacts[2].IsInUse())
+
+		manifest, _, err := ReadManifest(boxPath)
+		require.NoError(t, err)
+		require.Equal(t, artifacts[3].Checksum, manifest.Checksum.SHA256)
+
+		// the cached checksum is reported
+		artifacts, err = Scan(tmpDir, ScanOptions{IsSkipChecksum: true})
+		require.NoError(t, err)
+		require.Equal(t, manifest.Checksum.SHA256, artifacts[3].Checksum)
+	}
+
+	t.Log("registered without a manifest registration, e.g. with vagrant box add")
+	{
+		vagrantBoxes := []vagrantcli.BoxModel{{Name: "bitrise-replica-macos", Provider: "virtualbox"}, {Name: "manual", Provider: "vmware_desktop"}}
+		vms := []vagrantcli.MachineModel{{ID: "5a1e", Name: "default", Dir: "/Users/ci/vm2", Box: vagrantcli.BoxModel{Name: "old-box", Provider: "virtualbox"}}}
+		unregisteredBoxPath := filepath.Join(tmpDir, "boxes", "unregistered.box")
+		writeFile(t, unregisteredBoxPath, "unregistered box")
+		defer func() {
+			require.NoError(t, os.Remove(unregisteredBoxPath))
+		}()
+
+		artifacts, err := Scan(tmpDir, ScanOptions{IsSkipChecksum: true, VagrantBoxes: vagrantBoxes, VMs: vms})
+		require.NoError(t, err)
+		require.Equal(t, 5, len(artifacts))
+		// the boxes with a manifest registration are not affected
+		require.Equal(t, otherBoxPath, artifacts[2].Path)
+		require.Equal(t, []string(nil), artifacts[2].UnverifiedRegistrations)
+		require.False(t, artifacts[2].IsInUse())
+		require.Equal(t, "bitrise-replica-macos", artifacts[4].RegisteredAs)
+
+		require.Equal(t, unregisteredBoxPath, artifacts[3].Path)
+		require.Equal(t, []string{"manual/vmware_desktop", "old-box/virtualbox"}, artifacts[3].UnverifiedRegistrations)
+		require.True(t, artifacts[3].IsInUse())
+	}
+
+	t.Log("vmware box, registered with the provider of its metadata.json")
+	{
+		vmwareBoxPath := filepath.Join(tmpDir, "packer", "packer_vmware-iso_vmware.box")
+		writeFile(t, vmwareBoxPath, "vmware box")
+		defer func() {
+			require.NoError(t, os.Remove(vmwareBoxPath))
+			require.NoError(t, os.Remove(ManifestPathFor(vmwareBoxPath)))
+		}()
+		require.NoError(t, MarkRegistered(vmwareBoxPath, VagrantBoxModel{Name: "bitrise-replica-macos", Provider: "vmware_desktop", RegisteredAt: createdAt}))
+
+		vagrantBoxes := []vagrantcli.BoxModel{{Name: "bitrise-replica-macos", Provider: "vmware_desktop", Version: "0"}}
+		vms := []vagrantcli.MachineModel{{ID: "7c2d", Name: "default", Dir: "/Users/ci/vm3", Box: vagrantBoxes[0]}}
+		artifacts, err := Scan(tmpDir, ScanOptions{IsSkipChecksum: true, VagrantBoxes: vagrantBoxes, VMs: vms})
+		require.NoError(t, err)
+
+		byPath := map[string]ArtifactModel{}
+		for _, anArtifact := range artifacts {
+			byPath[anArtifact.Path] = anArtifact
+		}
+		// the virtualbox box of the same name is not registered
+		require.Equal(t, "", byPath[boxPath].RegisteredAs)
+
+		vmwareBox := byPath[vmwareBoxPath]
+		require.Equal(t, "bitrise-replica-macos", vmwareBox.RegisteredAs)
+		require.Equal(t, []string{"/Users/ci/vm3"}, vmwareBox.UsedByVMs)
+		require.True(t, vmwareBox.IsInUse())
+	}
+
+	t.Log("changed file")
+	{
+		writeFile(t, boxPath, "changed box")
+		artifacts, err := Scan(tmpDir, ScanOptions{IsSkipChecksum: true})
+		require.NoError(t, err)
+		require.Equal(t, "", artifacts[3].Checksum)
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	require.Equal(t, "512 B", HumanSize(512))
+	require.Equal(t, "1.5 KB", HumanSize(1536))
+	require.Equal(t, "8.9 GB", HumanSize(9556302233))
+	require.Equal(t, "5.5 GB", HumanSize(5905580032))
+}
@@ -0,0 +1,125 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+const (
+	// ManifestFileExtension is the extension of the manifest file,
+	// which is saved next to the artifact (DMG, vagrant box)
+	ManifestFileExtension = ".replica.json"
+)
+
+// dmgFileNameRegexp matches the file name of the installer DMGs: OSX_InstallESD_<version>_<build>.dmg
+var dmgFileNameRegexp = regexp.MustCompile(`^OSX_InstallESD_([0-9.]+)_([0-9A-Za-z]+)\.dmg$`)
+
+// ChecksumModel is the cached checksum of the artifact,
+// valid while the size and the modification time of the file are the same
+type ChecksumModel struct {
+	SHA256  string    `json:"sha256"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// VagrantBoxModel is a box registered in vagrant
+type VagrantBoxModel struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	// RegisteredAt is the time the artifact was registered, the latest registration
+	// of the same name and provider replaces the previous ones
+	RegisteredAt time.Time `json:"registered_at,omitempty"`
+}
+
+// ManifestModel describes how the artifact was created
+type ManifestModel struct {
+	Kind         string    `json:"kind"`
+	MacOSVersion string    `json:"macos_version,omitempty"`
+	MacOSBuild   string    `json:"macos_build,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	// SourcePath is the artifact this one was created from, e.g. the DMG of a box
+	SourcePath string `json:"source,omitempty"`
+	// Settings are the customization settings of the artifact, e.g. the embedded profiles
+	Settings map[string]string `json:"settings,omitempty"`
+	Checksum *ChecksumModel    `json:"checksum,omitempty"`
+	// VagrantBox is set once the box is registered in vagrant
+	VagrantBox *VagrantBoxModel `json:"vagrant_box,omitempty"`
+}
+
+// CachedChecksum returns the SHA-256 checksum cached in the manifest,
+// if the artifact did not change (size, modification time) since it was calculated
+func (manifest ManifestModel) CachedChecksum(size int64, modTime time.Time) (string, bool) {
+	cached := manifest.Checksum
+	if cached == nil || cached.Size != size || !cached.ModTime.Equal(modTime) {
+		return "", false
+	}
+	return cached.SHA256, true
+}
+
+// ManifestPathFor returns the path of the manifest file
+// which belongs to the artifact at artifactPath
+func ManifestPathFor(artifactPath string) string {
+	return strings.TrimSuffix(artifactPath, filepath.Ext(artifactPath)) + ManifestFileExtension
+}
+
+// ParseDMGFileName returns the macOS version and build of the installer DMG's file name
+func ParseDMGFileName(fileName string) (string, string, bool) {
+	match := dmgFileNameRegexp.FindStringSubmatch(fileName)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+// ReadManifest reads the manifest of the artifact, if it has one
+func ReadManifest(artifactPath string) (ManifestModel, bool, error) {
+	pth := ManifestPathFor(artifactPath)
+	content, err := ioutil.ReadFile(pth)
+	if os.IsNotExist(err) {
+		return ManifestModel{}, false, nil
+	} else if err != nil {
+		return ManifestModel{}, false, fmt.Errorf("Failed to read manifest (%s), error: %s", pth, err)
+	}
+	var manifest ManifestModel
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return ManifestModel{}, false, fmt.Errorf("Failed to parse manifest (%s), error: %s", pth, err)
+	}
+	return manifest, true, nil
+}
+
+// WriteManifest writes the manifest next to the artifact
+func WriteManifest(artifactPath string, manifest ManifestModel) error {
+	pth := ManifestPathFor(artifactPath)
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Failed to serialize manifest, error: %s", err)
+	}
+	if err := fileutil.WriteBytesToFile(pth, content); err != nil {
+		return fmt.Errorf("Failed to write manifest (%s), error: %s", pth, err)
+	}
+	return nil
+}
+
+// MarkRegistered records in the manifest of the box, that it's registered in vagrant
+func MarkRegistered(boxPath string, box VagrantBoxModel) error {
+	manifest, isFound, err := ReadManifest(boxPath)
+	if err != nil {
+		return err
+	}
+	if !isFound {
+		manifest = ManifestModel{Kind: KindBox}
+		if info, err := os.Stat(boxPath); err == nil {
+			manifest.CreatedAt = info.ModTime()
+		}
+	}
+	manifest.VagrantBox = &box
+	return WriteManifest(boxPath, manifest)
+}
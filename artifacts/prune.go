@@ -0,0 +1,169 @@
+package artifacts
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/replica/sshkey"
+)
+
+// PrunePolicyModel selects the artifacts to delete. An artifact is deleted if any of the rules
+// selects it, but the boxes registered in vagrant or used by a VM are always kept.
+type PrunePolicyModel struct {
+	// KeepPerMinorVersion if positive, only the newest N artifacts of every
+	// macOS minor version (e.g. 10.12) are kept, per kind
+	KeepPerMinorVersion int
+	// MaxTotalSize if positive, the oldest artifacts are deleted
+	// until the total size of the kept ones is under this size, in bytes
+	MaxTotalSize int64
+	// MaxAge if positive, the artifacts created before this age are deleted
+	MaxAge time.Duration
+}
+
+// IsEmpty returns true if the policy has no rules
+func (policy PrunePolicyModel) IsEmpty() bool {
+	return policy.KeepPerMinorVersion <= 0 && policy.MaxTotalSize <= 0 && policy.MaxAge <= 0
+}
+
+// PruneCandidateModel is an artifact selected for deletion
+type PruneCandidateModel struct {
+	Artifact ArtifactModel
+	Reason   string
+}
+
+// minorVersion returns the macOS minor version of the version, e.g. 10.12 of 10.12.4
+func minorVersion(version string) string {
+	segments := strings.Split(version, ".")
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return strings.Join(segments, ".")
+}
+
+// SelectPrunable returns the artifacts the policy deletes, oldest first
+func SelectPrunable(artifacts []ArtifactModel, policy PrunePolicyModel, now time.Time) []PruneCandidateModel {
+	// newest first
+	sorted := append([]ArtifactModel{}, artifacts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	reasons := map[string]string{}
+	if policy.KeepPerMinorVersion > 0 {
+		countByGroup := map[string]int{}
+		for _, anArtifact := range sorted {
+			group := anArtifact.Kind + " " + minorVersion(anArtifact.MacOSVersion)
+			countByGroup[group]++
+			if countByGroup[group] > policy.KeepPerMinorVersion && !anArtifact.IsInUse() {
+				reasons[anArtifact.Path] = fmt.Sprintf("more than %d of macOS %s", policy.KeepPerMinorVersion, valueOrUnknown(minorVersion(anArtifact.MacOSVersion)))
+			}
+		}
+	}
+	if policy.MaxAge > 0 {
+		for _, anArtifact := range sorted {
+			if _, isSelected := reasons[anArtifact.Path]; isSelected || anArtifact.IsInUse() {
+				continue
+			}
+			if now.Sub(anArtifact.CreatedAt) > policy.MaxAge {
+				reasons[anArtifact.Path] = "older than " + formatAge(policy.MaxAge)
+			}
+		}
+	}
+	if policy.MaxTotalSize > 0 {
+		totalSize := int64(0)
+		for _, anArtifact := range sorted {
+			if _, isSelected := reasons[anArtifact.Path]; !isSelected {
+				totalSize += anArtifact.Size
+			}
+		}
+		for idx := len(sorted) - 1; idx >= 0 && totalSize > policy.MaxTotalSize; idx-- {
+			anArtifact := sorted[idx]
+			if _, isSelected := reasons[anArtifact.Path]; isSelected || anArtifact.IsInUse() {
+				continue
+			}
+			reasons[anArtifact.Path] = "total size over " + HumanSize(policy.MaxTotalSize)
+			totalSize -= anArtifact.Size
+		}
+	}
+
+	candidates := []PruneCandidateModel{}
+	for idx := len(sorted) - 1; idx >= 0; idx-- {
+		if reason, isSelected := reasons[sorted[idx].Path]; isSelected {
+			candidates = append(candidates, PruneCandidateModel{Artifact: sorted[idx], Reason: reason})
+		}
+	}
+	return candidates
+}
+
+// formatAge formats the age in days, if it's a number of days
+func formatAge(age time.Duration) string {
+	day := 24 * time.Hour
+	if age%day == 0 {
+		return fmt.Sprintf("%d days", age/day)
+	}
+	return age.String()
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "(unknown)"
+	}
+	return value
+}
+
+// Delete removes the artifact, with its manifest and SSH private key
+func Delete(artifact ArtifactModel) error {
+	for _, aPath := range []string{artifact.Path, ManifestPathFor(artifact.Path), sshkey.PrivateKeyPathFor(artifact.Path)} {
+		if err := os.Remove(aPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Failed to delete (%s), error: %s", aPath, err)
+		}
+	}
+	return nil
+}
+
+// ParseSize parses a size, in bytes or with a unit, e.g. 500MB, 100GB or 1.5TB
+func ParseSize(size string) (int64, error) {
+	units := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"TB", 1024 * 1024 * 1024 * 1024},
+		{"GB", 1024 * 1024 * 1024},
+		{"MB", 1024 * 1024},
+		{"KB", 1024},
+		{"B", 1},
+	}
+
+	value, multiplier := strings.ToUpper(strings.TrimSpace(size)), float64(1)
+	for _, aUnit := range units {
+		if strings.HasSuffix(value, aUnit.suffix) {
+			value, multiplier = strings.TrimSpace(strings.TrimSuffix(value, aUnit.suffix)), aUnit.multiplier
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("Invalid size (%s), should be a number of bytes, or a number with a unit, e.g. 100GB", size)
+	}
+	return int64(number * multiplier), nil
+}
+
+// ParseAge parses an age: a number of days (e.g. 30d), or a duration (e.g. 36h)
+func ParseAge(age string) (time.Duration, error) {
+	if strings.HasSuffix(age, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(age, "d"))
+		if err != nil || days < 0 {
+			return 0, fmt.Errorf("Invalid age (%s), should be a number of days, e.g. 30d", age)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(age)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid age (%s), should be a number of days (e.g. 30d) or a duration (e.g. 36h)", age)
+	}
+	return duration, nil
+}
@@ -0,0 +1,137 @@
+package artifacts
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func candidatePaths(candidates []PruneCandidateModel) []string {
+	paths := []string{}
+	for _, aCandidate := range candidates {
+		paths = append(paths, aCandidate.Artifact.Path)
+	}
+	return paths
+}
+
+func TestSelectPrunable(t *testing.T) {
+	now := time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time {
+		return now.Add(-time.Duration(days) * 24 * time.Hour)
+	}
+	gb := int64(1024 * 1024 * 1024)
+	artifactList := []ArtifactModel{
+		{Kind: KindDMG, Path: "dmg-10.12.1", MacOSVersion: "10.12.1", Size: 5 * gb, CreatedAt: daysAgo(60)},
+		{Kind: KindDMG, Path: "dmg-10.12.4", MacOSVersion: "10.12.4", Size: 5 * gb, CreatedAt: daysAgo(10)},
+		{Kind: KindDMG, Path: "dmg-10.11.6", MacOSVersion: "10.11.6", Size: 5 * gb, CreatedAt: daysAgo(90)},
+		{Kind: KindBox, Path: "box-10.12.1", MacOSVersion: "10.12.1", Size: 9 * gb, CreatedAt: daysAgo(59), RegisteredAs: "bitrise-replica-macos"},
+		{Kind: KindBox, Path: "box-10.12.2", MacOSVersion: "10.12.2", Size: 9 * gb, CreatedAt: daysAgo(40)},
+		{Kind: KindBox, Path: "box-10.12.4", MacOSVersion: "10.12.4", Size: 9 * gb, CreatedAt: daysAgo(9)},
+	}
+
+	t.Log("empty policy")
+	{
+		require.True(t, PrunePolicyModel{}.IsEmpty())
+		require.Equal(t, []PruneCandidateModel{}, SelectPrunable(artifactList, PrunePolicyModel{}, now))
+	}
+
+	t.Log("keep per minor version")
+	{
+		candidates := SelectPrunable(artifactList, PrunePolicyModel{KeepPerMinorVersion: 1}, now)
+		// box-10.12.1 is registered in vagrant
+		require.Equal(t, []string{"dmg-10.12.1", "box-10.12.2"}, candidatePaths(candidates))
+		require.Equal(t, "more than 1 of macOS 10.12", candidates[0].Reason)
+	}
+
+	t.Log("max age")
+	{
+		candidates := SelectPrunable(artifactList, PrunePolicyModel{MaxAge: 45 * 24 * time.Hour}, now)
+		require.Equal(t, []string{"dmg-10.11.6", "dmg-10.12.1"}, candidatePaths(candidates))
+		require.Equal(t, "older than 45 days", candidates[0].Reason)
+	}
+
+	t.Log("max total size")
+	{
+		// 42 GB in total, the oldest ones are deleted until it's under 25 GB
+		candidates := SelectPrunable(artifactList, PrunePolicyModel{MaxTotalSize: 25 * gb}, now)
+		require.Equal(t, []string{"dmg-10.11.6", "dmg-10.12.1", "box-10.12.2"}, candidatePaths(candidates))
+	}
+
+	t.Log("combined rules")
+	{
+		candidates := SelectPrunable(artifactList, PrunePolicyModel{KeepPerMinorVersion: 1, MaxTotalSize: 25 * gb}, now)
+		require.Equal(t, []string{"dmg-10.11.6", "dmg-10.12.1", "box-10.12.2"}, candidatePaths(candidates))
+		require.Equal(t, "total size over 25.0 GB", candidates[0].Reason)
+		require.Equal(t, "more than 1 of macOS 10.12", candidates[1].Reason)
+	}
+
+	t.Log("used by a VM")
+	{
+		inUse := append([]ArtifactModel{}, artifactList...)
+		inUse[4].UsedByVMs = []string{"/Users/ci/vm1"}
+		candidates := SelectPrunable(inUse, PrunePolicyModel{KeepPerMinorVersion: 1}, now)
+		require.Equal(t, []string{"dmg-10.12.1"}, candidatePaths(candidates))
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	dmgPath := filepath.Join(tmpDir, "OSX_InstallESD_10.12.4_16E195.dmg")
+	keyPath := filepath.Join(tmpDir, "OSX_InstallESD_10.12.4_16E195.id_ed25519")
+	otherPath := filepath.Join(tmpDir, "OSX_InstallESD_10.12.5_16F73.dmg")
+	writeFile(t, dmgPath, "dmg")
+	writeFile(t, keyPath, "key")
+	writeFile(t, otherPath, "dmg")
+	require.NoError(t, WriteManifest(dmgPath, ManifestModel{Kind: KindDMG}))
+
+	require.NoError(t, Delete(ArtifactModel{Kind: KindDMG, Path: dmgPath}))
+	for _, aPath := range []string{dmgPath, ManifestPathFor(dmgPath), keyPath} {
+		_, err := os.Stat(aPath)
+		require.True(t, os.IsNotExist(err), aPath)
+	}
+	_, err = os.Stat(otherPath)
+	require.NoError(t, err)
+}
+
+func TestParseSize(t *testing.T) {
+	for aSize, anExpected := range map[string]int64{
+		"1024":   1024,
+		"500MB":  500 * 1024 * 1024,
+		"100GB":  100 * 1024 * 1024 * 1024,
+		"1.5 tb": 1536 * 1024 * 1024 * 1024,
+		"10B":    10,
+	} {
+		size, err := ParseSize(aSize)
+		require.NoError(t, err, aSize)
+		require.Equal(t, anExpected, size, aSize)
+	}
+
+	for _, aSize := range []string{"", "GB", "-1GB", "10PB"} {
+		_, err := ParseSize(aSize)
+		require.Error(t, err, aSize)
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	age, err := ParseAge("30d")
+	require.NoError(t, err)
+	require.Equal(t, 30*24*time.Hour, age)
+
+	age, err = ParseAge("36h")
+	require.NoError(t, err)
+	require.Equal(t, 36*time.Hour, age)
+
+	for _, anAge := range []string{"", "d", "-1d", "30 days"} {
+		_, err := ParseAge(anAge)
+		require.Error(t, err, anAge)
+	}
+}
@@ -0,0 +1,203 @@
+package boxpublish
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/bitrise-io/replica/s3client"
+)
+
+const (
+	// checksumMetadataName is the name of the metadata of the box objects, with the SHA-256 of the box
+	checksumMetadataName = "sha256"
+	// catalogUpdateAttempts is the number of attempts to update the catalog,
+	// if it's changed by someone else in the meantime
+	catalogUpdateAttempts = 5
+	// uploadStateFileSuffix is the suffix of the upload state file, next to the box
+	uploadStateFileSuffix = ".upload.json"
+)
+
+// OptionsModel ...
+type OptionsModel struct {
+	// BoxName is the name of the box in the catalog, e.g. myorg/macos-sierra
+	BoxName string
+	// Version of the box, e.g. 10.12.4
+	Version string
+	// Provider is the vagrant provider of the box, e.g. virtualbox
+	Provider string
+	// Prefix is the key prefix of the boxes in the bucket, e.g. vagrant/boxes
+	Prefix string
+	// BaseURL if set, is the URL of the prefix in the catalog,
+	// instead of the URL of the bucket (e.g. a CDN in front of the bucket)
+	BaseURL string
+	// PartSize is the part size of the upload, default: s3client.DefaultPartSize
+	PartSize int64
+	// IsReplace if true a different box of the same version and provider is replaced
+	IsReplace bool
+}
+
+// ResultModel ...
+type ResultModel struct {
+	BoxURL     string
+	CatalogURL string
+	Checksum   string
+	// IsUploaded is false if the box was already uploaded
+	IsUploaded bool
+}
+
+// BoxKey returns the key of the box in the bucket: PREFIX/BOX/VERSION/PROVIDER.box,
+// the same layout replica serve uses
+func BoxKey(opts OptionsModel) string {
+	return path.Join(opts.Prefix, opts.BoxName, opts.Version, opts.Provider+".box")
+}
+
+// CatalogKey returns the key of the catalog of the box in the bucket: PREFIX/BOX/catalog.json
+func CatalogKey(opts OptionsModel) string {
+	return path.Join(opts.Prefix, opts.BoxName, "catalog.json")
+}
+
+func objectURL(client *s3client.Client, key string, opts OptionsModel) string {
+	if opts.BaseURL == "" {
+		return client.ObjectURL(key)
+	}
+	relativeKey := strings.TrimPrefix(strings.TrimPrefix(key, opts.Prefix), "/")
+	return strings.TrimRight(opts.BaseURL, "/") + "/" + relativeKey
+}
+
+// readCatalog returns the catalog of the bucket with its ETag,
+// or a new catalog with an empty ETag if it does not exist yet
+func readCatalog(client *s3client.Client, opts OptionsModel) (catalog.CatalogModel, string, error) {
+	content, object, err := client.GetObject(CatalogKey(opts))
+	if err == s3client.ErrNotFound {
+		boxCatalog, err := catalog.New(opts.BoxName)
+		return boxCatalog, "", err
+	} else if err != nil {
+		return catalog.CatalogModel{}, "", fmt.Errorf("Failed to download catalog, error: %s", err)
+	}
+
+	boxCatalog, err := catalog.Parse(content)
+	if err != nil {
+		return catalog.CatalogModel{}, "", err
+	}
+	if boxCatalog.Name != opts.BoxName {
+		return catalog.CatalogModel{}, "", fmt.Errorf("The catalog (%s) belongs to another box (%s), not to %s", CatalogKey(opts), boxCatalog.Name, opts.BoxName)
+	}
+	return boxCatalog, object.ETag, nil
+}
+
+// updateCatalog adds the box to the catalog of the bucket. The catalog is only written
+// if it was not changed since it was read, otherwise the update is retried.
+func updateCatalog(client *s3client.Client, provider catalog.ProviderModel, opts OptionsModel) error {
+	for attempt := 1; attempt <= catalogUpdateAttempts; attempt++ {
+		boxCatalog, etag, err := readCatalog(client, opts)
+		if err != nil {
+			return err
+		}
+		if err := boxCatalog.AddBox(opts.Version, provider, opts.IsReplace); err != nil {
+			return err
+		}
+		content, err := boxCatalog.Serialize()
+		if err != nil {
+			return err
+		}
+
+		putOpts := s3client.PutOptionsModel{ContentType: "application/json", IfMatch: etag}
+		if etag == "" {
+			putOpts.IfNoneMatch = "*"
+		}
+		_, err = client.PutObject(CatalogKey(opts), content, putOpts)
+		if err == nil {
+			return nil
+		}
+		if err != s3client.ErrPreconditionFailed {
+			return fmt.Errorf("Failed to upload catalog, error: %s", err)
+		}
+		log.Printf(" => The catalog was changed in the meantime, retrying the update (%d/%d)", attempt, catalogUpdateAttempts)
+	}
+	return errors.New("Failed to update the catalog, it's changed by someone else continuously")
+}
+
+// Publish uploads the box into the bucket, then adds it to the catalog of the box, next to it.
+// The catalog is only updated once the box is uploaded, and its checksum is verified.
+func Publish(client *s3client.Client, boxPath string, opts OptionsModel) (ResultModel, error) {
+	if err := catalog.ValidateVersion(opts.Version); err != nil {
+		return ResultModel{}, err
+	}
+	if opts.Provider == "" {
+		return ResultModel{}, errors.New("No provider specified")
+	}
+	info, err := os.Stat(boxPath)
+	if err != nil {
+		return ResultModel{}, fmt.Errorf("Failed to get file info of the box (%s), error: %s", boxPath, err)
+	}
+
+	log.Println(" => Calculating the checksum of the box:", boxPath)
+	checksum, err := catalog.SHA256OfFile(boxPath)
+	if err != nil {
+		return ResultModel{}, err
+	}
+
+	boxKey := BoxKey(opts)
+	result := ResultModel{
+		BoxURL:     objectURL(client, boxKey, opts),
+		CatalogURL: objectURL(client, CatalogKey(opts), opts),
+		Checksum:   checksum,
+	}
+	provider := catalog.ProviderModel{
+		Name:         opts.Provider,
+		URL:          result.BoxURL,
+		ChecksumType: catalog.ChecksumTypeSHA256,
+		Checksum:     checksum,
+	}
+
+	// fail before the upload, if the catalog can't be updated
+	boxCatalog, _, err := readCatalog(client, opts)
+	if err != nil {
+		return ResultModel{}, err
+	}
+	if err := boxCatalog.AddBox(opts.Version, provider, opts.IsReplace); err != nil {
+		return ResultModel{}, err
+	}
+
+	object, err := client.HeadObject(boxKey)
+	if err != nil && err != s3client.ErrNotFound {
+		return ResultModel{}, fmt.Errorf("Failed to check whether the box is already uploaded, error: %s", err)
+	}
+	if err == nil && object.Metadata[checksumMetadataName] == checksum && object.Size == info.Size() {
+		log.Println(" => The box is already uploaded:", boxKey)
+	} else {
+		log.Println(" => Uploading the box:", boxKey)
+		if err := client.UploadFile(boxKey, boxPath, s3client.UploadFileOptionsModel{
+			PartSize:    opts.PartSize,
+			ContentType: "application/octet-stream",
+			Metadata:    map[string]string{checksumMetadataName: checksum},
+			StatePath:   boxPath + uploadStateFileSuffix,
+			Fingerprint: checksum,
+			Progress: func(uploadedBytes, totalBytes int64) {
+				log.Printf("    %d%% (%d / %d MB)", uploadedBytes*100/totalBytes, uploadedBytes/1024/1024, totalBytes/1024/1024)
+			},
+		}); err != nil {
+			return ResultModel{}, fmt.Errorf("Failed to upload the box, error: %s", err)
+		}
+		result.IsUploaded = true
+
+		object, err = client.HeadObject(boxKey)
+		if err != nil {
+			return ResultModel{}, fmt.Errorf("Failed to check the uploaded box, error: %s", err)
+		}
+	}
+	if object.Metadata[checksumMetadataName] != checksum || object.Size != info.Size() {
+		return ResultModel{}, fmt.Errorf("The uploaded box (%s) does not match the local box", boxKey)
+	}
+
+	log.Println(" => Updating the catalog:", CatalogKey(opts))
+	if err := updateCatalog(client, provider, opts); err != nil {
+		return ResultModel{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,167 @@
+package boxpublish
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/bitrise-io/replica/s3client"
+	"github.com/bitrise-io/replica/s3client/s3test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, server *s3test.Server) *s3client.Client {
+	client, err := s3client.New(s3client.ConfigModel{
+		Endpoint:    server.URL,
+		Region:      "us-east-1",
+		Bucket:      server.Bucket,
+		IsPathStyle: true,
+		Credentials: s3client.CredentialsModel{AccessKeyID: "access-key", SecretAccessKey: "secret-key"},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func writeBox(t *testing.T, dir, content string) string {
+	pth := filepath.Join(dir, "virtualbox.box")
+	require.NoError(t, ioutil.WriteFile(pth, []byte(content), 0644))
+	return pth
+}
+
+func storedCatalog(t *testing.T, server *s3test.Server, key string) catalog.CatalogModel {
+	object, isFound := server.Object(key)
+	require.True(t, isFound)
+	boxCatalog, err := catalog.Parse(object.Content)
+	require.NoError(t, err)
+	return boxCatalog
+}
+
+func TestKeys(t *testing.T) {
+	opts := OptionsModel{BoxName: "macos-sierra", Version: "10.12.4", Provider: "virtualbox"}
+	require.Equal(t, "macos-sierra/10.12.4/virtualbox.box", BoxKey(opts))
+	require.Equal(t, "macos-sierra/catalog.json", CatalogKey(opts))
+
+	opts.Prefix = "vagrant/boxes"
+	require.Equal(t, "vagrant/boxes/macos-sierra/10.12.4/virtualbox.box", BoxKey(opts))
+	require.Equal(t, "vagrant/boxes/macos-sierra/catalog.json", CatalogKey(opts))
+}
+
+func TestPublish(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	server := s3test.NewServer("boxes")
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	boxPath := writeBox(t, tmpDir, "box 1")
+	checksum, err := catalog.SHA256OfFile(boxPath)
+	require.NoError(t, err)
+	opts := OptionsModel{BoxName: "macos-sierra", Version: "10.12.4", Provider: "virtualbox", Prefix: "vagrant"}
+
+	t.Log("new box and catalog")
+	{
+		result, err := Publish(client, boxPath, opts)
+		require.NoError(t, err)
+		require.True(t, result.IsUploaded)
+		require.Equal(t, checksum, result.Checksum)
+		require.Equal(t, server.URL+"/boxes/vagrant/macos-sierra/10.12.4/virtualbox.box", result.BoxURL)
+		require.Equal(t, server.URL+"/boxes/vagrant/macos-sierra/catalog.json", result.CatalogURL)
+
+		object, isFound := server.Object("vagrant/macos-sierra/10.12.4/virtualbox.box")
+		require.True(t, isFound)
+		require.Equal(t, "box 1", string(object.Content))
+		require.Equal(t, checksum, object.Metadata["sha256"])
+
+		boxCatalog := storedCatalog(t, server, "vagrant/macos-sierra/catalog.json")
+		require.Equal(t, "macos-sierra", boxCatalog.Name)
+		require.Equal(t, []catalog.VersionModel{
+			{Version: "10.12.4", Providers: []catalog.ProviderModel{
+				{Name: "virtualbox", URL: result.BoxURL, ChecksumType: "sha256", Checksum: checksum},
+			}},
+		}, boxCatalog.Versions)
+
+		_, err = os.Stat(boxPath + uploadStateFileSuffix)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	t.Log("already published")
+	{
+		partUploads := server.Requests("PUT part")
+		result, err := Publish(client, boxPath, opts)
+		require.NoError(t, err)
+		require.False(t, result.IsUploaded)
+		require.Equal(t, partUploads, server.Requests("PUT part"))
+	}
+
+	t.Log("different box of the same version")
+	{
+		otherDir := filepath.Join(tmpDir, "other")
+		require.NoError(t, os.MkdirAll(otherDir, 0755))
+		otherBoxPath := writeBox(t, otherDir, "box 2")
+		catalogPuts := server.Requests("PUT object")
+		_, err := Publish(client, otherBoxPath, opts)
+		require.Error(t, err)
+		require.Equal(t, catalogPuts, server.Requests("PUT object"))
+
+		object, isFound := server.Object("vagrant/macos-sierra/10.12.4/virtualbox.box")
+		require.True(t, isFound)
+		require.Equal(t, "box 1", string(object.Content))
+
+		opts.IsReplace = true
+		result, err := Publish(client, otherBoxPath, opts)
+		require.NoError(t, err)
+		require.True(t, result.IsUploaded)
+		opts.IsReplace = false
+
+		boxCatalog := storedCatalog(t, server, "vagrant/macos-sierra/catalog.json")
+		require.Equal(t, 1, len(boxCatalog.Versions))
+		require.Equal(t, result.Checksum, boxCatalog.Versions[0].Providers[0].Checksum)
+	}
+
+	t.Log("new version, the catalog is changed by someone else")
+	{
+		boxCatalog := storedCatalog(t, server, "vagrant/macos-sierra/catalog.json")
+		require.NoError(t, boxCatalog.AddBox("10.12.5", catalog.ProviderModel{Name: "vmware_desktop", URL: "https://example.com/vmware.box"}, false))
+		content, err := boxCatalog.Serialize()
+		require.NoError(t, err)
+		server.PutObject("vagrant/macos-sierra/catalog.json", s3test.ObjectModel{Content: content})
+
+		opts.Version = "10.13.0"
+		opts.BaseURL = "https://cdn.example.com/vagrant/"
+		result, err := Publish(client, boxPath, opts)
+		require.NoError(t, err)
+		require.Equal(t, "https://cdn.example.com/vagrant/macos-sierra/10.13.0/virtualbox.box", result.BoxURL)
+
+		boxCatalog = storedCatalog(t, server, "vagrant/macos-sierra/catalog.json")
+		versions := []string{}
+		for _, aVersion := range boxCatalog.Versions {
+			versions = append(versions, aVersion.Version)
+		}
+		require.Equal(t, []string{"10.12.4", "10.12.5", "10.13.0"}, versions)
+	}
+
+	t.Log("catalog of another box")
+	{
+		otherCatalog, err := catalog.New("macos-high-sierra")
+		require.NoError(t, err)
+		content, err := otherCatalog.Serialize()
+		require.NoError(t, err)
+		server.PutObject("vagrant/macos-sierra/catalog.json", s3test.ObjectModel{Content: content})
+
+		_, err = Publish(client, boxPath, opts)
+		require.Error(t, err)
+	}
+
+	t.Log("invalid version")
+	{
+		opts.Version = "latest"
+		_, err := Publish(client, boxPath, opts)
+		require.Error(t, err)
+	}
+}
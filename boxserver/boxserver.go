@@ -0,0 +1,357 @@
+package boxserver
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/bitrise-io/replica/catalog"
+)
+
+const (
+	// CatalogFileName is the name of the generated catalog of a box, e.g. /macos-sierra/catalog.json
+	CatalogFileName = "catalog.json"
+	// boxFileExtension is the extension of the served box files
+	boxFileExtension = ".box"
+)
+
+var (
+	boxNameRegexp     = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+	versionRegexp     = regexp.MustCompile(`^\d+(\.\d+)*$`)
+	boxFileNameRegexp = regexp.MustCompile(`^([A-Za-z0-9_-]+)\.box$`)
+)
+
+// Options ...
+type Options struct {
+	// BaseURL is the URL of the server, used in the catalogs.
+	// If empty it's determined from the request.
+	BaseURL string
+	// Username and Password if set, the server requires basic authentication
+	Username string
+	Password string
+}
+
+// BoxFileModel is a served box file: DIR/BOX/VERSION/NAME.box
+type BoxFileModel struct {
+	Box     string
+	Version string
+	// Name is the name of the file, without the extension (e.g. virtualbox), it's only used in the URL of the box,
+	// the provider in the catalog is read from the metadata.json of the box
+	Name    string
+	Path    string
+	Size    int64
+	ModTime time.Time
+	// MacOSBuild is the macOS build of the box, from its manifest (DIR/BOX/VERSION/NAME.replica.json),
+	// empty if the box has no manifest
+	MacOSBuild string
+
+	// manifestChecksum is the checksum cached in the manifest of the box, empty if it's not up to date
+	manifestChecksum string
+}
+
+// URLPath returns the path of the box file on the server
+func (boxFile BoxFileModel) URLPath() string {
+	return "/" + path.Join(boxFile.Box, boxFile.Version, boxFile.Name+boxFileExtension)
+}
+
+// boxInfoCacheKey identifies a version of a box file, the box info is read once per version
+type boxInfoCacheKey struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// boxInfoModel is the catalog entry of a box file, read from the file
+type boxInfoModel struct {
+	// Provider is the provider of the box, from its metadata.json (e.g. vmware_desktop)
+	Provider string
+	// Checksum is the SHA-256 checksum of the box file
+	Checksum string
+}
+
+// Server serves the boxes of a directory, with the generated catalogs of the boxes.
+// The layout of the directory: BOX/VERSION/NAME.box, e.g. macos-sierra/10.12.4/virtualbox.box
+type Server struct {
+	dir  string
+	opts Options
+
+	boxInfosMutex sync.Mutex
+	boxInfos      map[boxInfoCacheKey]boxInfoModel
+}
+
+// New returns the server of the directory
+func New(dir string, opts Options) *Server {
+	return &Server{
+		dir:      dir,
+		opts:     opts,
+		boxInfos: map[boxInfoCacheKey]boxInfoModel{},
+	}
+}
+
+// ScanBoxFiles returns the box files of the directory, ordered by box, version and provider.
+// Files and directories which don't match the layout are ignored.
+func ScanBoxFiles(dir string) ([]BoxFileModel, error) {
+	boxInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list boxes (%s), error: %s", dir, err)
+	}
+
+	boxFiles := []BoxFileModel{}
+	for _, aBoxInfo := range boxInfos {
+		if !aBoxInfo.IsDir() || !boxNameRegexp.MatchString(aBoxInfo.Name()) {
+			continue
+		}
+		versionInfos, err := ioutil.ReadDir(filepath.Join(dir, aBoxInfo.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list versions of box (%s), error: %s", aBoxInfo.Name(), err)
+		}
+		for _, aVersionInfo := range versionInfos {
+			if !aVersionInfo.IsDir() || !versionRegexp.MatchString(aVersionInfo.Name()) {
+				continue
+			}
+			versionDir := filepath.Join(dir, aBoxInfo.Name(), aVersionInfo.Name())
+			fileInfos, err := ioutil.ReadDir(versionDir)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to list files of box (%s) version (%s), error: %s", aBoxInfo.Name(), aVersionInfo.Name(), err)
+			}
+			for _, aFileInfo := range fileInfos {
+				match := boxFileNameRegexp.FindStringSubmatch(aFileInfo.Name())
+				if !aFileInfo.Mode().IsRegular() || match == nil {
+					continue
+				}
+				pth := filepath.Join(versionDir, aFileInfo.Name())
+				// a missing or invalid manifest does not prevent serving the box
+				manifest, _, err := artifacts.ReadManifest(pth)
+				if err != nil {
+					log.Printf(" [!] Failed to read the manifest of the box, error: %s", err)
+				}
+				manifestChecksum, _ := manifest.CachedChecksum(aFileInfo.Size(), aFileInfo.ModTime())
+				boxFiles = append(boxFiles, BoxFileModel{
+					Box:              aBoxInfo.Name(),
+					Version:          aVersionInfo.Name(),
+					Name:             match[1],
+					Path:             pth,
+					Size:             aFileInfo.Size(),
+					ModTime:          aFileInfo.ModTime(),
+					MacOSBuild:       manifest.MacOSBuild,
+					manifestChecksum: manifestChecksum,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(boxFiles, func(i, j int) bool {
+		if boxFiles[i].Box != boxFiles[j].Box {
+			return boxFiles[i].Box < boxFiles[j].Box
+		}
+		if boxFiles[i].Version != boxFiles[j].Version {
+			return catalog.CompareVersions(boxFiles[i].Version, boxFiles[j].Version) > 0
+		}
+		return boxFiles[i].Name < boxFiles[j].Name
+	})
+	return boxFiles, nil
+}
+
+// ServeHTTP ...
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !server.isAuthorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="replica"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	segments := strings.Split(strings.Trim(path.Clean("/"+r.URL.Path), "/"), "/")
+	switch {
+	case r.URL.Path == "/":
+		server.serveIndex(w, r)
+	case len(segments) == 2 && segments[1] == CatalogFileName:
+		server.serveCatalog(w, r, segments[0])
+	case len(segments) == 3:
+		server.serveBoxFile(w, r, segments[0], segments[1], segments[2])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (server *Server) isAuthorized(r *http.Request) bool {
+	if server.opts.Username == "" && server.opts.Password == "" {
+		return true
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	isUsernameOK := subtle.ConstantTimeCompare([]byte(username), []byte(server.opts.Username)) == 1
+	isPasswordOK := subtle.ConstantTimeCompare([]byte(password), []byte(server.opts.Password)) == 1
+	return isUsernameOK && isPasswordOK
+}
+
+// baseURL returns the URL of the server, as the client sees it
+func (server *Server) baseURL(r *http.Request) string {
+	if server.opts.BaseURL != "" {
+		return strings.TrimRight(server.opts.BaseURL, "/")
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwardedProto := r.Header.Get("X-Forwarded-Proto"); forwardedProto == "http" || forwardedProto == "https" {
+		scheme = forwardedProto
+	}
+	return scheme + "://" + r.Host
+}
+
+func (server *Server) serveCatalog(w http.ResponseWriter, r *http.Request, boxName string) {
+	boxFiles, err := ScanBoxFiles(server.dir)
+	if err != nil {
+		server.serveError(w, err)
+		return
+	}
+
+	boxCatalog, err := catalog.New(boxName)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	for _, aBoxFile := range boxFiles {
+		if aBoxFile.Box != boxName {
+			continue
+		}
+		info, err := server.boxInfo(aBoxFile)
+		if err != nil {
+			server.serveError(w, err)
+			return
+		}
+		provider := catalog.ProviderModel{
+			Name:         info.Provider,
+			URL:          server.baseURL(r) + aBoxFile.URLPath(),
+			ChecksumType: catalog.ChecksumTypeSHA256,
+			Checksum:     info.Checksum,
+		}
+		if err := boxCatalog.AddBox(aBoxFile.Version, provider, false); err != nil {
+			server.serveError(w, err)
+			return
+		}
+	}
+	if len(boxCatalog.Versions) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	content, err := boxCatalog.Serialize()
+	if err != nil {
+		server.serveError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(content); err != nil {
+		log.Printf(" [!] Failed to write catalog response, error: %s", err)
+	}
+}
+
+func (server *Server) serveBoxFile(w http.ResponseWriter, r *http.Request, boxName, version, fileName string) {
+	match := boxFileNameRegexp.FindStringSubmatch(fileName)
+	if !boxNameRegexp.MatchString(boxName) || !versionRegexp.MatchString(version) || match == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	pth := filepath.Join(server.dir, boxName, version, fileName)
+	file, err := os.Open(pth)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		server.serveError(w, err)
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf(" [!] Failed to close box file (%s), error: %s", pth, err)
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		server.serveError(w, err)
+		return
+	}
+	if !info.Mode().IsRegular() {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	// handles the range requests, so that the download of the box can be resumed
+	http.ServeContent(w, r, fileName, info.ModTime(), file)
+}
+
+func (server *Server) serveError(w http.ResponseWriter, err error) {
+	log.Printf(" [!] Failed to serve request, error: %s", err)
+	http.Error(w, "Internal server error", http.StatusInternalServerError)
+}
+
+// ReadBoxInfos reads the providers and calculates the checksums (which are not cached in the manifests)
+// of the boxes of the directory, so that the catalog requests don't have to wait for it.
+// The boxes added later are read at the first request of their catalog.
+func (server *Server) ReadBoxInfos() error {
+	boxFiles, err := ScanBoxFiles(server.dir)
+	if err != nil {
+		return err
+	}
+	for _, aBoxFile := range boxFiles {
+		if _, err := server.boxInfo(aBoxFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// boxInfo returns the provider and the SHA-256 checksum of the box file, read once per version of the file.
+// The checksum cached in the manifest of the box is used, if it's up to date.
+func (server *Server) boxInfo(boxFile BoxFileModel) (boxInfoModel, error) {
+	key := boxInfoCacheKey{path: boxFile.Path, size: boxFile.Size, modTime: boxFile.ModTime}
+
+	server.boxInfosMutex.Lock()
+	info, isCached := server.boxInfos[key]
+	server.boxInfosMutex.Unlock()
+	if isCached {
+		return info, nil
+	}
+
+	metadata, err := catalog.ReadBoxMetadata(boxFile.Path)
+	if err != nil {
+		return boxInfoModel{}, err
+	}
+	info = boxInfoModel{Provider: metadata.Provider, Checksum: boxFile.manifestChecksum}
+	if info.Checksum == "" {
+		log.Println(" => Calculating the checksum of the box:", boxFile.Path)
+		info.Checksum, err = catalog.SHA256OfFile(boxFile.Path)
+		if err != nil {
+			return boxInfoModel{}, fmt.Errorf("Failed to calculate the checksum of the box (%s), error: %s", boxFile.Path, err)
+		}
+	}
+
+	server.boxInfosMutex.Lock()
+	server.boxInfos[key] = info
+	server.boxInfosMutex.Unlock()
+	return info, nil
+}
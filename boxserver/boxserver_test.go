@@ -0,0 +1,248 @@
+package boxserver
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/stretchr/testify/require"
+)
+
+// testBoxSize is the size of the test boxes: two files (a header and a data block each) and the end of the tar
+const testBoxSize = 4*512 + 1024
+
+// writeTestBox writes a vagrant box (an uncompressed tar) with the metadata.json of the provider
+func writeTestBox(t *testing.T, pth, provider, vagrantfile string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(pth), 0755))
+	file, err := os.Create(pth)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, file.Close())
+	}()
+
+	tarWriter := tar.NewWriter(file)
+	for _, aFile := range [][]string{{"Vagrantfile", vagrantfile}, {"metadata.json", `{"provider": "` + provider + `"}`}} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: aFile[0], Mode: 0644, Size: int64(len(aFile[1]))}))
+		_, err := tarWriter.Write([]byte(aFile[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+}
+
+func createTestBoxDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+
+	writeTestBox(t, filepath.Join(dir, "macos-sierra/10.12.4/virtualbox.box"), "virtualbox", "10.12.4")
+	// the name of the file is not the provider of the box
+	writeTestBox(t, filepath.Join(dir, "macos-sierra/10.12.4/vmware.box"), "vmware_desktop", "10.12.4 vmware")
+	writeTestBox(t, filepath.Join(dir, "macos-sierra/10.12.10/virtualbox.box"), "virtualbox", "newer")
+	writeTestBox(t, filepath.Join(dir, "macos-sierra/latest/virtualbox.box"), "virtualbox", "not a version")
+	for pth, content := range map[string]string{
+		"macos-sierra/10.12.4/virtualbox.replica.json": `{"kind": "box", "macos_version": "10.12.4", "macos_build": "16E195"}`,
+		"macos-sierra/10.12.4/notes.txt":               "not a box",
+		"README.md":                                    "not a box",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, pth), []byte(content), 0600))
+	}
+	return dir
+}
+
+func doRequest(t *testing.T, handler http.Handler, method, target string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, nil)
+	for key, values := range header {
+		r.Header[key] = values
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestScanBoxFiles(t *testing.T) {
+	dir := createTestBoxDir(t)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	boxFiles, err := ScanBoxFiles(dir)
+	require.NoError(t, err)
+
+	paths := []string{}
+	for _, aBoxFile := range boxFiles {
+		paths = append(paths, aBoxFile.URLPath())
+	}
+	require.Equal(t, []string{
+		"/macos-sierra/10.12.10/virtualbox.box",
+		"/macos-sierra/10.12.4/virtualbox.box",
+		"/macos-sierra/10.12.4/vmware.box",
+	}, paths)
+	require.Equal(t, int64(testBoxSize), boxFiles[1].Size)
+	require.Equal(t, "vmware", boxFiles[2].Name)
+	require.Equal(t, "16E195", boxFiles[1].MacOSBuild)
+	require.Equal(t, "", boxFiles[2].MacOSBuild)
+}
+
+func TestServer(t *testing.T) {
+	dir := createTestBoxDir(t)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	server := New(dir, Options{})
+	boxPath := filepath.Join(dir, "macos-sierra/10.12.4/virtualbox.box")
+	boxContent, err := ioutil.ReadFile(boxPath)
+	require.NoError(t, err)
+	boxChecksum, err := catalog.SHA256OfFile(boxPath)
+	require.NoError(t, err)
+
+	t.Log("catalog")
+	{
+		w := doRequest(t, server, http.MethodGet, "http://build-mac.local:8080/macos-sierra/catalog.json", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var boxCatalog catalog.CatalogModel
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &boxCatalog))
+		require.Equal(t, "macos-sierra", boxCatalog.Name)
+		require.Equal(t, 2, len(boxCatalog.Versions))
+		require.Equal(t, "10.12.4", boxCatalog.Versions[0].Version)
+		require.Equal(t, catalog.ProviderModel{
+			Name:         "virtualbox",
+			URL:          "http://build-mac.local:8080/macos-sierra/10.12.4/virtualbox.box",
+			ChecksumType: "sha256",
+			Checksum:     boxChecksum,
+		}, boxCatalog.Versions[0].Providers[0])
+		// the provider of the box, not the name of the file
+		require.Equal(t, "vmware_desktop", boxCatalog.Versions[0].Providers[1].Name)
+		require.Equal(t, "http://build-mac.local:8080/macos-sierra/10.12.4/vmware.box", boxCatalog.Versions[0].Providers[1].URL)
+		require.Equal(t, "10.12.10", boxCatalog.Versions[1].Version)
+	}
+
+	t.Log("catalog with base URL")
+	{
+		w := doRequest(t, New(dir, Options{BaseURL: "https://boxes.example.com/"}), http.MethodGet, "/macos-sierra/catalog.json", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"url": "https://boxes.example.com/macos-sierra/10.12.10/virtualbox.box"`)
+	}
+
+	t.Log("box file")
+	{
+		w := doRequest(t, server, http.MethodGet, "/macos-sierra/10.12.4/virtualbox.box", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, string(boxContent), w.Body.String())
+		require.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+	}
+
+	t.Log("range request, to resume the download")
+	{
+		w := doRequest(t, server, http.MethodGet, "/macos-sierra/10.12.4/virtualbox.box", http.Header{"Range": {"bytes=1024-"}})
+		require.Equal(t, http.StatusPartialContent, w.Code)
+		require.Equal(t, string(boxContent[1024:]), w.Body.String())
+		require.Equal(t, fmt.Sprintf("bytes 1024-%d/%d", testBoxSize-1, testBoxSize), w.Header().Get("Content-Range"))
+	}
+
+	t.Log("index")
+	{
+		w := doRequest(t, server, http.MethodGet, "http://build-mac.local:8080/", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), "<h2>macos-sierra</h2>")
+		require.Contains(t, w.Body.String(), "vagrant box add http://build-mac.local:8080/macos-sierra/catalog.json")
+		require.Contains(t, w.Body.String(), `<td>10.12.4</td><td>16E195</td><td><a href="/macos-sierra/10.12.4/virtualbox.box">virtualbox</a></td><td>3.0 KB</td>`)
+		require.Contains(t, w.Body.String(), `<td>10.12.4</td><td>-</td><td><a href="/macos-sierra/10.12.4/vmware.box">vmware</a></td><td>3.0 KB</td>`)
+	}
+
+	t.Log("not found")
+	{
+		for _, aTarget := range []string{
+			"/macos-high-sierra/catalog.json",
+			"/macos-sierra/10.12.4/notes.txt",
+			"/macos-sierra/latest/virtualbox.box",
+			"/macos-sierra/10.12.4/parallels.box",
+			"/macos-sierra/../README.md",
+			"/README.md",
+		} {
+			w := doRequest(t, server, http.MethodGet, aTarget, nil)
+			require.Equal(t, http.StatusNotFound, w.Code, aTarget)
+		}
+	}
+
+	t.Log("method not allowed")
+	{
+		w := doRequest(t, server, http.MethodPost, "/macos-sierra/catalog.json", nil)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	}
+}
+
+func TestServer_checksums(t *testing.T) {
+	dir := createTestBoxDir(t)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	// the manifest of the box has an up to date checksum
+	modTime := time.Date(2017, 4, 1, 12, 0, 0, 0, time.UTC)
+	boxPath := filepath.Join(dir, "macos-sierra/10.12.10/virtualbox.box")
+	require.NoError(t, os.Chtimes(boxPath, modTime, modTime))
+	require.NoError(t, artifacts.WriteManifest(boxPath, artifacts.ManifestModel{
+		Kind:     artifacts.KindBox,
+		Checksum: &artifacts.ChecksumModel{SHA256: "cached-checksum", Size: testBoxSize, ModTime: modTime},
+	}))
+	otherBoxChecksum, err := catalog.SHA256OfFile(filepath.Join(dir, "macos-sierra/10.12.4/virtualbox.box"))
+	require.NoError(t, err)
+
+	server := New(dir, Options{})
+	require.NoError(t, server.ReadBoxInfos())
+	require.Equal(t, 3, len(server.boxInfos))
+
+	w := doRequest(t, server, http.MethodGet, "/macos-sierra/catalog.json", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"checksum": "cached-checksum"`)
+	require.Contains(t, w.Body.String(), `"checksum": "`+otherBoxChecksum+`"`)
+
+	t.Log("outdated manifest checksum")
+	{
+		writeTestBox(t, boxPath, "virtualbox", "changed")
+		w := doRequest(t, New(dir, Options{}), http.MethodGet, "/macos-sierra/catalog.json", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotContains(t, w.Body.String(), "cached-checksum")
+	}
+}
+
+func TestServer_basicAuth(t *testing.T) {
+	dir := createTestBoxDir(t)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	server := httptest.NewServer(New(dir, Options{Username: "replica", Password: "secret"}))
+	defer server.Close()
+
+	for _, aCase := range []struct {
+		username     string
+		password     string
+		expectedCode int
+	}{
+		{"", "", http.StatusUnauthorized},
+		{"replica", "wrong", http.StatusUnauthorized},
+		{"replica", "secret", http.StatusOK},
+	} {
+		r, err := http.NewRequest(http.MethodGet, server.URL+"/macos-sierra/10.12.4/vmware.box", nil)
+		require.NoError(t, err)
+		if aCase.username != "" {
+			r.SetBasicAuth(aCase.username, aCase.password)
+		}
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, aCase.expectedCode, resp.StatusCode, aCase.password)
+	}
+}
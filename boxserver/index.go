@@ -0,0 +1,91 @@
+package boxserver
+
+import (
+	"bytes"
+	"html/template"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/bitrise-io/replica/artifacts"
+)
+
+// indexTemplate lists the boxes, with their versions and box files
+var indexTemplate = template.Must(template.New("index").Funcs(template.FuncMap{
+	"humanSize": artifacts.HumanSize,
+	"formatTime": func(t time.Time) string {
+		return t.Format("2006-01-02 15:04")
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>replica boxes</title>
+<style>
+body { font-family: -apple-system, Helvetica, Arial, sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { text-align: left; padding: 0.3em 1em; border-bottom: 1px solid #ddd; }
+code { background: #f4f4f4; padding: 0.2em 0.4em; }
+</style>
+</head>
+<body>
+<h1>replica boxes</h1>
+{{- if not .Boxes }}
+<p>No boxes found.</p>
+{{- end }}
+{{- range .Boxes }}
+<h2>{{ .Name }}</h2>
+<p><code>vagrant box add {{ .CatalogURL }}</code></p>
+<table>
+<tr><th>macOS version</th><th>macOS build</th><th>box</th><th>size</th><th>built</th></tr>
+{{- range .Files }}
+<tr><td>{{ .Version }}</td><td>{{ or .MacOSBuild "-" }}</td><td><a href="{{ .URLPath }}">{{ .Name }}</a></td><td>{{ humanSize .Size }}</td><td>{{ formatTime .ModTime }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+type indexBoxModel struct {
+	Name       string
+	CatalogURL string
+	Files      []BoxFileModel
+}
+
+type indexModel struct {
+	Boxes []indexBoxModel
+}
+
+func (server *Server) serveIndex(w http.ResponseWriter, r *http.Request) {
+	boxFiles, err := ScanBoxFiles(server.dir)
+	if err != nil {
+		server.serveError(w, err)
+		return
+	}
+
+	index := indexModel{}
+	for _, aBoxFile := range boxFiles {
+		if len(index.Boxes) == 0 || index.Boxes[len(index.Boxes)-1].Name != aBoxFile.Box {
+			index.Boxes = append(index.Boxes, indexBoxModel{
+				Name:       aBoxFile.Box,
+				CatalogURL: server.baseURL(r) + "/" + aBoxFile.Box + "/" + CatalogFileName,
+			})
+		}
+		box := &index.Boxes[len(index.Boxes)-1]
+		box.Files = append(box.Files, aBoxFile)
+	}
+
+	var buf bytes.Buffer
+	if err := indexTemplate.Execute(&buf, index); err != nil {
+		server.serveError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Printf(" [!] Failed to write index response, error: %s", err)
+	}
+}
@@ -0,0 +1,238 @@
+package buildlog
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLogsDir is the directory of the build log directories, relative to the working directory
+	DefaultLogsDir = "./_out/logs"
+	// DefaultKeepCount is the number of build log directories kept by default
+	DefaultKeepCount = 10
+
+	// ReplicaLogFileName is the log of replica, including the output of the commands it runs
+	ReplicaLogFileName = "replica.log"
+	// PackerLogFileName is the debug log of packer (PACKER_LOG)
+	PackerLogFileName = "packer.log"
+
+	// dirNameTimeLayout is the time format of the build log directory names
+	dirNameTimeLayout = "20060102-150405"
+)
+
+// buildLogDirRegexp matches the build log directory names: a timestamp, and an optional counter
+var buildLogDirRegexp = regexp.MustCompile(`^(\d{8}-\d{6})(?:-(\d+))?$`)
+
+// colorCodeRegexp matches the ANSI color codes, which are not written into the log file
+var colorCodeRegexp = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// SessionModel captures the output of a replica run into a build log directory:
+// everything written to the standard output and error (by replica, and by
+// the commands it runs) is written to the terminal and into the log file as well.
+type SessionModel struct {
+	// Dir is the build log directory of the run
+	Dir string
+
+	logFile      *os.File
+	logFileMutex sync.Mutex
+
+	originalStdout *os.File
+	originalStderr *os.File
+	stdoutWriter   *os.File
+	stderrWriter   *os.File
+	copyWaitGroup  sync.WaitGroup
+	copyErr        error
+}
+
+// Start creates a new build log directory in logsDir, removes the oldest ones,
+// so that at most keepCount directories remain, and starts capturing the output
+func Start(logsDir string, keepCount int, now time.Time) (*SessionModel, error) {
+	if keepCount < 1 {
+		return nil, fmt.Errorf("Invalid number of build logs to keep (%d), should be at least 1", keepCount)
+	}
+	if err := Rotate(logsDir, keepCount-1); err != nil {
+		return nil, err
+	}
+
+	dir, err := createLogDir(logsDir, now)
+	if err != nil {
+		return nil, err
+	}
+
+	logFile, err := os.Create(filepath.Join(dir, ReplicaLogFileName))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create log file, error: %s", err)
+	}
+
+	session := &SessionModel{
+		Dir:            dir,
+		logFile:        logFile,
+		originalStdout: os.Stdout,
+		originalStderr: os.Stderr,
+	}
+
+	stdoutWriter, err := session.tee(os.Stdout)
+	if err != nil {
+		session.closeLogFile()
+		return nil, err
+	}
+	stderrWriter, err := session.tee(os.Stderr)
+	if err != nil {
+		if closeErr := stdoutWriter.Close(); closeErr != nil {
+			log.Printf(" [!] Failed to close output pipe, error: %s", closeErr)
+		}
+		session.copyWaitGroup.Wait()
+		session.closeLogFile()
+		return nil, err
+	}
+	session.stdoutWriter, session.stderrWriter = stdoutWriter, stderrWriter
+
+	// the commands started with the standard outputs inherit these files as well
+	os.Stdout, os.Stderr = stdoutWriter, stderrWriter
+	log.SetOutput(os.Stderr)
+
+	return session, nil
+}
+
+// PackerLogPath is the path of packer's debug log, in the build log directory
+func (session *SessionModel) PackerLogPath() string {
+	return filepath.Join(session.Dir, PackerLogFileName)
+}
+
+// Close stops capturing the output, and restores the original standard outputs
+func (session *SessionModel) Close() error {
+	os.Stdout, os.Stderr = session.originalStdout, session.originalStderr
+	log.SetOutput(os.Stderr)
+
+	for _, aWriter := range []*os.File{session.stdoutWriter, session.stderrWriter} {
+		if err := aWriter.Close(); err != nil {
+			return fmt.Errorf("Failed to close output pipe, error: %s", err)
+		}
+	}
+	session.copyWaitGroup.Wait()
+
+	if err := session.logFile.Close(); err != nil {
+		return fmt.Errorf("Failed to close log file, error: %s", err)
+	}
+	if session.copyErr != nil {
+		return fmt.Errorf("Failed to write build log, error: %s", session.copyErr)
+	}
+	return nil
+}
+
+// Write writes the output into the log file, without color codes
+func (session *SessionModel) Write(p []byte) (int, error) {
+	session.logFileMutex.Lock()
+	defer session.logFileMutex.Unlock()
+
+	if _, err := session.logFile.Write(colorCodeRegexp.ReplaceAll(p, nil)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// closeLogFile closes the log file, if the capture could not be started
+func (session *SessionModel) closeLogFile() {
+	if err := session.logFile.Close(); err != nil {
+		log.Printf(" [!] Failed to close log file, error: %s", err)
+	}
+}
+
+// tee returns a pipe, whose content is written into the terminal and into the log file
+func (session *SessionModel) tee(terminal *os.File) (*os.File, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create output pipe, error: %s", err)
+	}
+
+	session.copyWaitGroup.Add(1)
+	go func() {
+		defer session.copyWaitGroup.Done()
+		_, copyErr := io.Copy(io.MultiWriter(terminal, session), reader)
+		if copyErr == nil {
+			copyErr = reader.Close()
+		}
+		if copyErr != nil {
+			session.logFileMutex.Lock()
+			session.copyErr = copyErr
+			session.logFileMutex.Unlock()
+		}
+	}()
+	return writer, nil
+}
+
+// createLogDir creates the build log directory of the run,
+// with a counter in its name if one already exists for the same second
+func createLogDir(logsDir string, now time.Time) (string, error) {
+	if err := os.MkdirAll(logsDir, 0755); err != nil {
+		return "", fmt.Errorf("Failed to create logs directory (%s), error: %s", logsDir, err)
+	}
+
+	name := now.Format(dirNameTimeLayout)
+	for counter := 2; ; counter++ {
+		dir := filepath.Join(logsDir, name)
+		err := os.Mkdir(dir, 0755)
+		if err == nil {
+			return dir, nil
+		}
+		if !os.IsExist(err) {
+			return "", fmt.Errorf("Failed to create build log directory (%s), error: %s", dir, err)
+		}
+		name = now.Format(dirNameTimeLayout) + "-" + strconv.Itoa(counter)
+	}
+}
+
+// Rotate removes the oldest build log directories of logsDir, keeping the newest keepCount ones.
+// Other files and directories of logsDir are kept.
+func Rotate(logsDir string, keepCount int) error {
+	infos, err := ioutil.ReadDir(logsDir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Failed to list build logs (%s), error: %s", logsDir, err)
+	}
+
+	type buildLogDir struct {
+		name      string
+		timestamp string
+		counter   int
+	}
+	dirs := []buildLogDir{}
+	for _, anInfo := range infos {
+		match := buildLogDirRegexp.FindStringSubmatch(anInfo.Name())
+		if !anInfo.IsDir() || match == nil {
+			continue
+		}
+		dir := buildLogDir{name: anInfo.Name(), timestamp: match[1], counter: 1}
+		if match[2] != "" {
+			counter, err := strconv.Atoi(match[2])
+			if err != nil {
+				return fmt.Errorf("Invalid build log directory name (%s), error: %s", anInfo.Name(), err)
+			}
+			dir.counter = counter
+		}
+		dirs = append(dirs, dir)
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].timestamp != dirs[j].timestamp {
+			return dirs[i].timestamp < dirs[j].timestamp
+		}
+		return dirs[i].counter < dirs[j].counter
+	})
+
+	for idx := 0; idx < len(dirs)-keepCount; idx++ {
+		if err := os.RemoveAll(filepath.Join(logsDir, dirs[idx].name)); err != nil {
+			return fmt.Errorf("Failed to remove old build log (%s), error: %s", dirs[idx].name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package buildlog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/stretchr/testify/require"
+)
+
+func dirNames(t *testing.T, dir string) []string {
+	infos, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, anInfo := range infos {
+		names = append(names, anInfo.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestRotate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("missing logs dir")
+	{
+		require.NoError(t, Rotate(filepath.Join(tmpDir, "missing"), 1))
+	}
+
+	t.Log("keeps the newest build logs, and everything else")
+	{
+		for _, aName := range []string{"20170102-150405-10", "20170102-150405", "20170103-090000", "20170102-150405-2", "notes"} {
+			require.NoError(t, os.Mkdir(filepath.Join(tmpDir, aName), 0755))
+		}
+		require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "20170101-000000"), []byte{}, 0600))
+
+		require.NoError(t, Rotate(tmpDir, 2))
+		require.Equal(t, []string{"20170101-000000", "20170102-150405-10", "20170103-090000", "notes"}, dirNames(t, tmpDir))
+
+		require.NoError(t, Rotate(tmpDir, 0))
+		require.Equal(t, []string{"20170101-000000", "notes"}, dirNames(t, tmpDir))
+	}
+}
+
+func TestStart(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	logsDir := filepath.Join(tmpDir, "_out", "logs")
+	now := time.Date(2017, 1, 2, 15, 4, 5, 0, time.Local)
+
+	t.Log("captures the output of replica and of the commands")
+	{
+		session, err := Start(logsDir, 2, now)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(logsDir, "20170102-150405"), session.Dir)
+		require.Equal(t, filepath.Join(logsDir, "20170102-150405", "packer.log"), session.PackerLogPath())
+
+		fmt.Println(colorstring.Green("replica output"))
+		log.SetFlags(0)
+		log.Println("replica log")
+		cmdErr := cmdex.NewCommandWithStandardOuts("sh", "-c", "echo command stdout; echo command stderr >&2").Run()
+		require.NoError(t, session.Close())
+		log.SetFlags(log.LstdFlags)
+		require.NoError(t, cmdErr)
+
+		content, err := ioutil.ReadFile(filepath.Join(session.Dir, ReplicaLogFileName))
+		require.NoError(t, err)
+		for _, aLine := range []string{"replica output\n", "replica log\n", "command stdout\n", "command stderr\n"} {
+			require.Contains(t, string(content), aLine)
+		}
+		require.NotContains(t, string(content), "\x1b[")
+	}
+
+	t.Log("a second run in the same second")
+	{
+		session, err := Start(logsDir, 2, now)
+		require.NoError(t, err)
+		require.NoError(t, session.Close())
+		require.Equal(t, filepath.Join(logsDir, "20170102-150405-2"), session.Dir)
+	}
+
+	t.Log("rotates the old logs")
+	{
+		session, err := Start(logsDir, 2, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, session.Close())
+		require.Equal(t, []string{"20170102-150405-2", "20170102-160405"}, dirNames(t, logsDir))
+	}
+
+	t.Log("invalid keep count")
+	{
+		_, err := Start(logsDir, 0, now)
+		require.Error(t, err)
+	}
+}
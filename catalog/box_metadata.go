@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+)
+
+// boxMetadataFileName is the metadata file of a vagrant box, with the provider of the box
+const boxMetadataFileName = "metadata.json"
+
+// gzipMagic are the first bytes of a gzip compressed file
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// BoxMetadataModel is the metadata.json of a vagrant box
+type BoxMetadataModel struct {
+	Provider string `json:"provider"`
+}
+
+// ReadBoxMetadata reads the metadata.json of the vagrant box file (a tar or a gzip compressed tar).
+// The files of the box are read until the metadata is found, packer writes it before the disk image.
+func ReadBoxMetadata(boxPath string) (BoxMetadataModel, error) {
+	file, err := os.Open(boxPath)
+	if err != nil {
+		return BoxMetadataModel{}, fmt.Errorf("Failed to open the box (%s), error: %s", boxPath, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf(" [!] Failed to close the box (%s), error: %s", boxPath, err)
+		}
+	}()
+
+	reader := bufio.NewReader(file)
+	var content io.Reader = reader
+	if magic, err := reader.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return BoxMetadataModel{}, fmt.Errorf("Failed to decompress the box (%s), error: %s", boxPath, err)
+		}
+		content = gzipReader
+	}
+
+	metadata, err := readBoxMetadataFromTar(tar.NewReader(content))
+	if err != nil {
+		return BoxMetadataModel{}, fmt.Errorf("Invalid box (%s), error: %s", boxPath, err)
+	}
+	return metadata, nil
+}
+
+func readBoxMetadataFromTar(tarReader *tar.Reader) (BoxMetadataModel, error) {
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return BoxMetadataModel{}, fmt.Errorf("no %s found in the box", boxMetadataFileName)
+		} else if err != nil {
+			return BoxMetadataModel{}, fmt.Errorf("failed to read the box, error: %s", err)
+		}
+		if path.Clean(header.Name) != boxMetadataFileName {
+			continue
+		}
+
+		var metadata BoxMetadataModel
+		if err := json.NewDecoder(tarReader).Decode(&metadata); err != nil {
+			return BoxMetadataModel{}, fmt.Errorf("failed to parse the %s of the box, error: %s", boxMetadataFileName, err)
+		}
+		if metadata.Provider == "" {
+			return BoxMetadataModel{}, errors.New("no provider defined in the " + boxMetadataFileName + " of the box")
+		}
+		return metadata, nil
+	}
+}
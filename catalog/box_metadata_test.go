@@ -0,0 +1,91 @@
+package catalog
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestBox(t *testing.T, pth string, isGzip bool, files map[string]string) {
+	file, err := os.Create(pth)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, file.Close())
+	}()
+
+	var out io.Writer = file
+	if isGzip {
+		gzipWriter := gzip.NewWriter(file)
+		defer func() {
+			require.NoError(t, gzipWriter.Close())
+		}()
+		out = gzipWriter
+	}
+
+	tarWriter := tar.NewWriter(out)
+	for _, aName := range []string{"./Vagrantfile", "./metadata.json", "metadata.json", "box.ovf"} {
+		content, isFound := files[aName]
+		if !isFound {
+			continue
+		}
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: aName, Mode: 0644, Size: int64(len(content))}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+}
+
+func TestReadBoxMetadata(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("gzip compressed box")
+	{
+		boxPath := filepath.Join(tmpDir, "vmware.box")
+		writeTestBox(t, boxPath, true, map[string]string{
+			"./Vagrantfile":   "Vagrant.configure(2) do |config|\nend\n",
+			"./metadata.json": `{"provider": "vmware_desktop"}`,
+		})
+
+		metadata, err := ReadBoxMetadata(boxPath)
+		require.NoError(t, err)
+		require.Equal(t, BoxMetadataModel{Provider: "vmware_desktop"}, metadata)
+	}
+
+	t.Log("uncompressed box")
+	{
+		boxPath := filepath.Join(tmpDir, "virtualbox.box")
+		writeTestBox(t, boxPath, false, map[string]string{
+			"metadata.json": `{"provider": "virtualbox"}`,
+			"box.ovf":       "<ovf/>",
+		})
+
+		metadata, err := ReadBoxMetadata(boxPath)
+		require.NoError(t, err)
+		require.Equal(t, "virtualbox", metadata.Provider)
+	}
+
+	t.Log("invalid boxes")
+	{
+		noMetadataPath := filepath.Join(tmpDir, "no-metadata.box")
+		writeTestBox(t, noMetadataPath, true, map[string]string{"box.ovf": "<ovf/>"})
+		noProviderPath := filepath.Join(tmpDir, "no-provider.box")
+		writeTestBox(t, noProviderPath, true, map[string]string{"metadata.json": `{}`})
+		notTarPath := filepath.Join(tmpDir, "not-tar.box")
+		require.NoError(t, ioutil.WriteFile(notTarPath, []byte("box"), 0600))
+
+		for _, aPath := range []string{noMetadataPath, noProviderPath, notTarPath, filepath.Join(tmpDir, "missing.box")} {
+			_, err := ReadBoxMetadata(aPath)
+			require.Error(t, err, aPath)
+		}
+	}
+}
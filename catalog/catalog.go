@@ -0,0 +1,270 @@
+package catalog
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+// ChecksumTypeSHA256 is the checksum type of the boxes in the catalogs created by replica
+const ChecksumTypeSHA256 = "sha256"
+
+// boxNameRegexp matches the box names vagrant accepts in a catalog, e.g. bitrise/macos-sierra
+var boxNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)?$`)
+
+// versionRegexp matches the box versions vagrant accepts: numbers, separated by dots
+var versionRegexp = regexp.MustCompile(`^\d+(\.\d+)*$`)
+
+// CatalogModel is a vagrant box catalog (metadata.json),
+// which can be added with: vagrant box add URL
+type CatalogModel struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Versions    []VersionModel `json:"versions"`
+}
+
+// VersionModel is a version of the box, with a box file per provider
+type VersionModel struct {
+	Version     string          `json:"version"`
+	Description string          `json:"description,omitempty"`
+	Providers   []ProviderModel `json:"providers"`
+}
+
+// ProviderModel is a box file of a version, for a vagrant provider
+type ProviderModel struct {
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+	ChecksumType string `json:"checksum_type,omitempty"`
+	Checksum     string `json:"checksum,omitempty"`
+}
+
+// New returns an empty catalog of the box
+func New(name string) (CatalogModel, error) {
+	if name == "" {
+		return CatalogModel{}, fmt.Errorf("No box name provided")
+	}
+	if !boxNameRegexp.MatchString(name) {
+		return CatalogModel{}, fmt.Errorf("Invalid box name (%s), should be in the format: organization/name", name)
+	}
+	return CatalogModel{Name: name, Versions: []VersionModel{}}, nil
+}
+
+// ReadCatalogFromFile reads the catalog of the path
+func ReadCatalogFromFile(pth string) (CatalogModel, error) {
+	content, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return CatalogModel{}, fmt.Errorf("Failed to read catalog (%s), error: %s", pth, err)
+	}
+	return Parse(content)
+}
+
+// Parse parses the JSON catalog
+func Parse(content []byte) (CatalogModel, error) {
+	var catalog CatalogModel
+	if err := json.Unmarshal(content, &catalog); err != nil {
+		return CatalogModel{}, fmt.Errorf("Failed to parse catalog, error: %s", err)
+	}
+	if catalog.Versions == nil {
+		catalog.Versions = []VersionModel{}
+	}
+	return catalog, nil
+}
+
+// ReadOrCreateCatalog reads the catalog of the path, or returns a new catalog
+// of the box, if the file does not exist yet. The name of an existing catalog has to match.
+func ReadOrCreateCatalog(pth, name string) (CatalogModel, error) {
+	if _, err := os.Stat(pth); os.IsNotExist(err) {
+		return New(name)
+	} else if err != nil {
+		return CatalogModel{}, fmt.Errorf("Failed to check whether the catalog (%s) exists, error: %s", pth, err)
+	}
+
+	catalog, err := ReadCatalogFromFile(pth)
+	if err != nil {
+		return CatalogModel{}, err
+	}
+	if name != "" && catalog.Name != name {
+		return CatalogModel{}, fmt.Errorf("The catalog (%s) belongs to another box (%s), not to %s", pth, catalog.Name, name)
+	}
+	return catalog, nil
+}
+
+// Serialize returns the JSON of the catalog
+func (catalog CatalogModel) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(catalog); err != nil {
+		return nil, fmt.Errorf("Failed to serialize catalog, error: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteToFile writes the catalog into the file of the path
+func (catalog CatalogModel) WriteToFile(pth string) error {
+	content, err := catalog.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := fileutil.WriteBytesToFile(pth, content); err != nil {
+		return fmt.Errorf("Failed to write catalog (%s), error: %s", pth, err)
+	}
+	return nil
+}
+
+// AddBox adds the box file of the provider to the version. The version is created if it
+// does not exist yet, the other versions are kept as they are.
+// A different box of the same version and provider is only replaced if isReplace is true,
+// as vagrant does not update a version it already downloaded.
+func (catalog *CatalogModel) AddBox(version string, provider ProviderModel, isReplace bool) error {
+	if err := ValidateVersion(version); err != nil {
+		return err
+	}
+	if provider.Name == "" || provider.URL == "" {
+		return fmt.Errorf("The provider name and the URL of the box are required")
+	}
+
+	versionIdx := -1
+	for idx, aVersion := range catalog.Versions {
+		if aVersion.Version == version {
+			versionIdx = idx
+			break
+		}
+	}
+	if versionIdx == -1 {
+		catalog.Versions = append(catalog.Versions, VersionModel{Version: version, Providers: []ProviderModel{}})
+		versionIdx = len(catalog.Versions) - 1
+	}
+	catalogVersion := &catalog.Versions[versionIdx]
+
+	for idx, aProvider := range catalogVersion.Providers {
+		if aProvider.Name != provider.Name {
+			continue
+		}
+		if aProvider == provider {
+			return nil
+		}
+		if !isReplace {
+			return fmt.Errorf("Version %s already has a different %s box (%s)", version, provider.Name, aProvider.URL)
+		}
+		catalogVersion.Providers[idx] = provider
+		return nil
+	}
+
+	catalogVersion.Providers = append(catalogVersion.Providers, provider)
+	sort.Slice(catalogVersion.Providers, func(i, j int) bool {
+		return catalogVersion.Providers[i].Name < catalogVersion.Providers[j].Name
+	})
+	catalog.sortVersions()
+	return nil
+}
+
+// ValidateVersion checks whether vagrant accepts the box version
+func ValidateVersion(version string) error {
+	if !versionRegexp.MatchString(version) {
+		return fmt.Errorf("Invalid version (%s), should be numbers separated by dots, e.g. 10.12.4", version)
+	}
+	return nil
+}
+
+// LatestVersion returns the newest version of the catalog, if any
+func (catalog CatalogModel) LatestVersion() (VersionModel, bool) {
+	if len(catalog.Versions) == 0 {
+		return VersionModel{}, false
+	}
+	latest := catalog.Versions[0]
+	for _, aVersion := range catalog.Versions[1:] {
+		if CompareVersions(aVersion.Version, latest.Version) > 0 {
+			latest = aVersion
+		}
+	}
+	return latest, true
+}
+
+// sortVersions sorts the versions in ascending order
+func (catalog *CatalogModel) sortVersions() {
+	sort.SliceStable(catalog.Versions, func(i, j int) bool {
+		return CompareVersions(catalog.Versions[i].Version, catalog.Versions[j].Version) < 0
+	})
+}
+
+// CompareVersions compares two dot separated numeric versions,
+// returns -1 if a is older, 1 if a is newer, 0 if they are the same
+func CompareVersions(a, b string) int {
+	aSegments, bSegments := versionSegments(a), versionSegments(b)
+	for idx := 0; idx < len(aSegments) || idx < len(bSegments); idx++ {
+		aSegment, bSegment := 0, 0
+		if idx < len(aSegments) {
+			aSegment = aSegments[idx]
+		}
+		if idx < len(bSegments) {
+			bSegment = bSegments[idx]
+		}
+		if aSegment < bSegment {
+			return -1
+		}
+		if aSegment > bSegment {
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionSegments returns the numbers of the version, a non numeric segment counts as 0
+func versionSegments(version string) []int {
+	segments := []int{}
+	for _, aSegment := range strings.Split(version, ".") {
+		number, err := strconv.Atoi(aSegment)
+		if err != nil {
+			number = 0
+		}
+		segments = append(segments, number)
+	}
+	return segments
+}
+
+// SHA256OfFile returns the hex encoded SHA-256 checksum of the file
+func SHA256OfFile(pth string) (string, error) {
+	file, err := os.Open(pth)
+	if err != nil {
+		return "", fmt.Errorf("Failed to open file (%s), error: %s", pth, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf(" [!] Failed to close file (%s), error: %s", pth, err)
+		}
+	}()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("Failed to calculate the checksum of the file (%s), error: %s", pth, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// NewProviderOfBox returns the provider entry of the box file, with its SHA-256 checksum
+func NewProviderOfBox(providerName, url, boxPath string) (ProviderModel, error) {
+	checksum, err := SHA256OfFile(boxPath)
+	if err != nil {
+		return ProviderModel{}, err
+	}
+	return ProviderModel{
+		Name:         providerName,
+		URL:          url,
+		ChecksumType: ChecksumTypeSHA256,
+		Checksum:     checksum,
+	}, nil
+}
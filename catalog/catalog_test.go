@@ -0,0 +1,174 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	catalog, err := New("myorg/macos-sierra")
+	require.NoError(t, err)
+	require.Equal(t, CatalogModel{Name: "myorg/macos-sierra", Versions: []VersionModel{}}, catalog)
+
+	for _, aName := range []string{"", "myorg/macos sierra", "myorg/macos/sierra"} {
+		_, err := New(aName)
+		require.Error(t, err, aName)
+	}
+}
+
+func TestCatalogModel_AddBox(t *testing.T) {
+	virtualbox := ProviderModel{Name: "virtualbox", URL: "https://boxes.example.com/10.12.4/virtualbox.box", ChecksumType: ChecksumTypeSHA256, Checksum: "aaa"}
+	vmware := ProviderModel{Name: "vmware", URL: "https://boxes.example.com/10.12.4/vmware.box", ChecksumType: ChecksumTypeSHA256, Checksum: "bbb"}
+	older := ProviderModel{Name: "virtualbox", URL: "https://boxes.example.com/10.12.3/virtualbox.box", ChecksumType: ChecksumTypeSHA256, Checksum: "ccc"}
+
+	catalog, err := New("myorg/macos-sierra")
+	require.NoError(t, err)
+
+	t.Log("new versions and providers")
+	{
+		require.NoError(t, catalog.AddBox("10.12.4", vmware, false))
+		require.NoError(t, catalog.AddBox("10.12.4", virtualbox, false))
+		require.NoError(t, catalog.AddBox("10.12.3", older, false))
+		require.NoError(t, catalog.AddBox("10.12.10", older, false))
+
+		require.Equal(t, []VersionModel{
+			{Version: "10.12.3", Providers: []ProviderModel{older}},
+			{Version: "10.12.4", Providers: []ProviderModel{virtualbox, vmware}},
+			{Version: "10.12.10", Providers: []ProviderModel{older}},
+		}, catalog.Versions)
+
+		latest, isFound := catalog.LatestVersion()
+		require.True(t, isFound)
+		require.Equal(t, "10.12.10", latest.Version)
+	}
+
+	t.Log("the same box again")
+	{
+		require.NoError(t, catalog.AddBox("10.12.4", virtualbox, false))
+		require.Equal(t, []ProviderModel{virtualbox, vmware}, catalog.Versions[1].Providers)
+	}
+
+	t.Log("a different box of an existing version and provider")
+	{
+		rebuilt := virtualbox
+		rebuilt.Checksum = "ddd"
+		require.Error(t, catalog.AddBox("10.12.4", rebuilt, false))
+		require.Equal(t, "aaa", catalog.Versions[1].Providers[0].Checksum)
+
+		require.NoError(t, catalog.AddBox("10.12.4", rebuilt, true))
+		require.Equal(t, []ProviderModel{rebuilt, vmware}, catalog.Versions[1].Providers)
+	}
+
+	t.Log("invalid inputs")
+	{
+		require.Error(t, catalog.AddBox("10.12.4-beta", virtualbox, false))
+		require.Error(t, catalog.AddBox("", virtualbox, false))
+		require.Error(t, catalog.AddBox("10.12.5", ProviderModel{Name: "virtualbox"}, false))
+		require.Equal(t, 3, len(catalog.Versions))
+	}
+}
+
+func TestReadOrCreateCatalog(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	catalogPath := filepath.Join(tmpDir, "catalog.json")
+
+	t.Log("new catalog")
+	{
+		catalog, err := ReadOrCreateCatalog(catalogPath, "myorg/macos-sierra")
+		require.NoError(t, err)
+		require.Equal(t, "myorg/macos-sierra", catalog.Name)
+
+		_, err = ReadOrCreateCatalog(catalogPath, "")
+		require.Error(t, err)
+	}
+
+	t.Log("existing catalog, older versions are kept intact")
+	{
+		require.NoError(t, ioutil.WriteFile(catalogPath, []byte(`{
+  "name": "myorg/macos-sierra",
+  "description": "macOS Sierra",
+  "versions": [
+    {
+      "version": "10.12.3",
+      "description": "first build",
+      "providers": [
+        {"name": "virtualbox", "url": "https://boxes.example.com/old.box", "checksum_type": "md5", "checksum": "abc"}
+      ]
+    }
+  ]
+}`), 0600))
+
+		catalog, err := ReadOrCreateCatalog(catalogPath, "")
+		require.NoError(t, err)
+		require.NoError(t, catalog.AddBox("10.12.4", ProviderModel{Name: "virtualbox", URL: "https://boxes.example.com/new.box", ChecksumType: ChecksumTypeSHA256, Checksum: "def"}, false))
+		require.NoError(t, catalog.WriteToFile(catalogPath))
+
+		content, err := ioutil.ReadFile(catalogPath)
+		require.NoError(t, err)
+		require.Equal(t, `{
+  "name": "myorg/macos-sierra",
+  "description": "macOS Sierra",
+  "versions": [
+    {
+      "version": "10.12.3",
+      "description": "first build",
+      "providers": [
+        {
+          "name": "virtualbox",
+          "url": "https://boxes.example.com/old.box",
+          "checksum_type": "md5",
+          "checksum": "abc"
+        }
+      ]
+    },
+    {
+      "version": "10.12.4",
+      "providers": [
+        {
+          "name": "virtualbox",
+          "url": "https://boxes.example.com/new.box",
+          "checksum_type": "sha256",
+          "checksum": "def"
+        }
+      ]
+    }
+  ]
+}
+`, string(content))
+
+		_, err = ReadOrCreateCatalog(catalogPath, "myorg/macos-high-sierra")
+		require.Error(t, err)
+	}
+}
+
+func TestNewProviderOfBox(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	boxPath := filepath.Join(tmpDir, "packer_virtualbox-iso_virtualbox.box")
+	require.NoError(t, ioutil.WriteFile(boxPath, []byte("box"), 0600))
+
+	provider, err := NewProviderOfBox("virtualbox", "https://boxes.example.com/a.box", boxPath)
+	require.NoError(t, err)
+	require.Equal(t, ProviderModel{
+		Name:         "virtualbox",
+		URL:          "https://boxes.example.com/a.box",
+		ChecksumType: "sha256",
+		Checksum:     "26f8567f2569182294c3fa5b9f9cb2270b554eef628b4c149cf82a42888ff4ae",
+	}, provider)
+
+	_, err = NewProviderOfBox("virtualbox", "https://boxes.example.com/a.box", filepath.Join(tmpDir, "missing.box"))
+	require.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// artifactsGroupCmd groups the commands which manage the build artifacts (DMGs, vagrant boxes)
+var artifactsGroupCmd = &cobra.Command{
+	Use:   "artifacts",
+	Short: "Manage the build artifacts (installer DMGs and vagrant boxes)",
+	Long: `Manage the build artifacts (installer DMGs and vagrant boxes)
+of the output directory (default: ./_out).`,
+}
+
+func init() {
+	RootCmd.AddCommand(artifactsGroupCmd)
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultArtifactsDir = "./_out"
+	outputFormatTable   = "table"
+	outputFormatJSON    = "json"
+)
+
+var (
+	flagArtifactsFormat         = outputFormatTable
+	flagArtifactsIsSkipChecksum = false
+)
+
+// artifactsListCmd represents the artifacts list command
+var artifactsListCmd = &cobra.Command{
+	Use:   "list [OUTPUT_DIR]",
+	Short: "List the installer DMGs and the vagrant boxes of the output directory",
+	Long: `List the installer DMGs and the vagrant boxes of the output directory (default: ./_out),
+with their macOS version, size, creation time, SHA-256 checksum and customization settings,
+and whether the box is registered in vagrant.
+
+The checksums are cached next to the artifacts (*.replica.json), they are only
+calculated again if the file changes.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		outDir := defaultArtifactsDir
+		if len(args) > 0 {
+			outDir = args[0]
+		}
+		return listArtifacts(outDir)
+	},
+}
+
+func init() {
+	artifactsGroupCmd.AddCommand(artifactsListCmd)
+	artifactsListCmd.Flags().StringVar(&flagArtifactsFormat, "format", outputFormatTable, "Output format: table or json")
+	artifactsListCmd.Flags().BoolVar(&flagArtifactsIsSkipChecksum, "skip-checksum", false, "Do not calculate the missing checksums, only print the cached ones")
+}
+
+// scanArtifacts returns the artifacts of the output directory,
+// and marks the boxes which are registered in vagrant, or used by a VM.
+// If isVagrantRequired, failing to list the vagrant boxes or VMs is an error,
+// otherwise the boxes are only reported as not registered.
+func scanArtifacts(outDir string, isSkipChecksum, isVagrantRequired bool) ([]artifacts.ArtifactModel, error) {
+	if info, err := os.Stat(outDir); err != nil {
+		return nil, fmt.Errorf("Failed to check the output directory (%s), error: %s", outDir, err)
+	} else if !info.IsDir() {
+		return nil, fmt.Errorf("The output directory (%s) is not a directory", outDir)
+	}
+
+	vagrantBoxes, err := vagrantcli.ListBoxes()
+	if err != nil && isVagrantRequired {
+		return nil, fmt.Errorf("Failed to list the registered vagrant boxes, error: %s", err)
+	} else if err != nil {
+		log.Println(colorstring.Yellow(" [!] Failed to list the registered vagrant boxes, error:"), err)
+	}
+	vms, err := vagrantcli.ListMachines()
+	if err != nil && isVagrantRequired {
+		return nil, fmt.Errorf("Failed to list the vagrant VMs, error: %s", err)
+	} else if err != nil {
+		log.Println(colorstring.Yellow(" [!] Failed to list the vagrant VMs, error:"), err)
+	}
+	return artifacts.Scan(outDir, artifacts.ScanOptions{
+		IsSkipChecksum: isSkipChecksum,
+		VagrantBoxes:   vagrantBoxes,
+		VMs:            vms,
+	})
+}
+
+// formatSettings formats the settings of the artifact, sorted by name
+func formatSettings(settings map[string]string) string {
+	names := []string{}
+	for aName := range settings {
+		names = append(names, aName)
+	}
+	sort.Strings(names)
+
+	formatted := []string{}
+	for _, aName := range names {
+		formatted = append(formatted, aName+"="+settings[aName])
+	}
+	return strings.Join(formatted, "; ")
+}
+
+func printArtifactsTable(artifactList []artifacts.ArtifactModel) error {
+	table := "KIND\tVERSION\tBUILD\tSIZE\tCREATED\tSHA256\tREGISTERED\tPATH\tSETTINGS\n"
+	for _, anArtifact := range artifactList {
+		checksum := anArtifact.Checksum
+		if len(checksum) > 12 {
+			checksum = checksum[:12]
+		}
+		registeredAs := anArtifact.RegisteredAs
+		if registeredAs == "" && len(anArtifact.UnverifiedRegistrations) > 0 {
+			registeredAs = strings.Join(anArtifact.UnverifiedRegistrations, ", ") + "?"
+		}
+		if len(anArtifact.UsedByVMs) > 0 {
+			registeredAs = strings.TrimSpace(fmt.Sprintf("%s (%d VMs)", registeredAs, len(anArtifact.UsedByVMs)))
+		}
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			anArtifact.Kind,
+			valueOrDash(anArtifact.MacOSVersion),
+			valueOrDash(anArtifact.MacOSBuild),
+			artifacts.HumanSize(anArtifact.Size),
+			anArtifact.CreatedAt.Format("2006-01-02 15:04"),
+			valueOrDash(checksum),
+			valueOrDash(registeredAs),
+			anArtifact.Path,
+			valueOrDash(formatSettings(anArtifact.Settings)),
+		)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print artifacts, error: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print artifacts, error: %s", err)
+	}
+	return nil
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func listArtifacts(outDir string) error {
+	if flagArtifactsFormat != outputFormatTable && flagArtifactsFormat != outputFormatJSON {
+		return errors.New("Invalid output format (--format): " + flagArtifactsFormat + ", should be table or json")
+	}
+
+	artifactList, err := scanArtifacts(outDir, flagArtifactsIsSkipChecksum, false)
+	if err != nil {
+		return err
+	}
+
+	if flagArtifactsFormat == outputFormatJSON {
+		content, err := json.MarshalIndent(artifactList, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Failed to serialize artifacts, error: %s", err)
+		}
+		if _, err := fmt.Fprintln(os.Stdout, string(content)); err != nil {
+			return fmt.Errorf("Failed to print artifacts, error: %s", err)
+		}
+		return nil
+	}
+
+	if len(artifactList) == 0 {
+		log.Println(colorstring.Yellow(" => No DMGs or vagrant boxes found in:"), outDir)
+		return nil
+	}
+	return printArtifactsTable(artifactList)
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/goinp/goinp"
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagPruneKeepPerMinor = 0
+	flagPruneMaxSize      = ""
+	flagPruneMaxAge       = ""
+	flagPruneIsDryRun     = false
+	flagPruneIsYes        = false
+)
+
+// artifactsPruneCmd represents the artifacts prune command
+var artifactsPruneCmd = &cobra.Command{
+	Use:   "prune [OUTPUT_DIR]",
+	Short: "Delete the old installer DMGs and vagrant boxes of the output directory",
+	Long: `Delete the old installer DMGs and vagrant boxes of the output directory (default: ./_out).
+
+An artifact is deleted if any of the rules selects it:
+  --keep-per-minor N : keep only the newest N DMGs and N boxes of every macOS minor version (e.g. 10.12)
+  --max-size SIZE    : delete the oldest artifacts, until their total size is under SIZE (e.g. 100GB)
+  --max-age AGE      : delete the artifacts older than AGE (e.g. 30d)
+
+The boxes registered in vagrant, or used by a vagrant VM are always kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		outDir := defaultArtifactsDir
+		if len(args) > 0 {
+			outDir = args[0]
+		}
+		return pruneArtifacts(outDir)
+	},
+}
+
+func init() {
+	artifactsGroupCmd.AddCommand(artifactsPruneCmd)
+	artifactsPruneCmd.Flags().IntVar(&flagPruneKeepPerMinor, "keep-per-minor", 0, "Keep only the newest N DMGs and boxes of every macOS minor version")
+	artifactsPruneCmd.Flags().StringVar(&flagPruneMaxSize, "max-size", "", "Maximum total size of the artifacts, e.g. 100GB")
+	artifactsPruneCmd.Flags().StringVar(&flagPruneMaxAge, "max-age", "", "Maximum age of the artifacts, in days (e.g. 30d) or as a duration (e.g. 36h)")
+	artifactsPruneCmd.Flags().BoolVar(&flagPruneIsDryRun, "dry-run", false, "Only print what would be deleted")
+	artifactsPruneCmd.Flags().BoolVar(&flagPruneIsYes, "yes", false, "Do not ask for confirmation before deleting")
+}
+
+func prunePolicy() (artifacts.PrunePolicyModel, error) {
+	policy := artifacts.PrunePolicyModel{KeepPerMinorVersion: flagPruneKeepPerMinor}
+	if flagPruneKeepPerMinor < 0 {
+		return artifacts.PrunePolicyModel{}, errors.New("Invalid --keep-per-minor, should be a positive number")
+	}
+	if flagPruneMaxSize != "" {
+		maxSize, err := artifacts.ParseSize(flagPruneMaxSize)
+		if err != nil {
+			return artifacts.PrunePolicyModel{}, err
+		}
+		policy.MaxTotalSize = maxSize
+	}
+	if flagPruneMaxAge != "" {
+		maxAge, err := artifacts.ParseAge(flagPruneMaxAge)
+		if err != nil {
+			return artifacts.PrunePolicyModel{}, err
+		}
+		policy.MaxAge = maxAge
+	}
+	if policy.IsEmpty() {
+		return artifacts.PrunePolicyModel{}, errors.New("No retention rule provided, use --keep-per-minor, --max-size or --max-age")
+	}
+	return policy, nil
+}
+
+func printPruneCandidates(candidates []artifacts.PruneCandidateModel) error {
+	table := "KIND\tVERSION\tSIZE\tCREATED\tREASON\tPATH\n"
+	for _, aCandidate := range candidates {
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\n",
+			aCandidate.Artifact.Kind,
+			valueOrDash(aCandidate.Artifact.MacOSVersion),
+			artifacts.HumanSize(aCandidate.Artifact.Size),
+			aCandidate.Artifact.CreatedAt.Format("2006-01-02 15:04"),
+			aCandidate.Reason,
+			aCandidate.Artifact.Path,
+		)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print artifacts, error: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print artifacts, error: %s", err)
+	}
+	return nil
+}
+
+func pruneArtifacts(outDir string) error {
+	policy, err := prunePolicy()
+	if err != nil {
+		return err
+	}
+
+	// without the vagrant boxes and VMs the boxes in use could not be kept
+	artifactList, err := scanArtifacts(outDir, true, true)
+	if err != nil {
+		return fmt.Errorf("%s, nothing is deleted", err)
+	}
+	for _, anArtifact := range artifactList {
+		if !anArtifact.IsInUse() {
+			continue
+		}
+		usedBy := []string{}
+		if anArtifact.RegisteredAs != "" {
+			usedBy = append(usedBy, "registered in vagrant as "+anArtifact.RegisteredAs)
+		}
+		for _, aVMDir := range anArtifact.UsedByVMs {
+			usedBy = append(usedBy, "used by the VM at "+aVMDir)
+		}
+		if len(anArtifact.UnverifiedRegistrations) > 0 {
+			usedBy = append(usedBy, "may be registered in vagrant as "+strings.Join(anArtifact.UnverifiedRegistrations, ", "))
+		}
+		log.Printf(" => Keeping %s (%s)", anArtifact.Path, strings.Join(usedBy, ", "))
+	}
+
+	candidates := artifacts.SelectPrunable(artifactList, policy, time.Now())
+	if len(candidates) == 0 {
+		log.Println(colorstring.Green(" => Nothing to delete"))
+		return nil
+	}
+	freedSize := int64(0)
+	for _, aCandidate := range candidates {
+		freedSize += aCandidate.Artifact.Size
+	}
+
+	fmt.Println()
+	if err := printPruneCandidates(candidates); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if flagPruneIsDryRun {
+		log.Println(colorstring.Yellow(fmt.Sprintf(" => Dry run: %d artifact(s) would be deleted, freeing %s", len(candidates), artifacts.HumanSize(freedSize))))
+		return nil
+	}
+	if !flagPruneIsYes {
+		isDelete, err := goinp.AskForBoolWithDefault(fmt.Sprintf("Do you want to delete %d artifact(s), freeing %s?", len(candidates), artifacts.HumanSize(freedSize)), false)
+		if err != nil {
+			return fmt.Errorf("Failed to get confirmation, error: %s", err)
+		}
+		if !isDelete {
+			log.Println(colorstring.Yellow(" => Nothing deleted"))
+			return nil
+		}
+	}
+
+	for _, aCandidate := range candidates {
+		if err := artifacts.Delete(aCandidate.Artifact); err != nil {
+			return err
+		}
+		log.Println(" => Deleted:", aCandidate.Artifact.Path)
+	}
+	log.Println(colorstring.Green(fmt.Sprintf(" => %d artifact(s) deleted, %s freed", len(candidates), artifacts.HumanSize(freedSize))))
+	printFreeDiskSpace()
+	return nil
+}
@@ -4,13 +4,28 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
+	"text/tabwriter"
 
 	"github.com/bitrise-io/go-utils/colorstring"
 	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/hypervisor"
 	"github.com/bitrise-io/replica/vagrantbox"
 	"github.com/spf13/cobra"
 )
 
+var (
+	flagCLTPackagePath = ""
+	flagIsAutologin    = true
+	flagTrustedCAPaths = []string{}
+	flagHardware       = hypervisor.HardwareModel{}
+	flagAppleSMCOSK    = ""
+	flagQEMUFirmware   = ""
+	flagPackerVars     = []string{}
+	flagPackerVarFiles = []string{}
+	flagIsListVars     = false
+)
+
 // boxCmd represents the box command
 var boxCmd = &cobra.Command{
 	Use:   "box INSTALL_DMG_PATH",
@@ -19,6 +34,9 @@ var boxCmd = &cobra.Command{
 
 NOTE: You can create an auto installer DMG with: replica create dmg`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagIsListVars {
+			return printPackerVariables()
+		}
 		if len(args) < 1 {
 			return errors.New("No macOS installer DMG path provided")
 		}
@@ -30,6 +48,30 @@ NOTE: You can create an auto installer DMG with: replica create dmg`,
 
 func init() {
 	createCmd.AddCommand(boxCmd)
+	addBoxFlags(boxCmd)
+	boxCmd.Flags().BoolVar(&flagIsListVars, "list-vars", false, "List the variables of the packer template, with their default values, which can be set with --packer-var")
+}
+
+// addBoxFlags registers the flags of the vagrant box creation,
+// which are shared by every command which creates a vagrant box
+func addBoxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagAppleSMCOSK, "apple-smc-osk", "", "The Apple SMC key of the VM, required by the qemu provider (default: $APPLE_SMC_OSK)")
+	cmd.Flags().StringVar(&flagQEMUFirmware, "qemu-firmware", "", "The EFI firmware of the VM, with the qemu provider (default: the firmware of the Homebrew qemu package)")
+	cmd.Flags().BoolVar(&flagIsAutologin, "autologin", true, "Automatic GUI login of the vagrant user (disable with --autologin=false)")
+	cmd.Flags().StringVar(&flagCLTPackagePath, "clt-package", "", "Install the Xcode Command Line Tools from this local .dmg or .pkg file, instead of downloading it with softwareupdate")
+	cmd.Flags().StringSliceVar(&flagTrustedCAPaths, "trust-ca", []string{}, "PEM encoded root CA certificate to add to the system trust store of the box (can be specified multiple times)")
+	cmd.Flags().StringSliceVar(&flagPackerVars, "packer-var", []string{}, "Set a variable of the packer template, in the format: key=value (can be specified multiple times, see: --list-vars)")
+	cmd.Flags().StringSliceVar(&flagPackerVarFiles, "packer-var-file", []string{}, "JSON file with values of the packer template variables (can be specified multiple times, --packer-var overrides it)")
+
+	defaultHardware := hypervisor.DefaultHardware(hypervisor.VirtualBox{})
+	cmd.Flags().IntVar(&flagHardware.CPUs, "cpus", 0, fmt.Sprintf("Number of CPUs of the VM (default: %d)", defaultHardware.CPUs))
+	cmd.Flags().IntVar(&flagHardware.MemoryMB, "memory", 0, fmt.Sprintf("Memory size of the VM, in MB (default: %d)", defaultHardware.MemoryMB))
+	cmd.Flags().IntVar(&flagHardware.DiskSizeMB, "disk-size", 0, fmt.Sprintf("Disk size of the VM, in MB (default: %d)", defaultHardware.DiskSizeMB))
+	cmd.Flags().IntVar(&flagHardware.VRAMMB, "vram", 0, fmt.Sprintf("Video memory size of the VM, in MB (default: %d)", defaultHardware.VRAMMB))
+	cmd.Flags().StringVar(&flagHardware.Chipset, "chipset", "", fmt.Sprintf("Chipset of the VM: ich9 or piix3 with virtualbox, ich9 with qemu, can't be set with the other providers (default: %s)", defaultHardware.Chipset))
+	cmd.Flags().StringVar(&flagHardware.Firmware, "firmware", "", fmt.Sprintf("Firmware of the VM: efi, efi32 or efi64 with virtualbox, efi with vmware and qemu, can't be set with parallels (default: %s)", defaultHardware.Firmware))
+	cmd.Flags().StringVar(&flagHardware.BootWait, "boot-wait", "", fmt.Sprintf("Time to wait for the VM to boot (default: %s)", defaultHardware.BootWait))
+	cmd.Flags().StringVar(&flagHardware.SSHWaitTimeout, "ssh-wait-timeout", "", fmt.Sprintf("Time to wait for SSH to become available in the VM (default: %s)", defaultHardware.SSHWaitTimeout))
 }
 
 func createVagrantBox(macOSAutoInstallerDMGPath string) (string, error) {
@@ -41,9 +83,14 @@ func createVagrantBox(macOSAutoInstallerDMGPath string) (string, error) {
 	fmt.Println()
 	log.Println(colorstring.Green(" => Creating vagrant box, using auto-installer DMG:"), absInstallerDMGPth)
 
+	opts, err := vagrantBoxOptions()
+	if err != nil {
+		return "", err
+	}
+
 	printFreeDiskSpace()
 
-	vagrantBoxPath, err := vagrantbox.CreateVirtualboxVagrantBoxFromPreparedMacOSInstallDMG(absInstallerDMGPth)
+	vagrantBoxPath, err := vagrantbox.CreateVagrantBoxFromPreparedMacOSInstallDMG(absInstallerDMGPth, opts)
 	if err != nil {
 		return vagrantBoxPath, fmt.Errorf("Failed to create vagrant box, error: %s", err)
 	}
@@ -52,3 +99,90 @@ func createVagrantBox(macOSAutoInstallerDMGPath string) (string, error) {
 	log.Println(colorstring.Green(" => vagrant box ready! You can find it at:"), vagrantBoxPath)
 	return vagrantBoxPath, nil
 }
+
+// vagrantBoxOptions returns the options of the box, from the config file and the flags
+func vagrantBoxOptions() (vagrantbox.Options, error) {
+	conf, err := loadConfig()
+	if err != nil {
+		return vagrantbox.Options{}, fmt.Errorf("Failed to load config, error: %s", err)
+	}
+
+	provider, err := loadProvider()
+	if err != nil {
+		return vagrantbox.Options{}, err
+	}
+	appleSMCOSK := flagAppleSMCOSK
+	if appleSMCOSK == "" {
+		appleSMCOSK = os.Getenv("APPLE_SMC_OSK")
+	}
+
+	packerVars, err := loadPackerVars()
+	if err != nil {
+		return vagrantbox.Options{}, err
+	}
+
+	return vagrantbox.Options{
+		Provider:       provider,
+		AppleSMCOSK:    appleSMCOSK,
+		CLTPackagePath: flagCLTPackagePath,
+		IsAutologin:    flagIsAutologin,
+		TrustedCAPaths: flagTrustedCAPaths,
+		ProvisionSteps: conf.Box.Provisioning,
+		// the flags override the config file
+		Hardware:      conf.Box.Hardware.Merge(flagHardware),
+		PackerVars:    packerVars,
+		PackerLogPath: packerLogPath(),
+	}, nil
+}
+
+// loadPackerVars reads the packer variable files, in order, then applies the --packer-var values
+func loadPackerVars() (map[string]string, error) {
+	packerVars := map[string]string{}
+	for _, aVarFilePath := range flagPackerVarFiles {
+		fileVars, err := vagrantbox.ReadPackerVarFile(aVarFilePath)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range fileVars {
+			packerVars[name] = value
+		}
+	}
+
+	flagVars, err := vagrantbox.ParsePackerVars(flagPackerVars)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range flagVars {
+		packerVars[name] = value
+	}
+	return packerVars, nil
+}
+
+// printPackerVariables prints the variables of the packer template of the selected provider
+func printPackerVariables() error {
+	provider, err := loadProvider()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println(colorstring.Green("Variables of the packer template (provider: " + provider.Name() + "):"))
+	fmt.Println()
+	table := "NAME\tDEFAULT\tSET WITH\n"
+	for _, aVariable := range vagrantbox.PackerVariables(provider) {
+		setWith := "--packer-var " + aVariable.Name + "=VALUE"
+		if aVariable.ManagedBy != "" {
+			setWith = aVariable.ManagedBy
+		}
+		table += fmt.Sprintf("%s\t%q\t%s\n", aVariable.Name, aVariable.Default, setWith)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print packer variables, error: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print packer variables, error: %s", err)
+	}
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagCatalogPath        = ""
+	flagCatalogName        = ""
+	flagCatalogDescription = ""
+	flagBoxVersion         = ""
+	flagBoxURL             = ""
+	flagIsReplaceBox       = false
+)
+
+// boxCatalogCmd represents the box catalog command
+var boxCatalogCmd = &cobra.Command{
+	Use:   "catalog BOX_PATH",
+	Short: "Add a vagrant box to a box catalog (metadata.json)",
+	Long: `Add a vagrant box to a box catalog (metadata.json).
+
+The catalog is created if it does not exist yet. The box is added as a new version
+(or as a new provider of an existing version), the other versions are kept.
+The provider of the box is read from the metadata.json of the box.
+
+Once the catalog and the box are uploaded, the box can be added with:
+  vagrant box add https://example.com/catalog.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No vagrant box path provided")
+		}
+		return addBoxToCatalog(args[0])
+	},
+}
+
+func init() {
+	boxGroupCmd.AddCommand(boxCatalogCmd)
+	boxCatalogCmd.Flags().StringVar(&flagCatalogPath, "catalog", "", "Path of the catalog JSON to create or update (required)")
+	boxCatalogCmd.Flags().StringVar(&flagCatalogName, "name", "", "Name of the box, e.g. myorg/macos-sierra (required for a new catalog)")
+	boxCatalogCmd.Flags().StringVar(&flagCatalogDescription, "description", "", "Description of the box, stored in the catalog")
+	boxCatalogCmd.Flags().StringVar(&flagBoxVersion, "version", "", "Version of the box, numbers separated by dots, e.g. 10.12.4 (required)")
+	boxCatalogCmd.Flags().StringVar(&flagBoxURL, "url", "", "URL the box can be downloaded from (required)")
+	boxCatalogCmd.Flags().BoolVar(&flagIsReplaceBox, "replace", false, "Replace the box of the version and provider, if the catalog already has a different one")
+}
+
+func addBoxToCatalog(boxPath string) error {
+	if flagCatalogPath == "" {
+		return errors.New("No catalog path provided (--catalog)")
+	}
+	if flagBoxVersion == "" {
+		return errors.New("No box version provided (--version)")
+	}
+	if flagBoxURL == "" {
+		return errors.New("No box URL provided (--url)")
+	}
+
+	absBoxPath, err := pathutil.AbsPath(boxPath)
+	if err != nil {
+		return fmt.Errorf("Failed to get absolute path for the box (path was: %s), error: %s", boxPath, err)
+	}
+	if isExist, err := pathutil.IsPathExists(absBoxPath); err != nil {
+		return fmt.Errorf("Failed to check whether the box exists, error: %s", err)
+	} else if !isExist {
+		return fmt.Errorf("Vagrant box does not exist at path: %s", absBoxPath)
+	}
+
+	providerName, err := boxProviderOfFile(absBoxPath)
+	if err != nil {
+		return err
+	}
+
+	boxCatalog, err := catalog.ReadOrCreateCatalog(flagCatalogPath, flagCatalogName)
+	if err != nil {
+		return err
+	}
+	if flagCatalogDescription != "" {
+		boxCatalog.Description = flagCatalogDescription
+	}
+
+	log.Println(" => Calculating the checksum of the box:", absBoxPath)
+	boxProvider, err := catalog.NewProviderOfBox(providerName, flagBoxURL, absBoxPath)
+	if err != nil {
+		return err
+	}
+	if err := boxCatalog.AddBox(flagBoxVersion, boxProvider, flagIsReplaceBox); err != nil {
+		return err
+	}
+
+	if err := boxCatalog.WriteToFile(flagCatalogPath); err != nil {
+		return err
+	}
+	log.Println(colorstring.Green(" => Box added to the catalog:"), flagCatalogPath)
+	log.Printf("    %s %s (%s): %s", boxCatalog.Name, flagBoxVersion, boxProvider.Name, boxProvider.URL)
+	return nil
+}
+
+// boxProviderOfFile returns the provider of the box, from the metadata.json of the box.
+// If --provider is set, it has to match the provider of the box.
+func boxProviderOfFile(boxPath string) (string, error) {
+	metadata, err := catalog.ReadBoxMetadata(boxPath)
+	if err != nil {
+		return "", err
+	}
+	if flagProvider == "" {
+		return metadata.Provider, nil
+	}
+
+	provider, err := loadProvider()
+	if err != nil {
+		return "", err
+	}
+	if metadata.Provider != provider.BoxProvider() && metadata.Provider != provider.VagrantProvider() {
+		return "", fmt.Errorf("The box is a %s box, not a %s box (--provider)", metadata.Provider, provider.Name())
+	}
+	return metadata.Provider, nil
+}
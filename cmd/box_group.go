@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// boxGroupCmd groups the commands which manage the created vagrant boxes
+var boxGroupCmd = &cobra.Command{
+	Use:   "box",
+	Short: "Manage the created vagrant boxes",
+	Long: `Manage the created vagrant boxes.
+
+NOTE: You can create a vagrant box with: replica create box`,
+}
+
+func init() {
+	RootCmd.AddCommand(boxGroupCmd)
+}
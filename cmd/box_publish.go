@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/boxpublish"
+	"github.com/bitrise-io/replica/s3client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagPublishBucket      = ""
+	flagPublishEndpoint    = ""
+	flagPublishRegion      = ""
+	flagPublishIsPathStyle = false
+	flagPublishPrefix      = ""
+	flagPublishName        = ""
+	flagPublishVersion     = ""
+	flagPublishBaseURL     = ""
+	flagPublishIsReplace   = false
+	flagPublishPartSizeMB  = int64(s3client.DefaultPartSize / 1024 / 1024)
+)
+
+// boxPublishCmd represents the box publish command
+var boxPublishCmd = &cobra.Command{
+	Use:   "publish BOX_PATH",
+	Short: "Upload a vagrant box to S3 compatible storage, and add it to the box catalog next to it",
+	Long: `Upload a vagrant box to S3 compatible storage (AWS S3, MinIO, ...),
+and add it to the box catalog next to it.
+
+The layout of the bucket: PREFIX/BOX/VERSION/PROVIDER.box and PREFIX/BOX/catalog.json
+(e.g. vagrant/macos-sierra/10.12.4/virtualbox.box and vagrant/macos-sierra/catalog.json)
+
+The provider of the box is read from the metadata.json of the box.
+The box is uploaded in parts, an interrupted upload is resumed when the command is run again.
+The SHA-256 checksum of the box is stored as the sha256 metadata of the object.
+The catalog is only updated once the box is uploaded, and it is never overwritten
+with a stale version, if it's updated by someone else at the same time.
+
+The credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+and AWS_SESSION_TOKEN (optional) environment variables.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No vagrant box path provided")
+		}
+		return publishBox(args[0])
+	},
+}
+
+func init() {
+	boxGroupCmd.AddCommand(boxPublishCmd)
+	boxPublishCmd.Flags().StringVar(&flagPublishBucket, "bucket", "", "Name of the bucket (required)")
+	boxPublishCmd.Flags().StringVar(&flagPublishEndpoint, "endpoint", "", "URL of the storage, e.g. http://localhost:9000 for MinIO (default: AWS S3 of the region)")
+	boxPublishCmd.Flags().StringVar(&flagPublishRegion, "region", "", "Region of the bucket (default: $AWS_REGION, $AWS_DEFAULT_REGION or us-east-1)")
+	boxPublishCmd.Flags().BoolVar(&flagPublishIsPathStyle, "path-style", false, "Use path-style URLs (ENDPOINT/BUCKET/KEY), required by MinIO")
+	boxPublishCmd.Flags().StringVar(&flagPublishPrefix, "prefix", "", "Key prefix of the boxes in the bucket, e.g. vagrant")
+	boxPublishCmd.Flags().StringVar(&flagPublishName, "name", "", "Name of the box, e.g. macos-sierra (required)")
+	boxPublishCmd.Flags().StringVar(&flagPublishVersion, "version", "", "Version of the box, numbers separated by dots, e.g. 10.12.4 (required)")
+	boxPublishCmd.Flags().StringVar(&flagPublishBaseURL, "base-url", "", "URL of the prefix in the catalog, e.g. a CDN in front of the bucket (default: the URL of the bucket)")
+	boxPublishCmd.Flags().BoolVar(&flagPublishIsReplace, "replace", false, "Replace the box of the version and provider, if the catalog already has a different one")
+	boxPublishCmd.Flags().Int64Var(&flagPublishPartSizeMB, "part-size", flagPublishPartSizeMB, "Size of the uploaded parts, in MB")
+}
+
+// publishRegion returns the region of the flag or of the environment
+func publishRegion() string {
+	for _, aRegion := range []string{flagPublishRegion, os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION")} {
+		if aRegion != "" {
+			return aRegion
+		}
+	}
+	return "us-east-1"
+}
+
+func publishBox(boxPath string) error {
+	if flagPublishBucket == "" {
+		return errors.New("No bucket provided (--bucket)")
+	}
+	if flagPublishName == "" {
+		return errors.New("No box name provided (--name)")
+	}
+	if flagPublishVersion == "" {
+		return errors.New("No box version provided (--version)")
+	}
+	creds := s3client.CredentialsModel{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return errors.New("No credentials provided, set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	absBoxPath, err := pathutil.AbsPath(boxPath)
+	if err != nil {
+		return fmt.Errorf("Failed to get absolute path for the box (path was: %s), error: %s", boxPath, err)
+	}
+	if isExist, err := pathutil.IsPathExists(absBoxPath); err != nil {
+		return fmt.Errorf("Failed to check whether the box exists, error: %s", err)
+	} else if !isExist {
+		return fmt.Errorf("Vagrant box does not exist at path: %s", absBoxPath)
+	}
+
+	providerName, err := boxProviderOfFile(absBoxPath)
+	if err != nil {
+		return err
+	}
+
+	client, err := s3client.New(s3client.ConfigModel{
+		Endpoint:    flagPublishEndpoint,
+		Region:      publishRegion(),
+		Bucket:      flagPublishBucket,
+		IsPathStyle: flagPublishIsPathStyle,
+		Credentials: creds,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := boxpublish.Publish(client, absBoxPath, boxpublish.OptionsModel{
+		BoxName:   flagPublishName,
+		Version:   flagPublishVersion,
+		Provider:  providerName,
+		Prefix:    flagPublishPrefix,
+		BaseURL:   flagPublishBaseURL,
+		PartSize:  flagPublishPartSizeMB * 1024 * 1024,
+		IsReplace: flagPublishIsReplace,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Println(colorstring.Green(" => Box published:"), result.BoxURL)
+	log.Println("    sha256:", result.Checksum)
+	log.Println(" => The box can be added with:")
+	log.Println("    vagrant box add", result.CatalogURL)
+	return nil
+}
@@ -20,11 +20,15 @@ func printToolVersions() error {
 	fmt.Println()
 
 	{
-		out, err := runToolVersionCommand("vboxmanage", "--version")
+		provider, err := loadProvider()
 		if err != nil {
-			return fmt.Errorf("Failed to get VirtualBox version, error: %s", err)
+			return err
 		}
-		fmt.Println(colorstring.Green("* VirtualBox version:"))
+		out, err := provider.ToolVersion()
+		if err != nil {
+			return fmt.Errorf("Failed to get %s version, error: %s", provider.DisplayName(), err)
+		}
+		fmt.Println(colorstring.Green("* " + provider.DisplayName() + " version:"))
 		fmt.Println(out)
 	}
 	{
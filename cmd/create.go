@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/bitrise-io/goinp/goinp"
+	"github.com/bitrise-io/replica/vagrantbox"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +14,12 @@ var createCmd = &cobra.Command{
 	Use:   "create INSTALL_MACOS_APP_PATH",
 	Short: `Create a vagrant box from an "Install macOS / OS X .." app`,
 	Long:  `Create a vagrant box from an "Install macOS / OS X .." app`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if flagIsListVars {
+			return nil
+		}
+		return startBuildLog()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 {
 			return errors.New("No 'Install macOS / OS X .. app' path provided")
@@ -24,6 +31,11 @@ var createCmd = &cobra.Command{
 
 func init() {
 	RootCmd.AddCommand(createCmd)
+	addDMGFlags(createCmd)
+	addBoxFlags(createCmd)
+	addVagrantfileFlags(createCmd)
+	addXcodeFlags(createCmd)
+	addAutoSnapshotFlags(createCmd)
 }
 
 func printPleaseAddToTestedToolVersions() error {
@@ -41,6 +53,24 @@ func printPleaseAddToTestedToolVersions() error {
 }
 
 func createVagrantBoxFromInstallMacOSApp(installMacOSAppPath string) error {
+	// fail early on an invalid config or option, before the time consuming install DMG creation
+	boxOpts, err := vagrantBoxOptions()
+	if err != nil {
+		return err
+	}
+	if err := boxOpts.ProvisionSteps.Validate(); err != nil {
+		return fmt.Errorf("Invalid provisioning steps, error: %s", err)
+	}
+	if err := vagrantbox.ValidateOptions(boxOpts); err != nil {
+		return err
+	}
+	if _, err := loadVagrantfileSettings(); err != nil {
+		return err
+	}
+	if _, err := readHostXcodes(flagXcodeAppPaths, flagDefaultXcode); err != nil {
+		return err
+	}
+
 	if err := printToolVersions(); err != nil {
 		return fmt.Errorf("Failed to print tool versions - missing tool - error: %s", err)
 	}
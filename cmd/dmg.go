@@ -10,6 +10,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	flagAuthorizedKeyPaths = []string{}
+	flagIsGenerateSSHKey   = false
+	flagProfilePaths       = []string{}
+)
+
 // dmgCmd represents the dmg command
 var dmgCmd = &cobra.Command{
 	Use:   "dmg INSTALL_MACOS_APP_PATH",
@@ -27,6 +33,15 @@ var dmgCmd = &cobra.Command{
 
 func init() {
 	createCmd.AddCommand(dmgCmd)
+	addDMGFlags(dmgCmd)
+}
+
+// addDMGFlags registers the flags of the DMG creation,
+// which are shared by every command which creates an installer DMG
+func addDMGFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&flagAuthorizedKeyPaths, "authorized-key", []string{}, "Public key file to install as an authorized SSH key of the vagrant user (can be specified multiple times). Default: the vagrant insecure public key")
+	cmd.Flags().StringSliceVar(&flagProfilePaths, "profile", []string{}, "Configuration profile (.mobileconfig) to install at first boot (can be specified multiple times)")
+	cmd.Flags().BoolVar(&flagIsGenerateSSHKey, "generate-ssh-key", false, "Generate an ed25519 SSH key pair for the DMG, and save the private key next to it")
 }
 
 func createInstallDMG(installMacOSAppPath string) (string, error) {
@@ -34,7 +49,11 @@ func createInstallDMG(installMacOSAppPath string) (string, error) {
 
 	printFreeDiskSpace()
 
-	macOSInstallDMGPath, err := macosinstaller.CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath)
+	result, err := macosinstaller.CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath, macosinstaller.Options{
+		AuthorizedKeyPaths: flagAuthorizedKeyPaths,
+		IsGenerateSSHKey:   flagIsGenerateSSHKey,
+		ProfilePaths:       flagProfilePaths,
+	})
 	if err != nil {
 		return "", fmt.Errorf("Failed to create Install DMG, error: %s", err)
 	}
@@ -42,8 +61,14 @@ func createInstallDMG(installMacOSAppPath string) (string, error) {
 	printFreeDiskSpace()
 
 	fmt.Println()
-	log.Println(colorstring.Green("Done. Built image is located at " + macOSInstallDMGPath + "."))
+	log.Println(colorstring.Green("Done. Built image is located at " + result.DMGPath + "."))
+	if len(result.ProfileIdentifiers) > 0 {
+		log.Println(colorstring.Green("Embedded configuration profiles:"))
+		for _, anIdentifier := range result.ProfileIdentifiers {
+			log.Println(" * " + anIdentifier)
+		}
+	}
 	fmt.Println()
 
-	return macOSInstallDMGPath, nil
+	return result.DMGPath, nil
 }
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/fleet"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/spf13/cobra"
+)
+
+// fleetLogFileName is the log of the vagrant commands, in the directory of every VM of the fleet
+const fleetLogFileName = "replica.log"
+
+var (
+	flagFleetCount       = 0
+	flagFleetParallelism = 2
+	flagFleetNamePrefix  = fleet.DefaultNamePrefix
+	flagFleetSSHPortBase = fleet.DefaultSSHPortBase
+)
+
+// fleetCmd represents the create fleet command
+var fleetCmd = &cobra.Command{
+	Use:   "fleet DESTINATION_DIR_PATH VAGRANT_BOX_PATH",
+	Short: "Create identical vagrant VMs, using the vagrant box",
+	Long: `Create identical vagrant VMs, using the vagrant box.
+
+Every VM is created in its own subdirectory of the destination directory (e.g. replica-01),
+as a linked clone of the box, with a unique hostname, forwarded SSH port and MAC address,
+and with an initial snapshot. The output of the vagrant commands is saved into
+the replica.log file of the VM's directory.
+
+NOTE: You can create the vagrant box with: replica create box`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		destinationDirPath := ""
+		vagrantBoxPath := ""
+
+		if flagIsSkipBoxReg {
+			if len(args) < 1 {
+				return errors.New("no destination directory path provided")
+			}
+			destinationDirPath = args[0]
+		} else {
+			if len(args) < 2 {
+				return errors.New("no vagrant box or destination directory path provided")
+			}
+			destinationDirPath = args[0]
+			vagrantBoxPath = args[1]
+		}
+
+		return createVagrantFleet(destinationDirPath, flagIsSkipBoxReg, vagrantBoxPath)
+	},
+}
+
+func init() {
+	createCmd.AddCommand(fleetCmd)
+	fleetCmd.Flags().IntVar(&flagFleetCount, "count", 0, "Number of VMs to create")
+	fleetCmd.Flags().IntVar(&flagFleetParallelism, "parallel", 2, "Maximum number of VMs to create and boot at once")
+	fleetCmd.Flags().StringVar(&flagFleetNamePrefix, "name-prefix", fleet.DefaultNamePrefix, "Prefix of the names, directories and hostnames of the VMs, e.g. replica for replica-01")
+	fleetCmd.Flags().IntVar(&flagFleetSSHPortBase, "ssh-port-base", fleet.DefaultSSHPortBase, "Host port forwarded to the SSH port of the first VM, the next VMs get the next ports")
+	fleetCmd.Flags().BoolVar(&flagIsSkipBoxReg, "skip-box-reg", false, "Skip the vagrant box registration (only use this if the box is already registered in vagrant!)")
+	fleetCmd.Flags().StringVar(&flagPrivateKeyPath, "private-key", "", "SSH private key to connect to the VMs with. Default: the key saved next to the vagrant box (if any), otherwise the vagrant insecure key")
+	addVagrantfileFlags(fleetCmd)
+}
+
+// fleetResultModel is the result of the creation of a VM of the fleet
+type fleetResultModel struct {
+	Member    fleet.MemberModel
+	SSHConfig vagrantcli.SSHConfigModel
+	Err       error
+}
+
+// createFleetVM creates, boots and snapshots the VM, logging the vagrant commands into the VM's directory
+func createFleetVM(member fleet.MemberModel, privateKeyPath string, provider hypervisor.Provider) error {
+	if err := pathutil.EnsureDirExist(member.Dir); err != nil {
+		return fmt.Errorf("Failed to create the directory of the VM (%s), error: %s", member.Dir, err)
+	}
+	logPath := filepath.Join(member.Dir, fleetLogFileName)
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("Failed to create log file (%s), error: %s", logPath, err)
+	}
+	defer func() {
+		if err := logFile.Close(); err != nil {
+			log.Printf(" [!] Failed to close log file (%s), error: %s", logPath, err)
+		}
+	}()
+
+	log.Printf(" => %s: creating and booting, log: %s", member.Name, logPath)
+	if err := createVagrantVM(member.Dir, true, privateKeyPath, provider, member.Settings, logFile); err != nil {
+		return fmt.Errorf("Failed to create the VM, see: %s, error: %s", logPath, err)
+	}
+	registerVM(member.Dir, member.Name, provider, member.Settings)
+	if provider.IsSnapshotSupported() {
+		if err := createVagrantSnapshot(member.Dir, vagrantInitialSnapshotID, logFile); err != nil {
+			return fmt.Errorf("Failed to create the initial snapshot, see: %s, error: %s", logPath, err)
+		}
+	}
+	log.Println(colorstring.Green(" => " + member.Name + ": ready [OK]"))
+	return nil
+}
+
+func printFleetResults(results []fleetResultModel) error {
+	table := "NAME\tHOSTNAME\tSSH\tSTATUS\tDIR\n"
+	for _, aResult := range results {
+		endpoint, status := "-", "ready"
+		if aResult.Err != nil {
+			status = "failed: " + aResult.Err.Error()
+		} else {
+			endpoint = aResult.SSHConfig.Endpoint()
+		}
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", aResult.Member.Name, aResult.Member.Settings.Hostname, endpoint, status, aResult.Member.Dir)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print VMs, error: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print VMs, error: %s", err)
+	}
+	return nil
+}
+
+func createVagrantFleet(destinationDirPath string, isShouldSkipBoxReg bool, vagrantBoxPath string) error {
+	provider, err := loadProvider()
+	if err != nil {
+		return err
+	}
+	settings, err := loadVagrantfileSettings()
+	if err != nil {
+		return err
+	}
+	absDestinationDirPath, err := pathutil.AbsPath(destinationDirPath)
+	if err != nil {
+		return fmt.Errorf("Failed to get absolute path of the destination directory (%s), error: %s", destinationDirPath, err)
+	}
+	members, err := fleet.Plan(absDestinationDirPath, provider, settings, fleet.OptionsModel{
+		Count:       flagFleetCount,
+		NamePrefix:  flagFleetNamePrefix,
+		SSHPortBase: flagFleetSSHPortBase,
+	})
+	if err != nil {
+		return err
+	}
+
+	if isShouldSkipBoxReg {
+		log.Println(colorstring.Yellow(" => Skipping the registration of the vagrant box"))
+	} else {
+		fmt.Println()
+		log.Println(colorstring.Green(" => Registering the vagrant box:"), vagrantBoxPath)
+		if err := registerVagrantBox(vagrantBoxPath, settings.BoxName, provider); err != nil {
+			return fmt.Errorf("Failed to register vagrant box, error: %s", err)
+		}
+		log.Println(colorstring.Green(" => vagrant box registered! [OK]"))
+	}
+
+	privateKeyPath, err := vagrantPrivateKeyPath(vagrantBoxPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	log.Println(colorstring.Green(fmt.Sprintf(" => Creating %d VMs at path: %s", len(members), absDestinationDirPath)))
+	if !provider.IsSnapshotSupported() {
+		log.Println(colorstring.Yellow(" => Snapshots are not supported with the " + provider.DisplayName() + " provider, skipping the initial snapshots"))
+	}
+	printFreeDiskSpace()
+
+	errs := fleet.Run(members, flagFleetParallelism, func(member fleet.MemberModel) error {
+		return createFleetVM(member, privateKeyPath, provider)
+	})
+
+	results := []fleetResultModel{}
+	failedCount := 0
+	for idx, aMember := range members {
+		result := fleetResultModel{Member: aMember, Err: errs[idx]}
+		if result.Err == nil {
+			result.SSHConfig, result.Err = vagrantcli.SSHConfig(aMember.Dir)
+		}
+		if result.Err != nil {
+			failedCount++
+		}
+		results = append(results, result)
+	}
+
+	fmt.Println()
+	printFreeDiskSpace()
+	fmt.Println()
+	if err := printFleetResults(results); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if failedCount > 0 {
+		return fmt.Errorf("Failed to create %d of the %d VMs", failedCount, len(members))
+	}
+	log.Println(colorstring.Green(fmt.Sprintf(" => %d VMs created & ready! [OK]", len(members))))
+	if provider.IsSnapshotSupported() {
+		fmt.Println(colorstring.Yellow(" NOTE: you can restore the saved snapshot state of a VM with:"))
+		fmt.Println(" $ replica vm snapshot reset --dir " + members[0].Dir)
+	}
+	return nil
+}
@@ -2,11 +2,28 @@ package cmd
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/buildlog"
+	"github.com/bitrise-io/replica/config"
+	"github.com/bitrise-io/replica/hypervisor"
 	"github.com/spf13/cobra"
 )
 
+var (
+	flagConfigPath = ""
+	flagProvider   = ""
+	flagKeepLogs   = buildlog.DefaultKeepCount
+
+	// buildLogSession captures the output of the command into the build log directory, if started
+	buildLogSession *buildlog.SessionModel
+)
+
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
 	Use:   "replica",
@@ -22,11 +39,76 @@ to quickly create a Cobra application.`,
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	if err := RootCmd.Execute(); err != nil {
+	err := RootCmd.Execute()
+	if err != nil {
 		fmt.Println(err)
+	}
+	if buildLogSession != nil {
+		if err != nil {
+			fmt.Println()
+			log.Println(colorstring.Red(" [!] Failed, you can find the build logs at:"), buildLogSession.Dir)
+		}
+		if closeErr := buildLogSession.Close(); closeErr != nil {
+			log.Println(colorstring.Red(" [!] Failed to save the build logs, error:"), closeErr)
+		}
+	}
+	if err != nil {
 		os.Exit(-1)
 	}
 }
 
 func init() {
+	RootCmd.PersistentFlags().StringVar(&flagConfigPath, "config", "", "Path of the replica JSON configuration file")
+	RootCmd.PersistentFlags().IntVar(&flagKeepLogs, "keep-logs", buildlog.DefaultKeepCount, "Number of build log directories to keep in "+buildlog.DefaultLogsDir+", the older ones are removed")
+	RootCmd.PersistentFlags().StringVar(&flagProvider, "provider", "", fmt.Sprintf("The hypervisor to build the box with and run the VM on, one of: %s (default: %s)", strings.Join(hypervisor.ProviderNames, ", "), hypervisor.DefaultProviderName))
+}
+
+// loadConfig reads the configuration file specified with --config,
+// or returns an empty configuration if no config file was specified
+func loadConfig() (config.ConfigModel, error) {
+	if flagConfigPath == "" {
+		return config.ConfigModel{}, nil
+	}
+	return config.ReadConfigFromFile(flagConfigPath)
+}
+
+// loadProvider returns the hypervisor selected with --provider,
+// or in the config file, or the default one
+func loadProvider() (hypervisor.Provider, error) {
+	conf, err := loadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load config, error: %s", err)
+	}
+
+	name := conf.Provider
+	if flagProvider != "" {
+		name = flagProvider
+	}
+	return hypervisor.NewProvider(name, hypervisor.Options{
+		QEMUFirmwarePath: flagQEMUFirmware,
+	})
+}
+
+// startBuildLog starts capturing the output of the command into a new build log directory
+func startBuildLog() error {
+	logsDir, err := pathutil.AbsPath(buildlog.DefaultLogsDir)
+	if err != nil {
+		return fmt.Errorf("Failed to determine absolute logs dir path, error: %s", err)
+	}
+	session, err := buildlog.Start(logsDir, flagKeepLogs, time.Now())
+	if err != nil {
+		return fmt.Errorf("Failed to start the build log, error: %s", err)
+	}
+	buildLogSession = session
+	log.Println(" => Build logs:", session.Dir)
+	return nil
+}
+
+// packerLogPath is the path of packer's debug log in the build log directory,
+// or empty if the build log was not started
+func packerLogPath() string {
+	if buildLogSession == nil {
+		return ""
+	}
+	return buildLogSession.PackerLogPath()
 }
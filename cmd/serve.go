@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/boxserver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagServeAddress  = ":8080"
+	flagServeBaseURL  = ""
+	flagServeUsername = ""
+)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve BOXES_DIR",
+	Short: "Serve a directory of vagrant boxes, with a box catalog per box",
+	Long: `Serve a directory of vagrant boxes, with a box catalog per box.
+
+The layout of the directory: BOX/VERSION/NAME.box
+(e.g. macos-sierra/10.12.4/virtualbox.box), the provider of the box in the catalog
+is read from the metadata.json of the box.
+
+The box can be added with:
+  vagrant box add http://HOST:8080/macos-sierra/catalog.json
+
+Basic authentication is required if --username is set,
+the password is read from the REPLICA_SERVE_PASSWORD environment variable.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No boxes directory provided")
+		}
+		return serveBoxes(args[0])
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&flagServeAddress, "address", ":8080", "The address to listen on")
+	serveCmd.Flags().StringVar(&flagServeBaseURL, "base-url", "", "The URL of the server in the catalogs, e.g. https://boxes.example.com (default: the host of the request)")
+	serveCmd.Flags().StringVar(&flagServeUsername, "username", "", "Require basic authentication, with this username and the password of $REPLICA_SERVE_PASSWORD")
+}
+
+func serveBoxes(boxesDir string) error {
+	absBoxesDir, err := pathutil.AbsPath(boxesDir)
+	if err != nil {
+		return fmt.Errorf("Failed to get absolute path for the boxes directory (path was: %s), error: %s", boxesDir, err)
+	}
+	opts := boxserver.Options{
+		BaseURL:  flagServeBaseURL,
+		Username: flagServeUsername,
+	}
+	if flagServeUsername != "" {
+		opts.Password = os.Getenv("REPLICA_SERVE_PASSWORD")
+		if opts.Password == "" {
+			return errors.New("Basic authentication requires a password, set it in the REPLICA_SERVE_PASSWORD environment variable")
+		}
+	}
+
+	server := boxserver.New(absBoxesDir, opts)
+	// the checksums of big boxes take minutes to calculate, do it before the first catalog request
+	log.Println(colorstring.Green(" => Reading the providers and the checksums of the boxes of:"), absBoxesDir)
+	if err := server.ReadBoxInfos(); err != nil {
+		return err
+	}
+
+	log.Println(colorstring.Green(" => Serving the boxes of:"), absBoxesDir)
+	log.Println(" => Listening on:", flagServeAddress)
+	return http.ListenAndServe(flagServeAddress, server)
+}
@@ -3,24 +3,48 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/bitrise-io/go-utils/cmdex"
 	"github.com/bitrise-io/go-utils/colorstring"
 	"github.com/bitrise-io/go-utils/fileutil"
 	"github.com/bitrise-io/go-utils/pathutil"
 	"github.com/bitrise-io/goinp/goinp"
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/bitrise-io/replica/catalog"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/registry"
+	"github.com/bitrise-io/replica/snapshot"
+	"github.com/bitrise-io/replica/sshkey"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/bitrise-io/replica/vagrantfile"
+	"github.com/bitrise-io/replica/vmsync"
 	"github.com/spf13/cobra"
 )
 
 const (
-	vagrantBoxName           = "bitrise-replica-macos"
-	vagrantInitialSnapshotID = "bitrise-replica-initial"
+	vagrantInitialSnapshotID = snapshot.InitialName
+	// vagrantPrivateKeyFileName is the name of the SSH private key file,
+	// copied into the vagrant VM's directory, if the box has its own key
+	vagrantPrivateKeyFileName = "replica_private_key"
 )
 
 var (
-	flagIsSkipBoxReg = false
+	flagIsSkipBoxReg      = false
+	flagPrivateKeyPath    = ""
+	flagVMSettings        = vagrantfile.SettingsModel{}
+	flagVMIsLinkedClone   = optionalBoolFlag{}
+	flagVMPortForwards    = []string{}
+	flagVMPrivateNetworks = []string{}
+	flagVMSyncedFolders   = []string{}
+	flagVMCustomizations  = []string{}
 )
 
 // vagrantCmd represents the vagrant command
@@ -54,6 +78,131 @@ NOTE: You can create the vagrant box with: replica create box`,
 func init() {
 	createCmd.AddCommand(vagrantCmd)
 	vagrantCmd.Flags().BoolVar(&flagIsSkipBoxReg, "skip-box-reg", false, "Skip the vagrant box registration (only use this if the box is already registered in vagrant!)")
+	vagrantCmd.Flags().StringVar(&flagPrivateKeyPath, "private-key", "", "SSH private key to connect to the VM with. Default: the key saved next to the vagrant box (if any), otherwise the vagrant insecure key")
+	addVagrantfileFlags(vagrantCmd)
+	addXcodeFlags(vagrantCmd)
+	addAutoSnapshotFlags(vagrantCmd)
+}
+
+// optionalBoolFlag is a boolean flag, which is nil if it was not specified
+type optionalBoolFlag struct {
+	value *bool
+}
+
+func (flag *optionalBoolFlag) String() string {
+	if flag.value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*flag.value)
+}
+
+func (flag *optionalBoolFlag) Set(value string) error {
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	flag.value = &parsed
+	return nil
+}
+
+func (flag *optionalBoolFlag) Type() string {
+	return "bool"
+}
+
+// addVagrantfileFlags registers the flags of the generated Vagrantfile,
+// which are shared by every command which creates a vagrant VM
+func addVagrantfileFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagVMSettings.BoxName, "box-name", "", fmt.Sprintf("The name the vagrant box is registered with, and the VM is created from (default: %s)", vagrantfile.DefaultBoxName))
+	cmd.Flags().StringVar(&flagVMSettings.BoxVersion, "box-version", "", "Version constraint of the vagrant box, e.g. '>= 1.2' (default: any version)")
+	cmd.Flags().IntVar(&flagVMSettings.CPUs, "vm-cpus", 0, "Number of CPUs of the VM (default: half of the host's CPUs, at least 2)")
+	cmd.Flags().IntVar(&flagVMSettings.MemoryMB, "vm-memory", 0, "Memory size of the VM, in MB (default: half of the host's RAM, at least 4096)")
+	cmd.Flags().Var(&flagVMIsLinkedClone, "linked-clone", "Create the VM as a linked clone of the box, instead of a full copy (default: false)")
+	cmd.Flags().Lookup("linked-clone").NoOptDefVal = "true"
+	cmd.Flags().StringSliceVar(&flagVMPortForwards, "forward-port", []string{}, "Forward a port of the host to the VM, in the format: HOST_PORT:GUEST_PORT[/udp] (can be specified multiple times)")
+	cmd.Flags().StringSliceVar(&flagVMPrivateNetworks, "private-network", []string{}, "Add a private network to the VM, with a static IP address or dhcp (can be specified multiple times)")
+	cmd.Flags().StringSliceVar(&flagVMSyncedFolders, "synced-folder", []string{}, "Share a directory of the host with the VM, in the format: HOST_PATH:GUEST_PATH[:TYPE] (can be specified multiple times)")
+	cmd.Flags().StringSliceVar(&flagVMCustomizations, "vm-customize", []string{}, "Provider specific setting of the VM, in the format: key=value, e.g. vram=128 with virtualbox (can be specified multiple times)")
+}
+
+// vagrantfileSettingsFromFlags returns the Vagrantfile settings specified with flags
+func vagrantfileSettingsFromFlags() (vagrantfile.SettingsModel, error) {
+	settings := flagVMSettings
+	settings.IsLinkedClone = flagVMIsLinkedClone.value
+	for _, aValue := range flagVMPortForwards {
+		portForward, err := vagrantfile.ParsePortForward(aValue)
+		if err != nil {
+			return vagrantfile.SettingsModel{}, err
+		}
+		settings.PortForwards = append(settings.PortForwards, portForward)
+	}
+	for _, aValue := range flagVMPrivateNetworks {
+		settings.PrivateNetworks = append(settings.PrivateNetworks, vagrantfile.PrivateNetworkModel{IP: aValue})
+	}
+	for _, aValue := range flagVMSyncedFolders {
+		syncedFolder, err := vagrantfile.ParseSyncedFolder(aValue)
+		if err != nil {
+			return vagrantfile.SettingsModel{}, err
+		}
+		settings.SyncedFolders = append(settings.SyncedFolders, syncedFolder)
+	}
+	settings.Customizations = map[string]string{}
+	for _, aValue := range flagVMCustomizations {
+		split := strings.SplitN(aValue, "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			return vagrantfile.SettingsModel{}, fmt.Errorf("Invalid --vm-customize (%s), should be in the format: key=value", aValue)
+		}
+		settings.Customizations[split[0]] = split[1]
+	}
+
+	// relative to the current directory, instead of the VM's directory
+	wd, err := os.Getwd()
+	if err != nil {
+		return vagrantfile.SettingsModel{}, fmt.Errorf("Failed to get current working directory, error: %s", err)
+	}
+	settings.NormalizePaths(wd)
+	return settings, nil
+}
+
+// loadVagrantfileSettings returns the settings of the Vagrantfile: the defaults sized from the host,
+// overridden by the config file, then by the flags
+func loadVagrantfileSettings() (vagrantfile.SettingsModel, error) {
+	conf, err := loadConfig()
+	if err != nil {
+		return vagrantfile.SettingsModel{}, fmt.Errorf("Failed to load config, error: %s", err)
+	}
+	flagSettings, err := vagrantfileSettingsFromFlags()
+	if err != nil {
+		return vagrantfile.SettingsModel{}, err
+	}
+
+	host, err := vagrantfile.DetectHost()
+	if err != nil {
+		log.Println(colorstring.Yellow(" [!] Failed to detect the hardware of the host, using the minimal VM size, error:"), err)
+	}
+	settings := vagrantfile.DefaultSettings(host).Merge(conf.VM).Merge(flagSettings)
+	if err := settings.Validate(); err != nil {
+		return vagrantfile.SettingsModel{}, fmt.Errorf("Invalid VM settings, error: %s", err)
+	}
+	return settings, nil
+}
+
+// vagrantPrivateKeyPath returns the SSH private key which belongs to the vagrant box,
+// or an empty string if vagrant's default insecure key should be used
+func vagrantPrivateKeyPath(vagrantBoxPath string) (string, error) {
+	if flagPrivateKeyPath != "" {
+		return flagPrivateKeyPath, nil
+	}
+	if vagrantBoxPath == "" {
+		return "", nil
+	}
+
+	boxPrivateKeyPath := sshkey.PrivateKeyPathFor(vagrantBoxPath)
+	if isExist, err := pathutil.IsPathExists(boxPrivateKeyPath); err != nil {
+		return "", fmt.Errorf("Failed to check whether the vagrant box has an SSH private key, error: %s", err)
+	} else if !isExist {
+		return "", nil
+	}
+	return boxPrivateKeyPath, nil
 }
 
 func createAndProvisionVagrantVM(destinationDirPath string, isShouldSkipBoxReg bool, vagrantBoxPath string) error {
@@ -61,6 +210,20 @@ func createAndProvisionVagrantVM(destinationDirPath string, isShouldSkipBoxReg b
 		return fmt.Errorf("Failed to create vagrant VM destination directory (path: %s), error: %s", destinationDirPath, err)
 	}
 
+	provider, err := loadProvider()
+	if err != nil {
+		return err
+	}
+	settings, err := loadVagrantfileSettings()
+	if err != nil {
+		return err
+	}
+	// fail early on an invalid Xcode.app, before the VM is created
+	hostXcodes, err := readHostXcodes(flagXcodeAppPaths, flagDefaultXcode)
+	if err != nil {
+		return err
+	}
+
 	if isShouldSkipBoxReg {
 		log.Println(colorstring.Yellow(" => Skipping the registration of the vagrant box"))
 	} else {
@@ -68,7 +231,7 @@ func createAndProvisionVagrantVM(destinationDirPath string, isShouldSkipBoxReg b
 		log.Println(colorstring.Green(" => Registering the vagrant box:"), vagrantBoxPath)
 		printFreeDiskSpace()
 
-		if err := registerVagrantBox(vagrantBoxPath); err != nil {
+		if err := registerVagrantBox(vagrantBoxPath, settings.BoxName, provider); err != nil {
 			return fmt.Errorf("Failed to register vagrant box, error: %s", err)
 		}
 
@@ -77,175 +240,227 @@ func createAndProvisionVagrantVM(destinationDirPath string, isShouldSkipBoxReg b
 		log.Println(colorstring.Green(" => vagrant box registered! [OK]"))
 	}
 
+	privateKeyPath, err := vagrantPrivateKeyPath(vagrantBoxPath)
+	if err != nil {
+		return err
+	}
+
 	fmt.Println()
 	log.Println(colorstring.Green(" => Creating and booting vagrant VM at path:"), destinationDirPath)
 
-	if err := createVagrantVM(destinationDirPath, true); err != nil {
+	if err := createVagrantVM(destinationDirPath, true, privateKeyPath, provider, settings, nil); err != nil {
 		return fmt.Errorf("Failed to create Vagrant VM, error: %s", err)
 	}
 
+	registerVM(destinationDirPath, "", provider, settings)
+
 	printFreeDiskSpace()
 	log.Println(colorstring.Green(" => vagrant VM created & ready! [OK]"))
 
 	fmt.Println()
-	log.Println(colorstring.Green(" => Creating an initial snapshot ..."))
+	if provider.IsSnapshotSupported() {
+		log.Println(colorstring.Green(" => Creating an initial snapshot ..."))
 
-	if err := createVagrantSnapshot(destinationDirPath, vagrantInitialSnapshotID); err != nil {
-		return fmt.Errorf("Failed to create vagrant snapshot, error: %s", err)
-	}
+		if err := createVagrantSnapshot(destinationDirPath, vagrantInitialSnapshotID, nil); err != nil {
+			return fmt.Errorf("Failed to create vagrant snapshot, error: %s", err)
+		}
 
-	printFreeDiskSpace()
-	log.Println(colorstring.Green(" => Snapshot created! [OK]"))
-	fmt.Println(colorstring.Yellow(" NOTE: you can restore this saved snapshot state of the virtual machine with:"))
-	fmt.Println(" $ vagrant snapshot restore " + vagrantInitialSnapshotID)
-	fmt.Println()
+		printFreeDiskSpace()
+		log.Println(colorstring.Green(" => Snapshot created! [OK]"))
+		fmt.Println(colorstring.Yellow(" NOTE: you can restore this saved snapshot state of the virtual machine with:"))
+		fmt.Println(" $ replica vm snapshot reset --dir " + destinationDirPath)
+		fmt.Println()
+	} else {
+		log.Println(colorstring.Yellow(" => Snapshots are not supported with the " + provider.DisplayName() + " provider, skipping the initial snapshot"))
+	}
 
 	fmt.Println()
 	log.Println(colorstring.Green(" => Sync Xcode.app ..."))
 
-	xcodeAppPath, err := goinp.AskForStringWithDefault(
-		"Please specify an Xcode.app (path) to be synced into the virtual machine",
-		"/Applications/Xcode.app")
-	if err != nil {
-		return fmt.Errorf("failed to get Xcode.app path, error: %s", err)
+	if len(flagXcodeAppPaths) == 0 {
+		xcodeAppPaths, err := goinp.AskForStringWithDefault(
+			"Please specify the Xcode.apps or .xip archives (paths, separated by commas) to be synced into the virtual machine",
+			"/Applications/Xcode.app")
+		if err != nil {
+			return fmt.Errorf("failed to get Xcode.app path, error: %s", err)
+		}
+		if hostXcodes, err = readHostXcodes(splitXcodeAppPaths(xcodeAppPaths), flagDefaultXcode); err != nil {
+			return err
+		}
 	}
 
-	if err := uploadDir(destinationDirPath, xcodeAppPath, "/Applications/Xcode.app"); err != nil {
-		return fmt.Errorf("failed to sync Xcode.app, error: %s", err)
+	syncedXcodes, err := syncXcodes(destinationDirPath, hostXcodes)
+	if err != nil {
+		return err
+	}
+	if len(syncedXcodes) > 0 {
+		fmt.Println()
+		if err := selectSyncedXcode(destinationDirPath, syncedXcodes, flagDefaultXcode); err != nil {
+			return err
+		}
 	}
 
 	printFreeDiskSpace()
 	log.Println(colorstring.Green(" => Xcode.app sync DONE! [OK]"))
+	fmt.Println(colorstring.Yellow(" NOTE: you can manage the Xcode.apps of the virtual machine with:"))
+	fmt.Println(" $ replica vm xcode list --dir " + destinationDirPath)
+	if err := autoSnapshot(destinationDirPath, provider, "xcode-sync"); err != nil {
+		return err
+	}
 	fmt.Println()
 
 	return nil
 }
 
 func uploadDir(vagrantVMDir, dirToUpload, targetPathInVM string) error {
-	vagrantSSHConfigFilePth, err := saveVagrantSSHConfigIntoTmpFile(vagrantVMDir)
+	return rsyncVM(vagrantVMDir, vmsync.OptionsModel{Direction: vmsync.Push}, filepath.Clean(dirToUpload)+"/", filepath.Clean(targetPathInVM)+"/")
+}
+
+// uploadFile uploads the file into the VM, the directory of the target path has to exist in the VM
+func uploadFile(vagrantVMDir, fileToUpload, targetPathInVM string) error {
+	return rsyncVM(vagrantVMDir, vmsync.OptionsModel{Direction: vmsync.Push}, filepath.Clean(fileToUpload), filepath.Clean(targetPathInVM))
+}
+
+// rsyncVM syncs between the path of the host and the path of the VM, with rsync over the SSH connection of the VM
+func rsyncVM(vagrantVMDir string, opts vmsync.OptionsModel, hostPath, vmPath string) error {
+	sshConfig, err := vagrantSSHConfig(vagrantVMDir)
 	if err != nil {
 		return fmt.Errorf("failed to determin vagrant ssh configs, error: %s", err)
 	}
 
-	{
-		cmd := cmdex.NewCommandWithStandardOuts("rsync",
-			"-avhP",
-			"-e", "ssh -F "+vagrantSSHConfigFilePth,
-			filepath.Clean(dirToUpload)+"/", "default:"+filepath.Clean(targetPathInVM)+"/",
-		).SetDir(vagrantVMDir)
+	cmd := cmdex.NewCommandWithStandardOuts("rsync", vmsync.RsyncArgs(opts, sshConfig.Path, sshConfig.Host, hostPath, vmPath)...)
 
-		fmt.Println()
-		log.Printf("$ %s", cmd.PrintableCommandArgs())
-		fmt.Println()
-		if err := cmd.Run(); err != nil {
-			return fmt.Errorf("Failed to run command, error: %s", err)
-		}
+	fmt.Println()
+	log.Printf("$ %s", cmd.PrintableCommandArgs())
+	fmt.Println()
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("Failed to run command, error: %s", err)
 	}
-
 	return nil
 }
 
-func saveVagrantSSHConfigIntoTmpFile(vagrantVMDir string) (string, error) {
-	tmpDirPth, err := pathutil.NormalizedOSTempDirPath("replica-vagrant-ssh")
+// savedSSHConfigModel is the SSH config of a VM, saved into a file
+type savedSSHConfigModel struct {
+	vagrantcli.SSHConfigModel
+	// Path is the path of the SSH config file, which can be used with: ssh -F
+	Path string
+}
+
+// the SSH configs of the VMs, by the directory of the VM, cached for the session,
+// so the syncs don't have to ask vagrant for the SSH config every time
+var (
+	vagrantSSHConfigsMutex sync.Mutex
+	vagrantSSHConfigs      = map[string]savedSSHConfigModel{}
+)
+
+// vagrantSSHConfig returns the SSH config of the VM, saved into a temporary file
+func vagrantSSHConfig(vagrantVMDir string) (savedSSHConfigModel, error) {
+	absVMDir, err := pathutil.AbsPath(vagrantVMDir)
 	if err != nil {
-		return "", fmt.Errorf("failed to create a temporary directory for vagrant ssh config file, error: %s", err)
+		return savedSSHConfigModel{}, fmt.Errorf("failed to get the absolute path of the VM directory, error: %s", err)
+	}
+
+	vagrantSSHConfigsMutex.Lock()
+	defer vagrantSSHConfigsMutex.Unlock()
+	if sshConfig, isCached := vagrantSSHConfigs[absVMDir]; isCached {
+		return sshConfig, nil
 	}
-	vagrantSSHConfigFilePath := filepath.Join(tmpDirPth, "vagrant.ssh.config")
 
-	cmd := cmdex.NewCommand("vagrant",
-		"ssh-config",
-	).SetDir(vagrantVMDir)
+	tmpDirPth, err := pathutil.NormalizedOSTempDirPath("replica-vagrant-ssh")
+	if err != nil {
+		return savedSSHConfigModel{}, fmt.Errorf("failed to create a temporary directory for vagrant ssh config file, error: %s", err)
+	}
 
 	fmt.Println()
-	log.Printf("$ %s", cmd.PrintableCommandArgs())
+	log.Printf("$ vagrant ssh-config")
 	fmt.Println()
-
-	sshConfigCmdOutput, err := cmd.RunAndReturnTrimmedOutput()
+	config, err := vagrantcli.SSHConfig(absVMDir)
 	if err != nil {
-		return "", fmt.Errorf("failed to run command, error: %s", err)
+		return savedSSHConfigModel{}, err
 	}
 
-	if err := fileutil.WriteStringToFile(vagrantSSHConfigFilePath, sshConfigCmdOutput); err != nil {
-		return "", fmt.Errorf("failed to write vagrant ssh config into file, error: %s", err)
+	sshConfig := savedSSHConfigModel{SSHConfigModel: config, Path: filepath.Join(tmpDirPth, "vagrant.ssh.config")}
+	if err := fileutil.WriteStringToFile(sshConfig.Path, config.Content); err != nil {
+		return savedSSHConfigModel{}, fmt.Errorf("failed to write vagrant ssh config into file, error: %s", err)
 	}
-
-	return vagrantSSHConfigFilePath, nil
+	vagrantSSHConfigs[absVMDir] = sshConfig
+	return sshConfig, nil
 }
 
-func createVagrantSnapshot(vagrantVMDir, snapshotID string) error {
-	cmd := cmdex.NewCommandWithStandardOuts("vagrant",
-		"snapshot",
-		"save", snapshotID,
-	).SetDir(vagrantVMDir)
+// forgetVagrantSSHConfig removes the cached SSH config of the VM, e.g. when the VM is recreated
+func forgetVagrantSSHConfig(vagrantVMDir string) {
+	absVMDir, err := pathutil.AbsPath(vagrantVMDir)
+	if err != nil {
+		return
+	}
+	vagrantSSHConfigsMutex.Lock()
+	defer vagrantSSHConfigsMutex.Unlock()
+	delete(vagrantSSHConfigs, absVMDir)
+}
 
-	fmt.Println()
-	log.Printf("$ %s", cmd.PrintableCommandArgs())
-	fmt.Println()
+// runVagrantCommand runs the vagrant command in the VM's directory,
+// writing its output to out, or to the standard outputs if out is nil
+func runVagrantCommand(vagrantVMDir string, out io.Writer, args ...string) error {
+	cmd := cmdex.NewCommandWithStandardOuts("vagrant", args...).SetDir(vagrantVMDir)
+	if out != nil {
+		cmd.SetStdout(out).SetStderr(out)
+		if _, err := fmt.Fprintf(out, "\n$ %s\n\n", cmd.PrintableCommandArgs()); err != nil {
+			return fmt.Errorf("Failed to write command output, error: %s", err)
+		}
+	} else {
+		fmt.Println()
+		log.Printf("$ %s", cmd.PrintableCommandArgs())
+		fmt.Println()
+	}
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("Failed to run command, error: %s", err)
 	}
-
 	return nil
 }
 
-func createVagrantVM(vagrantVMDirPath string, isVagrantDestroyBeforeCreate bool) error {
-	const vagrantFileContent = `# -*- mode: ruby -*-
-# vi: set ft=ruby :
-
-Vagrant.configure("2") do |config|
-  config.vm.box = "bitrise-replica-macos"
-  config.vm.synced_folder ".", "/vagrant", :disabled => true
-  config.ssh.insert_key = false
+func createVagrantSnapshot(vagrantVMDir, snapshotID string, out io.Writer) error {
+	if err := runVagrantCommand(vagrantVMDir, out, "snapshot", "save", snapshotID); err != nil {
+		return err
+	}
+	updateRegisteredVM(vagrantVMDir, func(vm *registry.VMModel) {
+		vm.AddSnapshot(snapshotID)
+	})
+	return nil
+}
 
-  config.vm.provider "virtualbox" do |v|
-    # v.linked_clone = true
+func createVagrantVM(vagrantVMDirPath string, isVagrantDestroyBeforeCreate bool, privateKeyPath string, provider hypervisor.Provider, settings vagrantfile.SettingsModel, out io.Writer) error {
+	privateKeyFileName := ""
+	if privateKeyPath != "" {
+		privateKeyFileName = vagrantPrivateKeyFileName
+		if err := cmdex.CopyFile(privateKeyPath, filepath.Join(vagrantVMDirPath, privateKeyFileName)); err != nil {
+			return fmt.Errorf("Failed to copy SSH private key into the destination directory, error: %s", err)
+		}
+	}
 
-    # v.memory = 4096
-    # v.cpus = 2
-  end
-end
-`
+	vagrantFileContent, err := vagrantfile.Render(provider, settings, privateKeyFileName)
+	if err != nil {
+		return fmt.Errorf("Failed to render Vagrantfile, error: %s", err)
+	}
 
 	if err := fileutil.WriteStringToFile(filepath.Join(vagrantVMDirPath, "Vagrantfile"), vagrantFileContent); err != nil {
 		return fmt.Errorf("Failed to write Vagrantfile into the destination directory, error: %s", err)
 	}
 
+	forgetVagrantSSHConfig(vagrantVMDirPath)
 	if isVagrantDestroyBeforeCreate {
-		{
-			cmd := cmdex.NewCommandWithStandardOuts("vagrant",
-				"destroy", "-f",
-			).SetDir(vagrantVMDirPath)
-
-			fmt.Println()
-			log.Printf("$ %s", cmd.PrintableCommandArgs())
-			fmt.Println()
-			if err := cmd.Run(); err != nil {
-				return fmt.Errorf("Failed to run command, error: %s", err)
-			}
-		}
-	}
-
-	{
-		cmd := cmdex.NewCommandWithStandardOuts("vagrant",
-			"up",
-		).SetDir(vagrantVMDirPath)
-
-		fmt.Println()
-		log.Printf("$ %s", cmd.PrintableCommandArgs())
-		fmt.Println()
-		if err := cmd.Run(); err != nil {
-			return fmt.Errorf("Failed to run command, error: %s", err)
+		if err := runVagrantCommand(vagrantVMDirPath, out, "destroy", "-f"); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return runVagrantCommand(vagrantVMDirPath, out, "up", "--provider", provider.VagrantProvider())
 }
 
-func registerVagrantBox(vagrantBoxPath string) error {
+func registerVagrantBox(vagrantBoxPath, boxName string, provider hypervisor.Provider) error {
 	cmd := cmdex.NewCommandWithStandardOuts("vagrant",
 		"box", "add",
 		"--force",
-		"--name", vagrantBoxName,
+		"--name", boxName,
 		vagrantBoxPath,
 	)
 
@@ -255,5 +470,22 @@ func registerVagrantBox(vagrantBoxPath string) error {
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("Failed to run command, error: %s", err)
 	}
+
+	// vagrant lists the box with the provider of its metadata.json (e.g. vmware_desktop), record the same one
+	boxProvider := provider.VagrantProvider()
+	if metadata, err := catalog.ReadBoxMetadata(vagrantBoxPath); err != nil {
+		log.Printf(" [!] Failed to read the provider of the box, using %s, error: %s", boxProvider, err)
+	} else {
+		boxProvider = metadata.Provider
+	}
+
+	// vagrant does not know which file the box was added from, record it in the box's manifest
+	if err := artifacts.MarkRegistered(vagrantBoxPath, artifacts.VagrantBoxModel{
+		Name:         boxName,
+		Provider:     boxProvider,
+		RegisteredAt: time.Now(),
+	}); err != nil {
+		log.Printf(" [!] Failed to record the registration of the box, error: %s", err)
+	}
 	return nil
 }
@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/goinp/goinp"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/registry"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/bitrise-io/replica/vagrantfile"
+	"github.com/spf13/cobra"
+)
+
+const vmStateMissing = "missing"
+
+var (
+	flagVMListFormat = outputFormatTable
+	flagVMIsAll      = false
+	flagVMIsYes      = false
+)
+
+var vmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the VMs created by replica",
+	Long: `List the VMs created by replica, with their directory, box, snapshots and synced Xcodes.
+
+The VMs are registered in ` + registry.DefaultPath() + ` when they are created.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listRegisteredVMs()
+	},
+}
+
+var vmStatusCmd = &cobra.Command{
+	Use:   "status [VM...]",
+	Short: "Print the state of the VMs (default: every registered VM)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return printVMStatuses(args)
+	},
+}
+
+var vmUpCmd = &cobra.Command{
+	Use:   "up [VM...]",
+	Short: "Boot the VMs (default: every registered VM)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runOnRegisteredVMs(args, "up")
+	},
+}
+
+var vmHaltCmd = &cobra.Command{
+	Use:   "halt [VM...]",
+	Short: "Shut down the VMs (default: every registered VM)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runOnRegisteredVMs(args, "halt")
+	},
+}
+
+var vmDestroyCmd = &cobra.Command{
+	Use:   "destroy [VM...]",
+	Short: "Destroy the VMs, and remove them from the registry",
+	Long: `Destroy the VMs, and remove them from the registry.
+The VMs are selected by name or directory, use --all to destroy every registered VM.
+The directories of the VMs (with the Vagrantfile) are kept.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !flagVMIsAll {
+			return errors.New("No VM provided, specify the VMs to destroy, or use --all")
+		}
+		return destroyRegisteredVMs(args)
+	},
+}
+
+func init() {
+	vmGroupCmd.AddCommand(vmListCmd)
+	vmListCmd.Flags().StringVar(&flagVMListFormat, "format", outputFormatTable, "Output format: table or json")
+	vmGroupCmd.AddCommand(vmStatusCmd)
+	vmGroupCmd.AddCommand(vmUpCmd)
+	vmGroupCmd.AddCommand(vmHaltCmd)
+	vmGroupCmd.AddCommand(vmDestroyCmd)
+	vmDestroyCmd.Flags().BoolVar(&flagVMIsAll, "all", false, "Destroy every registered VM")
+	vmDestroyCmd.Flags().BoolVar(&flagVMIsYes, "yes", false, "Do not ask for confirmation before destroying")
+}
+
+// registerVM records the created VM in the registry, with the box version vagrant selected for it
+func registerVM(vmDir, name string, provider hypervisor.Provider, settings vagrantfile.SettingsModel) {
+	absVMDir, err := pathutil.AbsPath(vmDir)
+	if err != nil {
+		log.Printf(" [!] Failed to register the VM, error: %s", err)
+		return
+	}
+	vm := registry.VMModel{
+		Name:      name,
+		Dir:       absVMDir,
+		Provider:  provider.Name(),
+		Box:       registry.BoxModel{Name: settings.BoxName, Version: settings.BoxVersion},
+		CreatedAt: time.Now(),
+	}
+	if machines, err := vagrantcli.ListMachines(); err != nil {
+		log.Printf(" [!] Failed to get the box version of the VM, error: %s", err)
+	} else {
+		for _, aMachine := range machines {
+			if aMachine.Dir == absVMDir && aMachine.Box.Name != "" {
+				vm.Box = registry.BoxModel{Name: aMachine.Box.Name, Version: aMachine.Box.Version}
+			}
+		}
+	}
+
+	if err := registry.Update(registry.DefaultPath(), func(vms *registry.RegistryModel) error {
+		vm = vms.Put(vm)
+		return nil
+	}); err != nil {
+		log.Printf(" [!] Failed to register the VM, error: %s", err)
+		return
+	}
+	log.Printf(" => VM registered as: %s", vm.Name)
+}
+
+// updateRegisteredVM modifies the registered VM of the directory, the VMs which are not registered are skipped
+func updateRegisteredVM(vmDir string, modify func(vm *registry.VMModel)) {
+	absVMDir, err := pathutil.AbsPath(vmDir)
+	if err != nil {
+		log.Printf(" [!] Failed to update the VM registry, error: %s", err)
+		return
+	}
+	if err := registry.Update(registry.DefaultPath(), func(vms *registry.RegistryModel) error {
+		vms.Modify(absVMDir, modify)
+		return nil
+	}); err != nil {
+		log.Printf(" [!] Failed to update the VM registry, error: %s", err)
+	}
+}
+
+// selectRegisteredVMs returns the registered VMs, by name or directory, or every VM if no VM was specified
+func selectRegisteredVMs(vms registry.RegistryModel, namesOrDirs []string) ([]registry.VMModel, error) {
+	if len(namesOrDirs) == 0 {
+		return vms.VMs, nil
+	}
+	selected := []registry.VMModel{}
+	for _, aNameOrDir := range namesOrDirs {
+		vm, isFound := vms.Find(aNameOrDir)
+		if !isFound {
+			return nil, fmt.Errorf("No registered VM found with the name or directory: %s, list the VMs with: replica vm list", aNameOrDir)
+		}
+		selected = append(selected, vm)
+	}
+	return selected, nil
+}
+
+func readRegisteredVMs(namesOrDirs []string) ([]registry.VMModel, error) {
+	vms, err := registry.Read(registry.DefaultPath())
+	if err != nil {
+		return nil, err
+	}
+	return selectRegisteredVMs(vms, namesOrDirs)
+}
+
+// formatXcodes formats the versions of the synced Xcodes of the VM
+func formatXcodes(vm registry.VMModel) string {
+	versions := []string{}
+	for _, anXcode := range vm.Xcodes {
+		versions = append(versions, valueOrDash(anXcode.Version))
+	}
+	return strings.Join(versions, ", ")
+}
+
+func printTable(table, subject string) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print %s, error: %s", subject, err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print %s, error: %s", subject, err)
+	}
+	return nil
+}
+
+func listRegisteredVMs() error {
+	if flagVMListFormat != outputFormatTable && flagVMListFormat != outputFormatJSON {
+		return errors.New("Invalid output format (--format): " + flagVMListFormat + ", should be table or json")
+	}
+	vms, err := readRegisteredVMs(nil)
+	if err != nil {
+		return err
+	}
+
+	if flagVMListFormat == outputFormatJSON {
+		content, err := json.MarshalIndent(vms, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Failed to serialize VMs, error: %s", err)
+		}
+		if _, err := fmt.Fprintln(os.Stdout, string(content)); err != nil {
+			return fmt.Errorf("Failed to print VMs, error: %s", err)
+		}
+		return nil
+	}
+
+	if len(vms) == 0 {
+		log.Println(colorstring.Yellow(" => No registered VMs, create one with: replica create vagrant"))
+		return nil
+	}
+	table := "NAME\tPROVIDER\tBOX\tCREATED\tSNAPSHOTS\tXCODES\tDIR\n"
+	for _, aVM := range vms {
+		box := aVM.Box.Name
+		if aVM.Box.Version != "" {
+			box += " (" + aVM.Box.Version + ")"
+		}
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			aVM.Name,
+			aVM.Provider,
+			valueOrDash(box),
+			aVM.CreatedAt.Format("2006-01-02 15:04"),
+			len(aVM.Snapshots),
+			valueOrDash(formatXcodes(aVM)),
+			aVM.Dir,
+		)
+	}
+	return printTable(table, "VMs")
+}
+
+// vmState returns the live state of the VM, from vagrant status
+func vmState(vm registry.VMModel) string {
+	if isExist, err := pathutil.IsDirExists(vm.Dir); err != nil || !isExist {
+		return vmStateMissing
+	}
+	status, err := vagrantcli.Status(vm.Dir)
+	if err != nil {
+		log.Printf(" [!] Failed to get the status of the VM (%s), error: %s", vm.Name, err)
+		return "unknown"
+	}
+	return status.State
+}
+
+func printVMStatuses(namesOrDirs []string) error {
+	vms, err := readRegisteredVMs(namesOrDirs)
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		log.Println(colorstring.Yellow(" => No registered VMs, create one with: replica create vagrant"))
+		return nil
+	}
+
+	table := "NAME\tSTATE\tPROVIDER\tBOX\tSNAPSHOTS\tXCODES\tDIR\n"
+	for _, aVM := range vms {
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			aVM.Name,
+			vmState(aVM),
+			aVM.Provider,
+			valueOrDash(aVM.Box.Name),
+			len(aVM.Snapshots),
+			valueOrDash(formatXcodes(aVM)),
+			aVM.Dir,
+		)
+	}
+	return printTable(table, "VM statuses")
+}
+
+// runOnRegisteredVMs runs the vagrant command (up or halt) in the directory of every selected VM
+func runOnRegisteredVMs(namesOrDirs []string, vagrantCommand string) error {
+	vms, err := readRegisteredVMs(namesOrDirs)
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		log.Println(colorstring.Yellow(" => No registered VMs, create one with: replica create vagrant"))
+		return nil
+	}
+
+	failed := []string{}
+	for _, aVM := range vms {
+		args := []string{vagrantCommand}
+		if vagrantCommand == "up" {
+			provider, err := hypervisor.NewProvider(aVM.Provider, hypervisor.Options{})
+			if err != nil {
+				return fmt.Errorf("Invalid provider of the VM (%s), error: %s", aVM.Name, err)
+			}
+			args = append(args, "--provider", provider.VagrantProvider())
+		}
+
+		fmt.Println()
+		log.Println(colorstring.Green(fmt.Sprintf(" => %s: vagrant %s", aVM.Name, vagrantCommand)))
+		if err := runVagrantCommand(aVM.Dir, nil, args...); err != nil {
+			log.Println(colorstring.Red(fmt.Sprintf(" [!] %s: failed, error: %s", aVM.Name, err)))
+			failed = append(failed, aVM.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Failed to run vagrant %s with the VMs: %s", vagrantCommand, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func destroyRegisteredVMs(namesOrDirs []string) error {
+	vms, err := readRegisteredVMs(namesOrDirs)
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		log.Println(colorstring.Yellow(" => No registered VMs"))
+		return nil
+	}
+
+	names := []string{}
+	for _, aVM := range vms {
+		names = append(names, aVM.Name)
+	}
+	if !flagVMIsYes {
+		isDestroy, err := goinp.AskForBoolWithDefault(fmt.Sprintf("Do you want to destroy the VMs: %s?", strings.Join(names, ", ")), false)
+		if err != nil {
+			return fmt.Errorf("Failed to get confirmation, error: %s", err)
+		}
+		if !isDestroy {
+			log.Println(colorstring.Yellow(" => Nothing destroyed"))
+			return nil
+		}
+	}
+
+	for _, aVM := range vms {
+		fmt.Println()
+		log.Println(colorstring.Green(" => Destroying:"), aVM.Name)
+		if isExist, err := pathutil.IsPathExists(filepath.Join(aVM.Dir, "Vagrantfile")); err != nil {
+			return fmt.Errorf("Failed to check the Vagrantfile of the VM (%s), error: %s", aVM.Name, err)
+		} else if isExist {
+			if err := runVagrantCommand(aVM.Dir, nil, "destroy", "-f"); err != nil {
+				return fmt.Errorf("Failed to destroy the VM (%s), error: %s", aVM.Name, err)
+			}
+		} else {
+			log.Println(colorstring.Yellow(" => The directory of the VM is missing, only removing it from the registry"))
+		}
+
+		if err := registry.Update(registry.DefaultPath(), func(vms *registry.RegistryModel) error {
+			vms.Remove(aVM.Dir)
+			return nil
+		}); err != nil {
+			return err
+		}
+		log.Println(colorstring.Green(" => Destroyed [OK]"))
+	}
+	printFreeDiskSpace()
+	return nil
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/spf13/cobra"
+)
+
+var flagDiskSizeMB = 0
+
+// vmDiskCmd groups the commands which operate on the disk image of a VM
+var vmDiskCmd = &cobra.Command{
+	Use:   "disk",
+	Short: "Resize or compact the disk image of a VM (with the tools of the hypervisor)",
+	Long: `Resize or compact the disk image of a VM, with the disk tool of the provider (--provider or the config file).
+
+The VM has to be halted (replica vm halt) before its disk image is modified.`,
+}
+
+var vmDiskResizeCmd = &cobra.Command{
+	Use:   "resize DISK_PATH",
+	Short: "Resize the disk image to --size-mb",
+	Long: `Resize the disk image to --size-mb.
+Only the disk image is resized, the partition of macOS has to be extended in the VM (e.g. with diskutil resizeVolume).`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No disk path provided")
+		}
+		if flagDiskSizeMB <= 0 {
+			return errors.New("No disk size provided, specify it with --size-mb")
+		}
+		return runDiskCommand(args[0], "Resizing", func(provider hypervisor.Provider, diskPath string) (*cmdex.CommandModel, error) {
+			return provider.ResizeDiskCommand(diskPath, flagDiskSizeMB)
+		})
+	},
+}
+
+var vmDiskCompactCmd = &cobra.Command{
+	Use:   "compact DISK_PATH",
+	Short: "Reclaim the unused space of the disk image",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No disk path provided")
+		}
+		return runDiskCommand(args[0], "Compacting", func(provider hypervisor.Provider, diskPath string) (*cmdex.CommandModel, error) {
+			return provider.CompactDiskCommand(diskPath)
+		})
+	},
+}
+
+func init() {
+	vmGroupCmd.AddCommand(vmDiskCmd)
+	vmDiskCmd.AddCommand(vmDiskResizeCmd)
+	vmDiskResizeCmd.Flags().IntVar(&flagDiskSizeMB, "size-mb", 0, "The new size of the disk image, in MB")
+	vmDiskCmd.AddCommand(vmDiskCompactCmd)
+}
+
+// runDiskCommand runs the disk command of the provider on the disk image
+func runDiskCommand(diskPath, actionName string, diskCommand func(provider hypervisor.Provider, diskPath string) (*cmdex.CommandModel, error)) error {
+	provider, err := loadProvider()
+	if err != nil {
+		return err
+	}
+
+	absDiskPath, err := pathutil.AbsPath(diskPath)
+	if err != nil {
+		return fmt.Errorf("Failed to get absolute path of the disk image, error: %s", err)
+	}
+	if isExist, err := pathutil.IsPathExists(absDiskPath); err != nil {
+		return fmt.Errorf("Failed to check whether the disk image exists, error: %s", err)
+	} else if !isExist {
+		return fmt.Errorf("Disk image does not exist at path: %s", absDiskPath)
+	}
+
+	cmd, err := diskCommand(provider, absDiskPath)
+	if err == hypervisor.ErrNotSupported {
+		return fmt.Errorf("%s the disk image is not supported with the %s provider", actionName, provider.DisplayName())
+	} else if err != nil {
+		return fmt.Errorf("Failed to create the disk command, error: %s", err)
+	}
+	cmd.SetStdout(os.Stdout).SetStderr(os.Stderr)
+
+	log.Println(colorstring.Green(fmt.Sprintf(" => %s the disk image: %s", actionName, absDiskPath)))
+	fmt.Println()
+	log.Printf("$ %s", cmd.PrintableCommandArgs())
+	fmt.Println()
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("Failed to run command, error: %s", err)
+	}
+	log.Println(colorstring.Green(" => Disk image DONE! [OK]"))
+	return nil
+}
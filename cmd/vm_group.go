@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// vmGroupCmd groups the commands which manage the created vagrant VMs
+var vmGroupCmd = &cobra.Command{
+	Use:   "vm",
+	Short: "Manage the created vagrant VMs",
+	Long: `Manage the created vagrant VMs.
+
+NOTE: You can create a vagrant VM with: replica create vagrant`,
+}
+
+func init() {
+	RootCmd.AddCommand(vmGroupCmd)
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/registry"
+	"github.com/bitrise-io/replica/snapshot"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/spf13/cobra"
+)
+
+// defaultAutoSnapshotKeep is the number of automatic snapshots kept per step
+const defaultAutoSnapshotKeep = 3
+
+var (
+	flagSnapshotVMDir      = "."
+	flagSnapshotKeep       = 0
+	flagIsAutoSnapshot     = false
+	flagAutoSnapshotKeep   = defaultAutoSnapshotKeep
+	flagSnapshotIsNoVerify = false
+)
+
+// vmSnapshotCmd groups the snapshot commands
+var vmSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Manage the snapshots of a vagrant VM",
+	Long: `Manage the snapshots of a vagrant VM.
+
+The snapshots saved by replica are named with a timestamp, and an optional label
+(e.g. replica-xcode-sync-20170102-150405). The initial snapshot (` + snapshot.InitialName + `)
+is taken right after the VM is created.`,
+}
+
+var vmSnapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the snapshots of the VM",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listVMSnapshots(flagSnapshotVMDir)
+	},
+}
+
+var vmSnapshotSaveCmd = &cobra.Command{
+	Use:   "save [LABEL]",
+	Short: "Save a timestamped snapshot of the VM",
+	Long: `Save a timestamped snapshot of the VM, e.g. replica-before-upgrade-20170102-150405.
+
+With --keep N only the newest N timestamped snapshots with the same label are kept,
+the older ones are deleted.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		label := ""
+		if len(args) > 0 {
+			label = args[0]
+		}
+		_, err := saveTimestampedSnapshot(flagSnapshotVMDir, label, flagSnapshotKeep)
+		return err
+	},
+}
+
+var vmSnapshotRestoreCmd = &cobra.Command{
+	Use:   "restore SNAPSHOT_NAME",
+	Short: "Restore a snapshot of the VM",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No snapshot name provided")
+		}
+		return restoreVMSnapshot(flagSnapshotVMDir, args[0])
+	},
+}
+
+var vmSnapshotDeleteCmd = &cobra.Command{
+	Use:   "delete SNAPSHOT_NAME...",
+	Short: "Delete snapshots of the VM",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No snapshot name provided")
+		}
+		for _, aName := range args {
+			if err := deleteVMSnapshot(flagSnapshotVMDir, aName); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var vmSnapshotResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Restore the initial snapshot of the VM (" + snapshot.InitialName + ")",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return restoreVMSnapshot(flagSnapshotVMDir, snapshot.InitialName)
+	},
+}
+
+func init() {
+	vmGroupCmd.AddCommand(vmSnapshotCmd)
+	vmSnapshotCmd.PersistentFlags().StringVar(&flagSnapshotVMDir, "dir", ".", "Directory of the vagrant VM")
+	vmSnapshotCmd.AddCommand(vmSnapshotListCmd)
+	vmSnapshotCmd.AddCommand(vmSnapshotSaveCmd)
+	vmSnapshotSaveCmd.Flags().IntVar(&flagSnapshotKeep, "keep", 0, "Keep only the newest N timestamped snapshots with the same label (default: keep every snapshot)")
+	vmSnapshotCmd.AddCommand(vmSnapshotRestoreCmd)
+	vmSnapshotRestoreCmd.Flags().BoolVar(&flagSnapshotIsNoVerify, "no-verify", false, "Do not check whether the snapshot exists before restoring it")
+	vmSnapshotCmd.AddCommand(vmSnapshotDeleteCmd)
+	vmSnapshotCmd.AddCommand(vmSnapshotResetCmd)
+}
+
+// addAutoSnapshotFlags registers the flags of the automatic snapshots,
+// taken after the key steps of the VM creation (e.g. the Xcode sync)
+func addAutoSnapshotFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&flagIsAutoSnapshot, "auto-snapshot", false, "Save a timestamped snapshot of the VM after the key steps, e.g. replica-xcode-sync-20170102-150405 after the Xcode sync")
+	cmd.Flags().IntVar(&flagAutoSnapshotKeep, "auto-snapshot-keep", defaultAutoSnapshotKeep, "Number of automatic snapshots to keep per step")
+}
+
+// listSnapshots returns the snapshots of the VM
+func listSnapshots(vmDir string) ([]snapshot.SnapshotModel, error) {
+	names, err := vagrantcli.ListSnapshots(vmDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.ParseAll(names), nil
+}
+
+func listVMSnapshots(vmDir string) error {
+	snapshots, err := listSnapshots(vmDir)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		log.Println(colorstring.Yellow(" => No snapshots of the VM in:"), vmDir)
+		return nil
+	}
+
+	table := "NAME\tLABEL\tCREATED\n"
+	for _, aSnapshot := range snapshots {
+		label, createdAt := valueOrDash(aSnapshot.Label), "-"
+		if aSnapshot.Name == snapshot.InitialName {
+			label = "(initial)"
+		}
+		if aSnapshot.IsTimestamped() {
+			createdAt = aSnapshot.CreatedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		table += fmt.Sprintf("%s\t%s\t%s\n", aSnapshot.Name, label, createdAt)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprint(w, table); err != nil {
+		return fmt.Errorf("Failed to print snapshots, error: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("Failed to print snapshots, error: %s", err)
+	}
+	return nil
+}
+
+// saveTimestampedSnapshot saves a timestamped snapshot of the VM, then deletes the timestamped snapshots
+// with the same label, which are over the keep limit (if keep is positive). Returns the name of the snapshot.
+func saveTimestampedSnapshot(vmDir, label string, keep int) (string, error) {
+	if err := snapshot.ValidateLabel(label); err != nil {
+		return "", err
+	}
+	if keep < 0 {
+		return "", errors.New("Invalid number of snapshots to keep, should be a positive number")
+	}
+
+	name := snapshot.NewName(label, time.Now())
+	log.Println(colorstring.Green(" => Saving snapshot:"), name)
+	if err := createVagrantSnapshot(vmDir, name, nil); err != nil {
+		return "", fmt.Errorf("Failed to create vagrant snapshot, error: %s", err)
+	}
+	log.Println(colorstring.Green(" => Snapshot saved! [OK]"))
+
+	if keep > 0 {
+		snapshots, err := listSnapshots(vmDir)
+		if err != nil {
+			return name, err
+		}
+		for _, anExpired := range snapshot.SelectExpired(snapshots, label, keep) {
+			log.Printf(" => Deleting the snapshot over the limit of %d: %s", keep, anExpired.Name)
+			if err := deleteVMSnapshot(vmDir, anExpired.Name); err != nil {
+				return name, err
+			}
+		}
+	}
+	return name, nil
+}
+
+// autoSnapshot saves a timestamped snapshot after the step, if the automatic snapshots are enabled
+func autoSnapshot(vmDir string, provider hypervisor.Provider, step string) error {
+	if !flagIsAutoSnapshot {
+		return nil
+	}
+	if !provider.IsSnapshotSupported() {
+		log.Println(colorstring.Yellow(" => Snapshots are not supported with the " + provider.DisplayName() + " provider, skipping the snapshot after: " + step))
+		return nil
+	}
+	fmt.Println()
+	if _, err := saveTimestampedSnapshot(vmDir, step, flagAutoSnapshotKeep); err != nil {
+		return fmt.Errorf("Failed to save the snapshot after %s, error: %s", step, err)
+	}
+	return nil
+}
+
+func restoreVMSnapshot(vmDir, name string) error {
+	if !flagSnapshotIsNoVerify {
+		snapshots, err := listSnapshots(vmDir)
+		if err != nil {
+			return err
+		}
+		isFound := false
+		for _, aSnapshot := range snapshots {
+			isFound = isFound || aSnapshot.Name == name
+		}
+		if !isFound {
+			return fmt.Errorf("The VM in (%s) has no snapshot named: %s, list the snapshots with: replica vm snapshot list", vmDir, name)
+		}
+	}
+
+	log.Println(colorstring.Green(" => Restoring snapshot:"), name)
+	if err := runVagrantCommand(vmDir, nil, "snapshot", "restore", name); err != nil {
+		return fmt.Errorf("Failed to restore vagrant snapshot, error: %s", err)
+	}
+	log.Println(colorstring.Green(" => Snapshot restored! [OK]"))
+	return nil
+}
+
+func deleteVMSnapshot(vmDir, name string) error {
+	if err := runVagrantCommand(vmDir, nil, "snapshot", "delete", name); err != nil {
+		return fmt.Errorf("Failed to delete vagrant snapshot (%s), error: %s", name, err)
+	}
+	updateRegisteredVM(vmDir, func(vm *registry.VMModel) {
+		vm.RemoveSnapshot(name)
+	})
+	return nil
+}
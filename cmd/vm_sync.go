@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"errors"
+	"log"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/replica/vmsync"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagSyncVMDir   = "."
+	flagSyncOptions = vmsync.OptionsModel{}
+)
+
+// vmSyncCmd groups the commands which sync files between the host and a VM
+var vmSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync files between the host and a vagrant VM (with rsync)",
+	Long: `Sync files between the host and a vagrant VM, with rsync over the SSH connection of the VM.
+
+The paths are passed to rsync as they are: a trailing / syncs the content of a directory,
+without it the directory itself is synced into the destination.
+The includes are applied before the excludes, e.g. --include '*.log' --exclude '*' syncs only the logs.`,
+}
+
+var vmSyncPushCmd = &cobra.Command{
+	Use:   "push HOST_PATH VM_PATH",
+	Short: "Sync from the host into the VM, e.g. to push the sources",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("No host or VM path provided")
+		}
+		return syncVM(vmsync.Push, args[0], args[1])
+	},
+}
+
+var vmSyncPullCmd = &cobra.Command{
+	Use:   "pull VM_PATH HOST_PATH",
+	Short: "Sync from the VM to the host, e.g. to pull the build artifacts and logs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 2 {
+			return errors.New("No VM or host path provided")
+		}
+		return syncVM(vmsync.Pull, args[1], args[0])
+	},
+}
+
+func init() {
+	vmGroupCmd.AddCommand(vmSyncCmd)
+	vmSyncCmd.PersistentFlags().StringVar(&flagSyncVMDir, "dir", ".", "Directory of the vagrant VM")
+	vmSyncCmd.PersistentFlags().StringSliceVar(&flagSyncOptions.Includes, "include", []string{}, "rsync include pattern, the matching files are not excluded (can be specified multiple times)")
+	vmSyncCmd.PersistentFlags().StringSliceVar(&flagSyncOptions.Excludes, "exclude", []string{}, "rsync exclude pattern (can be specified multiple times)")
+	vmSyncCmd.PersistentFlags().BoolVar(&flagSyncOptions.IsDelete, "delete", false, "Delete the files of the destination, which do not exist in the source")
+	vmSyncCmd.PersistentFlags().BoolVar(&flagSyncOptions.IsChecksum, "checksum", false, "Compare the files by checksum, instead of by size and modification time")
+	vmSyncCmd.PersistentFlags().IntVar(&flagSyncOptions.BandwidthLimit, "bwlimit", 0, "Maximum transfer rate in KB/s (default: no limit)")
+	vmSyncCmd.AddCommand(vmSyncPushCmd)
+	vmSyncCmd.AddCommand(vmSyncPullCmd)
+}
+
+func syncVM(direction, hostPath, vmPath string) error {
+	opts := flagSyncOptions
+	opts.Direction = direction
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if direction == vmsync.Push {
+		log.Println(colorstring.Green(" => Pushing: " + hostPath + " -> " + vmPath))
+	} else {
+		log.Println(colorstring.Green(" => Pulling: " + vmPath + " -> " + hostPath))
+	}
+	if err := rsyncVM(flagSyncVMDir, opts, hostPath, vmPath); err != nil {
+		return err
+	}
+	log.Println(colorstring.Green(" => Sync DONE! [OK]"))
+	return nil
+}
@@ -0,0 +1,411 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/go-utils/colorstring"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/registry"
+	"github.com/bitrise-io/replica/vagrantcli"
+	"github.com/bitrise-io/replica/xcode"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagXcodeVMDir    = "."
+	flagXcodeIsSelect = false
+	flagXcodeIsForce  = false
+	flagXcodeAppPaths = []string{}
+	flagDefaultXcode  = ""
+)
+
+// vmXcodeCmd groups the commands which manage the Xcode.apps of a VM
+var vmXcodeCmd = &cobra.Command{
+	Use:   "xcode",
+	Short: "Manage the Xcode.apps of a vagrant VM",
+	Long: `Manage the Xcode.apps of a vagrant VM.
+
+The Xcode.apps are synced into versioned paths (e.g. ` + xcode.VersionedAppPath("8.3.2") + `),
+the version is read from the version.plist of the Xcode.app. The default Xcode.app is selected with xcode-select.`,
+}
+
+var vmXcodeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the Xcode.apps of the VM",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return printVMXcodes(flagXcodeVMDir)
+	},
+}
+
+var vmXcodeAddCmd = &cobra.Command{
+	Use:   "add XCODE_APP_OR_XIP_PATH...",
+	Short: "Sync Xcode.apps or .xip archives of the host into the VM",
+	Long: `Sync Xcode.apps or .xip archives of the host into the VM.
+
+A .xip archive is verified, uploaded and expanded in the VM (or expanded on the host,
+if the VM has no xip tool), which is much faster than syncing the files of the expanded Xcode.app.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No Xcode.app path provided")
+		}
+		hostXcodes, err := readHostXcodes(args, "")
+		if err != nil {
+			return err
+		}
+		syncedXcodes, err := syncXcodes(flagXcodeVMDir, hostXcodes)
+		if err != nil {
+			return err
+		}
+		if flagXcodeIsSelect {
+			fmt.Println()
+			return selectSyncedXcode(flagXcodeVMDir, syncedXcodes, "")
+		}
+		return nil
+	},
+}
+
+var vmXcodeRemoveCmd = &cobra.Command{
+	Use:   "remove VERSION|PATH",
+	Short: "Remove an Xcode.app from the VM",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No Xcode version or path provided")
+		}
+		return removeVMXcode(flagXcodeVMDir, args[0])
+	},
+}
+
+var vmXcodeSelectCmd = &cobra.Command{
+	Use:   "select VERSION|PATH",
+	Short: "Select the default Xcode.app of the VM (with xcode-select)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("No Xcode version or path provided")
+		}
+		app, err := findVMXcode(flagXcodeVMDir, args[0])
+		if err != nil {
+			return err
+		}
+		return selectVMXcode(flagXcodeVMDir, app.Path)
+	},
+}
+
+func init() {
+	vmGroupCmd.AddCommand(vmXcodeCmd)
+	vmXcodeCmd.PersistentFlags().StringVar(&flagXcodeVMDir, "dir", ".", "Directory of the vagrant VM")
+	vmXcodeCmd.AddCommand(vmXcodeListCmd)
+	vmXcodeCmd.AddCommand(vmXcodeAddCmd)
+	vmXcodeAddCmd.Flags().BoolVar(&flagXcodeIsSelect, "select", false, "Select the (first) added Xcode.app as the default one")
+	vmXcodeCmd.AddCommand(vmXcodeRemoveCmd)
+	vmXcodeRemoveCmd.Flags().BoolVar(&flagXcodeIsForce, "force", false, "Remove the Xcode.app even if it is the default one")
+	vmXcodeCmd.AddCommand(vmXcodeSelectCmd)
+}
+
+// addXcodeFlags registers the flags of the Xcode sync step of the VM creation
+func addXcodeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&flagXcodeAppPaths, "xcode", []string{}, "Xcode.app or .xip archive to sync into the VM, to "+xcode.VersionedAppPath("VERSION")+" (can be specified multiple times). Default: asked interactively")
+	cmd.Flags().StringVar(&flagDefaultXcode, "default-xcode", "", "Version of the Xcode.app to select as the default one (default: the first synced Xcode.app)")
+}
+
+// vmXipExpandDir is the directory of the VM, the .xip archives are expanded into
+const vmXipExpandDir = "/tmp/replica-xcode-xip"
+
+// hostXcodeModel is an Xcode.app or a .xip archive of the host, to be synced into the VM
+type hostXcodeModel struct {
+	Path string
+	// Version is the version of the Xcode.app, or the version in the name of the .xip archive (if any)
+	Version xcode.VersionModel
+}
+
+// splitXcodeAppPaths splits the comma separated list of Xcode.app paths
+func splitXcodeAppPaths(paths string) []string {
+	appPaths := []string{}
+	for _, aPath := range strings.Split(paths, ",") {
+		if aPath = strings.TrimSpace(aPath); aPath != "" {
+			appPaths = append(appPaths, aPath)
+		}
+	}
+	return appPaths
+}
+
+// readHostXcodes reads the versions of the Xcode.apps of the host. The versions have to be unique,
+// as the version determines the path of the Xcode.app in the VM, and the default version (if any) has to be one of them.
+// The version of a .xip archive is only known once it's expanded, until then the version in its name is used.
+func readHostXcodes(appPaths []string, defaultVersion string) ([]hostXcodeModel, error) {
+	hostXcodes := []hostXcodeModel{}
+	pathsByVersion := map[string]string{}
+	isAnyVersionUnknown := false
+	for _, aPath := range appPaths {
+		version := xcode.VersionModel{}
+		if xcode.IsXip(aPath) {
+			if isExist, err := pathutil.IsPathExists(aPath); err != nil {
+				return nil, fmt.Errorf("Failed to check the .xip archive (%s), error: %s", aPath, err)
+			} else if !isExist {
+				return nil, fmt.Errorf("The .xip archive does not exist: %s", aPath)
+			}
+			version.Version = xcode.VersionOfXipName(aPath)
+			if version.Version == "" {
+				isAnyVersionUnknown = true
+				hostXcodes = append(hostXcodes, hostXcodeModel{Path: aPath})
+				continue
+			}
+		} else {
+			appVersion, err := xcode.ReadAppVersion(aPath)
+			if err != nil {
+				return nil, err
+			}
+			if err := xcode.ValidateVersion(appVersion.Version); err != nil {
+				return nil, fmt.Errorf("Invalid version of the Xcode.app (%s), error: %s", aPath, err)
+			}
+			version = appVersion
+		}
+		if otherPath, isFound := pathsByVersion[version.Version]; isFound {
+			return nil, fmt.Errorf("The Xcode.apps (%s, %s) have the same version: %s", otherPath, aPath, version.Version)
+		}
+		pathsByVersion[version.Version] = aPath
+		hostXcodes = append(hostXcodes, hostXcodeModel{Path: aPath, Version: version})
+	}
+	if _, isFound := pathsByVersion[defaultVersion]; defaultVersion != "" && len(appPaths) > 0 && !isFound && !isAnyVersionUnknown {
+		return nil, fmt.Errorf("The default Xcode version (%s) is not the version of any of the Xcode.apps", defaultVersion)
+	}
+	return hostXcodes, nil
+}
+
+// syncXcodes syncs the Xcode.apps and .xip archives into their versioned paths in the VM, returns the synced Xcode.apps
+func syncXcodes(vmDir string, hostXcodes []hostXcodeModel) ([]xcode.AppModel, error) {
+	syncedXcodes := []xcode.AppModel{}
+	for _, aHostXcode := range hostXcodes {
+		fmt.Println()
+		var syncedXcode xcode.AppModel
+		if xcode.IsXip(aHostXcode.Path) {
+			app, err := syncXcodeArchive(vmDir, aHostXcode, syncedXcodes)
+			if err != nil {
+				return nil, err
+			}
+			syncedXcode = app
+		} else {
+			syncedXcode = xcode.AppModel{VersionModel: aHostXcode.Version, Path: xcode.VersionedAppPath(aHostXcode.Version.Version)}
+			if err := verifyNotSyncedYet(syncedXcode, syncedXcodes); err != nil {
+				return nil, err
+			}
+			log.Println(colorstring.Green(fmt.Sprintf(" => Sync %s (%s) to: %s", aHostXcode.Path, aHostXcode.Version.Version, syncedXcode.Path)))
+			if err := uploadDir(vmDir, aHostXcode.Path, syncedXcode.Path); err != nil {
+				return nil, fmt.Errorf("failed to sync Xcode.app (%s), error: %s", aHostXcode.Path, err)
+			}
+		}
+
+		recordSyncedXcode(vmDir, syncedXcode.Path, syncedXcode.VersionModel)
+		syncedXcodes = append(syncedXcodes, syncedXcode)
+	}
+	return syncedXcodes, nil
+}
+
+// verifyNotSyncedYet checks that no other Xcode.app was synced to the path of the Xcode.app,
+// before the synced one is replaced by it
+func verifyNotSyncedYet(app xcode.AppModel, syncedXcodes []xcode.AppModel) error {
+	for _, anOther := range syncedXcodes {
+		if anOther.Path == app.Path {
+			return fmt.Errorf("An Xcode.app was already synced to (%s), the Xcode.apps have the same version: %s", app.Path, app.Version)
+		}
+	}
+	return nil
+}
+
+// verifyExpandedXcode checks the version of the Xcode.app expanded from the .xip archive,
+// and that it does not replace an already synced Xcode.app
+func verifyExpandedXcode(hostXcode hostXcodeModel, version xcode.VersionModel, syncedXcodes []xcode.AppModel) error {
+	if err := xcode.ValidateVersion(version.Version); err != nil {
+		return fmt.Errorf("Invalid version of the Xcode.app expanded from (%s), error: %s", hostXcode.Path, err)
+	}
+	if hostXcode.Version.Version != "" && !xcode.IsSameVersion(hostXcode.Version.Version, version.Version) {
+		return fmt.Errorf("The version of the Xcode.app (%s) expanded from (%s) does not match the version in the name of the archive: %s", version.Version, hostXcode.Path, hostXcode.Version.Version)
+	}
+	return verifyNotSyncedYet(xcode.AppModel{VersionModel: version, Path: xcode.VersionedAppPath(version.Version)}, syncedXcodes)
+}
+
+// syncXcodeArchive verifies the .xip archive, then uploads and expands it in the VM. If the VM has no xip tool,
+// the archive is expanded on the host, and the Xcode.app is synced instead. Returns the synced Xcode.app.
+// The expanded Xcode.app is not installed if an Xcode.app of the same version is already synced (syncedXcodes).
+func syncXcodeArchive(vmDir string, hostXcode hostXcodeModel, syncedXcodes []xcode.AppModel) (xcode.AppModel, error) {
+	log.Println(colorstring.Green(" => Verifying the .xip archive:"), hostXcode.Path)
+	if err := xcode.VerifyXip(hostXcode.Path); err != nil {
+		return xcode.AppModel{}, err
+	}
+
+	var uploadDuration, expandDuration time.Duration
+	app := xcode.AppModel{}
+	if _, err := vagrantcli.RunScript(vmDir, xcode.XipCheckScript); err == nil {
+		vmXipPath := filepath.Join("/tmp", filepath.Base(hostXcode.Path))
+		log.Println(colorstring.Green(" => Uploading the .xip archive to:"), vmXipPath)
+		startTime := time.Now()
+		if err := uploadFile(vmDir, hostXcode.Path, vmXipPath); err != nil {
+			return xcode.AppModel{}, fmt.Errorf("Failed to upload the .xip archive (%s), error: %s", hostXcode.Path, err)
+		}
+		uploadDuration = time.Since(startTime)
+
+		log.Println(colorstring.Green(" => Expanding the .xip archive in the VM, this can take a while ..."))
+		startTime = time.Now()
+		output, err := vagrantcli.RunScript(vmDir, xcode.ExpandXipScript(vmXipPath, vmXipExpandDir))
+		if err != nil {
+			return xcode.AppModel{}, fmt.Errorf("Failed to expand the .xip archive in the VM, error: %s", err)
+		}
+		expandDuration = time.Since(startTime)
+		apps, err := xcode.ParseAppList(output)
+		if err != nil {
+			return xcode.AppModel{}, err
+		}
+		if len(apps) != 1 {
+			return xcode.AppModel{}, fmt.Errorf("The .xip archive (%s) should contain exactly one Xcode.app, found: %d", hostXcode.Path, len(apps))
+		}
+		if err := verifyExpandedXcode(hostXcode, apps[0].VersionModel, syncedXcodes); err != nil {
+			return xcode.AppModel{}, err
+		}
+
+		app = xcode.AppModel{VersionModel: apps[0].VersionModel, Path: xcode.VersionedAppPath(apps[0].Version)}
+		if _, err := vagrantcli.RunScript(vmDir, xcode.InstallScript(apps[0].Path, app.Path, vmXipExpandDir)); err != nil {
+			return xcode.AppModel{}, fmt.Errorf("Failed to move the expanded Xcode.app to: %s, error: %s", app.Path, err)
+		}
+	} else {
+		log.Println(colorstring.Yellow(" => The VM has no xip tool, expanding the .xip archive on the host, this can take a while ..."))
+		expandDir, err := ioutil.TempDir("", "replica-xcode-xip")
+		if err != nil {
+			return xcode.AppModel{}, fmt.Errorf("Failed to create a temporary directory for the expanded Xcode.app, error: %s", err)
+		}
+		defer func() {
+			if err := os.RemoveAll(expandDir); err != nil {
+				log.Printf(" [!] Failed to remove the directory (%s), error: %s", expandDir, err)
+			}
+		}()
+
+		startTime := time.Now()
+		appPath, err := xcode.ExpandXip(hostXcode.Path, expandDir)
+		if err != nil {
+			return xcode.AppModel{}, err
+		}
+		expandDuration = time.Since(startTime)
+		version, err := xcode.ReadAppVersion(appPath)
+		if err != nil {
+			return xcode.AppModel{}, err
+		}
+		if err := verifyExpandedXcode(hostXcode, version, syncedXcodes); err != nil {
+			return xcode.AppModel{}, err
+		}
+
+		app = xcode.AppModel{VersionModel: version, Path: xcode.VersionedAppPath(version.Version)}
+		log.Println(colorstring.Green(fmt.Sprintf(" => Sync %s (%s) to: %s", appPath, version.Version, app.Path)))
+		startTime = time.Now()
+		if err := uploadDir(vmDir, appPath, app.Path); err != nil {
+			return xcode.AppModel{}, fmt.Errorf("failed to sync Xcode.app (%s), error: %s", appPath, err)
+		}
+		uploadDuration = time.Since(startTime)
+	}
+
+	log.Println(colorstring.Green(fmt.Sprintf(" => Xcode %s (%s) synced to: %s [OK]", app.Version, valueOrDash(app.Build), app.Path)))
+	log.Printf(" => Upload: %s, expansion: %s", uploadDuration.Round(time.Second), expandDuration.Round(time.Second))
+	return app, nil
+}
+
+// selectSyncedXcode selects the synced Xcode.app of the version, or the first one if no version is specified
+func selectSyncedXcode(vmDir string, syncedXcodes []xcode.AppModel, version string) error {
+	if len(syncedXcodes) == 0 {
+		return errors.New("No Xcode.app synced")
+	}
+	if version == "" {
+		return selectVMXcode(vmDir, syncedXcodes[0].Path)
+	}
+	app, isFound := xcode.FindApp(syncedXcodes, version)
+	if !isFound {
+		return fmt.Errorf("The default Xcode version (%s) is not the version of any of the synced Xcode.apps", version)
+	}
+	return selectVMXcode(vmDir, app.Path)
+}
+
+// recordSyncedXcode records the Xcode.app synced into the VM
+func recordSyncedXcode(vmDir, vmAppPath string, version xcode.VersionModel) {
+	updateRegisteredVM(vmDir, func(vm *registry.VMModel) {
+		vm.SetXcode(registry.XcodeModel{VersionModel: version, Path: vmAppPath, SyncedAt: time.Now()})
+	})
+}
+
+// listVMXcodes returns the Xcode.apps of the VM
+func listVMXcodes(vmDir string) ([]xcode.AppModel, error) {
+	output, err := vagrantcli.RunScript(vmDir, xcode.ListAppsScript)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list the Xcode.apps of the VM, error: %s", err)
+	}
+	return xcode.ParseAppList(output)
+}
+
+// findVMXcode returns the Xcode.app of the VM, by version or path
+func findVMXcode(vmDir, versionOrPath string) (xcode.AppModel, error) {
+	apps, err := listVMXcodes(vmDir)
+	if err != nil {
+		return xcode.AppModel{}, err
+	}
+	app, isFound := xcode.FindApp(apps, versionOrPath)
+	if !isFound {
+		return xcode.AppModel{}, fmt.Errorf("The VM in (%s) has no Xcode.app with the version or path: %s, list the Xcode.apps with: replica vm xcode list", vmDir, versionOrPath)
+	}
+	return app, nil
+}
+
+func printVMXcodes(vmDir string) error {
+	apps, err := listVMXcodes(vmDir)
+	if err != nil {
+		return err
+	}
+	if len(apps) == 0 {
+		log.Println(colorstring.Yellow(" => No Xcode.apps in the VM in:"), vmDir)
+		return nil
+	}
+
+	table := "VERSION\tBUILD\tDEFAULT\tPATH\n"
+	for _, anApp := range apps {
+		isDefault := ""
+		if anApp.IsSelected {
+			isDefault = "*"
+		}
+		table += fmt.Sprintf("%s\t%s\t%s\t%s\n", valueOrDash(anApp.Version), valueOrDash(anApp.Build), isDefault, anApp.Path)
+	}
+	return printTable(table, "Xcode.apps")
+}
+
+// selectVMXcode selects the Xcode.app as the default one of the VM
+func selectVMXcode(vmDir, vmAppPath string) error {
+	log.Println(colorstring.Green(" => Selecting the default Xcode.app:"), vmAppPath)
+	if _, err := vagrantcli.RunScript(vmDir, xcode.SelectScript(vmAppPath)); err != nil {
+		return fmt.Errorf("Failed to select the Xcode.app (%s), error: %s", vmAppPath, err)
+	}
+	updateRegisteredVM(vmDir, func(vm *registry.VMModel) {
+		vm.SelectXcode(vmAppPath)
+	})
+	log.Println(colorstring.Green(" => Xcode.app selected! [OK]"))
+	return nil
+}
+
+func removeVMXcode(vmDir, versionOrPath string) error {
+	app, err := findVMXcode(vmDir, versionOrPath)
+	if err != nil {
+		return err
+	}
+	if app.IsSelected && !flagXcodeIsForce {
+		return fmt.Errorf("The Xcode.app (%s) is the default one of the VM, select another one first, or use --force", app.Path)
+	}
+
+	log.Println(colorstring.Green(" => Removing the Xcode.app:"), app.Path)
+	if _, err := vagrantcli.RunScript(vmDir, xcode.RemoveScript(app.Path)); err != nil {
+		return fmt.Errorf("Failed to remove the Xcode.app (%s), error: %s", app.Path, err)
+	}
+	updateRegisteredVM(vmDir, func(vm *registry.VMModel) {
+		vm.RemoveXcode(app.Path)
+	})
+	log.Println(colorstring.Green(" => Xcode.app removed! [OK]"))
+	return nil
+}
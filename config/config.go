@@ -0,0 +1,67 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/vagrantbox"
+	"github.com/bitrise-io/replica/vagrantfile"
+)
+
+// BoxConfigModel is the configuration of the vagrant box creation
+type BoxConfigModel struct {
+	Provisioning vagrantbox.ProvisionStepsModel `json:"provisioning"`
+	Hardware     hypervisor.HardwareModel       `json:"hardware"`
+}
+
+// ConfigModel is the replica configuration
+type ConfigModel struct {
+	// Provider is the hypervisor the box is built with and the VM runs on (see: hypervisor.ProviderNames)
+	Provider string         `json:"provider,omitempty"`
+	Box      BoxConfigModel `json:"box"`
+	// VM are the settings of the Vagrantfile of the created vagrant VMs
+	VM vagrantfile.SettingsModel `json:"vm"`
+}
+
+// ReadConfigFromFile reads the JSON configuration file.
+// The relative paths in the configuration are relative to the configuration file's directory.
+func ReadConfigFromFile(configPath string) (ConfigModel, error) {
+	absConfigPath, err := pathutil.AbsPath(configPath)
+	if err != nil {
+		return ConfigModel{}, fmt.Errorf("Failed to get absolute path of config file (path: %s), error: %s", configPath, err)
+	}
+
+	content, err := fileutil.ReadBytesFromFile(absConfigPath)
+	if err != nil {
+		return ConfigModel{}, fmt.Errorf("Failed to read config file (path: %s), error: %s", absConfigPath, err)
+	}
+
+	config, err := parseConfig(content)
+	if err != nil {
+		return ConfigModel{}, fmt.Errorf("Invalid config file (path: %s), error: %s", absConfigPath, err)
+	}
+	config.normalizePaths(filepath.Dir(absConfigPath))
+
+	return config, nil
+}
+
+func parseConfig(content []byte) (ConfigModel, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	var config ConfigModel
+	if err := decoder.Decode(&config); err != nil {
+		return ConfigModel{}, err
+	}
+	return config, nil
+}
+
+func (config *ConfigModel) normalizePaths(baseDir string) {
+	config.Box.Provisioning.NormalizePaths(baseDir)
+	config.VM.NormalizePaths(baseDir)
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/vagrantbox"
+	"github.com/bitrise-io/replica/vagrantfile"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfigFromFile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("provisioning steps")
+	{
+		configPath := filepath.Join(tmpDir, "replica.json")
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`{
+  "box": {
+    "provisioning": {
+      "env": {
+        "HOMEBREW_NO_ANALYTICS": "1"
+      },
+      "steps": [
+        {
+          "name": "homebrew",
+          "type": "shell",
+          "script": "scripts/homebrew.sh",
+          "run_as": "user",
+          "after": "xcode-cli-tools"
+        },
+        {
+          "name": "gemrc",
+          "type": "file",
+          "source": "/etc/gemrc",
+          "destination": "/etc/gemrc"
+        }
+      ]
+    }
+  }
+}`), 0600))
+
+		config, err := ReadConfigFromFile(configPath)
+		require.NoError(t, err)
+		require.Equal(t, vagrantbox.ProvisionStepsModel{
+			Env: map[string]string{"HOMEBREW_NO_ANALYTICS": "1"},
+			Steps: []vagrantbox.ProvisionStepModel{
+				{
+					Name:   "homebrew",
+					Type:   "shell",
+					Script: filepath.Join(tmpDir, "scripts/homebrew.sh"),
+					RunAs:  "user",
+					After:  "xcode-cli-tools",
+				},
+				{
+					Name:        "gemrc",
+					Type:        "file",
+					Source:      "/etc/gemrc",
+					Destination: "/etc/gemrc",
+				},
+			},
+		}, config.Box.Provisioning)
+	}
+
+	t.Log("hardware")
+	{
+		configPath := filepath.Join(tmpDir, "hardware.json")
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`{"box": {"hardware": {"cpus": 4, "memory": 8192, "ssh_wait_timeout": "2h"}}}`), 0600))
+
+		config, err := ReadConfigFromFile(configPath)
+		require.NoError(t, err)
+		require.Equal(t, hypervisor.HardwareModel{CPUs: 4, MemoryMB: 8192, SSHWaitTimeout: "2h"}, config.Box.Hardware)
+	}
+
+	t.Log("vm")
+	{
+		configPath := filepath.Join(tmpDir, "vm.json")
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`{"vm": {"box": "macos-sierra", "cpus": 4, "linked_clone": true,
+  "forwarded_ports": [{"host": 8080, "guest": 80}],
+  "synced_folders": [{"host": "src", "guest": "/Users/vagrant/src", "type": "rsync"}]}}`), 0600))
+
+		config, err := ReadConfigFromFile(configPath)
+		require.NoError(t, err)
+		isLinkedClone := true
+		require.Equal(t, vagrantfile.SettingsModel{
+			BoxName:       "macos-sierra",
+			CPUs:          4,
+			IsLinkedClone: &isLinkedClone,
+			PortForwards:  []vagrantfile.PortForwardModel{{Host: 8080, Guest: 80}},
+			SyncedFolders: []vagrantfile.SyncedFolderModel{{Host: filepath.Join(tmpDir, "src"), Guest: "/Users/vagrant/src", Type: "rsync"}},
+		}, config.VM)
+	}
+
+	t.Log("unknown key")
+	{
+		configPath := filepath.Join(tmpDir, "typo.json")
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`{"box": {"provisoning": {}}}`), 0600))
+
+		_, err := ReadConfigFromFile(configPath)
+		require.Error(t, err)
+	}
+
+	t.Log("missing file")
+	{
+		_, err := ReadConfigFromFile(filepath.Join(tmpDir, "missing.json"))
+		require.Error(t, err)
+	}
+}
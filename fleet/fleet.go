@@ -0,0 +1,140 @@
+package fleet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/vagrantfile"
+)
+
+const (
+	// DefaultNamePrefix is the prefix of the names (and hostnames) of the VMs
+	DefaultNamePrefix = "replica"
+	// DefaultSSHPortBase is the host port forwarded to the SSH port of the first VM,
+	// the next VMs get the next ports. vagrant's default SSH ports are 2222 and 2200 - 2250.
+	DefaultSSHPortBase = 2300
+
+	sshPortForwardID = "ssh"
+	guestSSHPort     = 22
+)
+
+// OptionsModel ...
+type OptionsModel struct {
+	Count int
+	// NamePrefix is the prefix of the names of the VMs, e.g. replica for replica-01, replica-02 ...
+	NamePrefix string
+	// SSHPortBase is the host port forwarded to the SSH port of the first VM
+	SSHPortBase int
+}
+
+// MemberModel is a VM of the fleet
+type MemberModel struct {
+	// Name is the name of the VM's directory, and the hostname of the VM
+	Name string
+	Dir  string
+	// Settings are the settings of the VM's Vagrantfile
+	Settings vagrantfile.SettingsModel
+}
+
+// SSHPort returns the host port forwarded to the SSH port of the VM
+func (member MemberModel) SSHPort() int {
+	for _, aPortForward := range member.Settings.PortForwards {
+		if aPortForward.ID == sshPortForwardID {
+			return aPortForward.Host
+		}
+	}
+	return 0
+}
+
+// memberName returns the name of the index-th VM (starting from 1), e.g. replica-01
+func memberName(prefix string, index, count int) string {
+	width := len(fmt.Sprintf("%d", count))
+	if width < 2 {
+		width = 2
+	}
+	return fmt.Sprintf("%s-%0*d", prefix, width, index)
+}
+
+// Plan returns the VMs of the fleet, in the subdirectories of baseDir. Every VM is a linked clone
+// of the box, with a unique name, hostname, forwarded SSH port and MAC address.
+func Plan(baseDir string, provider hypervisor.Provider, settings vagrantfile.SettingsModel, opts OptionsModel) ([]MemberModel, error) {
+	if opts.Count < 1 {
+		return nil, fmt.Errorf("Invalid number of VMs (%d), should be at least 1", opts.Count)
+	}
+	if opts.NamePrefix == "" {
+		opts.NamePrefix = DefaultNamePrefix
+	}
+	if opts.SSHPortBase == 0 {
+		opts.SSHPortBase = DefaultSSHPortBase
+	}
+	if opts.SSHPortBase < 1 || opts.SSHPortBase+opts.Count-1 > 65535 {
+		return nil, fmt.Errorf("Invalid SSH port base (%d), the ports of the %d VMs should be between 1 and 65535", opts.SSHPortBase, opts.Count)
+	}
+
+	isLinkedClone := true
+	members := []MemberModel{}
+	macAddresses := map[string]bool{}
+	for idx := 1; idx <= opts.Count; idx++ {
+		name := memberName(opts.NamePrefix, idx, opts.Count)
+
+		macAddress := ""
+		for macAddress == "" || macAddresses[macAddress] {
+			generated, err := hypervisor.NewMACAddress(provider, rand.Reader)
+			if err != nil {
+				return nil, err
+			}
+			macAddress = generated
+		}
+		macAddresses[macAddress] = true
+
+		memberSettings := settings.Merge(vagrantfile.SettingsModel{
+			Hostname:      name,
+			MACAddress:    macAddress,
+			IsLinkedClone: &isLinkedClone,
+			PortForwards: []vagrantfile.PortForwardModel{
+				{ID: sshPortForwardID, Host: opts.SSHPortBase + idx - 1, Guest: guestSSHPort},
+			},
+		})
+		if err := memberSettings.Validate(); err != nil {
+			return nil, fmt.Errorf("Invalid settings of the VM (%s), error: %s", name, err)
+		}
+		members = append(members, MemberModel{
+			Name:     name,
+			Dir:      filepath.Join(baseDir, name),
+			Settings: memberSettings,
+		})
+	}
+	return members, nil
+}
+
+// Run calls fn with every VM, with at most parallelism calls at once, and returns the error of every VM.
+// The first VM is created alone: the first linked clone imports the box as the parent VM,
+// which the other VMs share.
+func Run(members []MemberModel, parallelism int, fn func(member MemberModel) error) []error {
+	errs := make([]error, len(members))
+	if len(members) == 0 {
+		return errs
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	errs[0] = fn(members[0])
+
+	var waitGroup sync.WaitGroup
+	slots := make(chan bool, parallelism)
+	for idx := 1; idx < len(members); idx++ {
+		waitGroup.Add(1)
+		slots <- true
+		go func(idx int) {
+			defer waitGroup.Done()
+			errs[idx] = fn(members[idx])
+			<-slots
+		}(idx)
+	}
+	waitGroup.Wait()
+	return errs
+}
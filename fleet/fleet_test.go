@@ -0,0 +1,103 @@
+package fleet
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/bitrise-io/replica/vagrantfile"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	settings := vagrantfile.DefaultSettings(vagrantfile.HostModel{})
+
+	t.Log("defaults")
+	{
+		members, err := Plan("/vms", hypervisor.VMware{}, settings, OptionsModel{Count: 3})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(members))
+
+		macAddresses := map[string]bool{}
+		for idx, aMember := range members {
+			require.Equal(t, []string{"replica-01", "replica-02", "replica-03"}[idx], aMember.Name)
+			require.Equal(t, "/vms/"+aMember.Name, aMember.Dir)
+			require.Equal(t, aMember.Name, aMember.Settings.Hostname)
+			require.Equal(t, DefaultSSHPortBase+idx, aMember.SSHPort())
+			require.True(t, *aMember.Settings.IsLinkedClone)
+			require.Regexp(t, "^00:50:56:[0-3][0-9a-f]:", aMember.Settings.MACAddress)
+			macAddresses[aMember.Settings.MACAddress] = true
+		}
+		require.Equal(t, 3, len(macAddresses))
+	}
+
+	t.Log("options, and the shared settings are kept")
+	{
+		settings := settings.Merge(vagrantfile.SettingsModel{
+			PortForwards: []vagrantfile.PortForwardModel{{Host: 8080, Guest: 80}},
+		})
+		members, err := Plan("/vms", hypervisor.VirtualBox{}, settings, OptionsModel{Count: 12, NamePrefix: "ci", SSHPortBase: 3000})
+		require.NoError(t, err)
+		require.Equal(t, "ci-12", members[11].Name)
+		require.Equal(t, 3011, members[11].SSHPort())
+		require.Equal(t, vagrantfile.PortForwardModel{Host: 8080, Guest: 80}, members[11].Settings.PortForwards[0])
+	}
+
+	t.Log("the shared port forwards conflict")
+	{
+		settings := settings.Merge(vagrantfile.SettingsModel{
+			PortForwards: []vagrantfile.PortForwardModel{{Host: 8080, Guest: 80}},
+		})
+		_, err := Plan("/vms", hypervisor.VirtualBox{}, settings, OptionsModel{Count: 2, SSHPortBase: 8079})
+		require.Error(t, err)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := Plan("/vms", hypervisor.VirtualBox{}, settings, OptionsModel{Count: 0})
+		require.Error(t, err)
+
+		_, err = Plan("/vms", hypervisor.VirtualBox{}, settings, OptionsModel{Count: 2, SSHPortBase: 65535})
+		require.Error(t, err)
+
+		_, err = Plan("/vms", hypervisor.VirtualBox{}, settings, OptionsModel{Count: 2, NamePrefix: "ci_vm"})
+		require.Error(t, err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	members := []MemberModel{}
+	for _, aName := range []string{"vm-1", "vm-2", "vm-3", "vm-4", "vm-5"} {
+		members = append(members, MemberModel{Name: aName})
+	}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	started := []string{}
+	errs := Run(members, 2, func(member MemberModel) error {
+		mutex.Lock()
+		started = append(started, member.Name)
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		if member.Name == "vm-3" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	require.Equal(t, "vm-1", started[0])
+	require.Equal(t, 5, len(started))
+	require.Equal(t, 2, maxRunning)
+	require.Equal(t, []error{nil, nil, errors.New("failed"), nil, nil}, errs)
+}
@@ -0,0 +1,137 @@
+package hypervisor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HardwareModel is the (virtual) hardware configuration of the VM the box is built with.
+// Zero values mean: use the default.
+type HardwareModel struct {
+	CPUs int `json:"cpus,omitempty"`
+	// MemoryMB is the size of the RAM, in MB
+	MemoryMB int `json:"memory,omitempty"`
+	// DiskSizeMB is the size of the disk, in MB
+	DiskSizeMB int `json:"disk_size,omitempty"`
+	// VRAMMB is the size of the video memory, in MB
+	VRAMMB int `json:"vram,omitempty"`
+	// Chipset is the emulated chipset, one of the chipsets of the provider (see: HardwareOptionsModel)
+	Chipset string `json:"chipset,omitempty"`
+	// Firmware is the firmware of the VM, one of the firmwares of the provider (see: HardwareOptionsModel)
+	Firmware string `json:"firmware,omitempty"`
+	// BootWait is the time to wait after booting the VM, before typing anything (e.g. 2s)
+	BootWait string `json:"boot_wait,omitempty"`
+	// SSHWaitTimeout is the time to wait for SSH to become available (e.g. 10000s)
+	SSHWaitTimeout string `json:"ssh_wait_timeout,omitempty"`
+}
+
+// HardwareOptionsModel are the chipsets and firmwares a provider can configure, the first one is the default.
+// Empty if the provider can't configure it.
+type HardwareOptionsModel struct {
+	Chipsets  []string
+	Firmwares []string
+}
+
+func (options HardwareOptionsModel) defaultChipset() string {
+	if len(options.Chipsets) == 0 {
+		return ""
+	}
+	return options.Chipsets[0]
+}
+
+func (options HardwareOptionsModel) defaultFirmware() string {
+	if len(options.Firmwares) == 0 {
+		return ""
+	}
+	return options.Firmwares[0]
+}
+
+// DefaultHardware returns the default hardware of the VM, with the default chipset and firmware of the provider
+func DefaultHardware(provider Provider) HardwareModel {
+	options := provider.HardwareOptions()
+	return HardwareModel{
+		CPUs:           1,
+		MemoryMB:       2048,
+		DiskSizeMB:     40960,
+		VRAMMB:         128,
+		Chipset:        options.defaultChipset(),
+		Firmware:       options.defaultFirmware(),
+		BootWait:       "2s",
+		SSHWaitTimeout: "10000s",
+	}
+}
+
+// Merge returns the hardware config, with the non zero values of other overriding the values of hardware
+func (hardware HardwareModel) Merge(other HardwareModel) HardwareModel {
+	if other.CPUs != 0 {
+		hardware.CPUs = other.CPUs
+	}
+	if other.MemoryMB != 0 {
+		hardware.MemoryMB = other.MemoryMB
+	}
+	if other.DiskSizeMB != 0 {
+		hardware.DiskSizeMB = other.DiskSizeMB
+	}
+	if other.VRAMMB != 0 {
+		hardware.VRAMMB = other.VRAMMB
+	}
+	if other.Chipset != "" {
+		hardware.Chipset = other.Chipset
+	}
+	if other.Firmware != "" {
+		hardware.Firmware = other.Firmware
+	}
+	if other.BootWait != "" {
+		hardware.BootWait = other.BootWait
+	}
+	if other.SSHWaitTimeout != "" {
+		hardware.SSHWaitTimeout = other.SSHWaitTimeout
+	}
+	return hardware
+}
+
+// Validate checks the hardware, the chipset and the firmware against the options of the provider
+func (hardware HardwareModel) Validate(provider Provider) error {
+	if hardware.CPUs < 1 || hardware.CPUs > 32 {
+		return fmt.Errorf("invalid number of CPUs (%d), should be between 1 and 32", hardware.CPUs)
+	}
+	if hardware.MemoryMB < 2048 {
+		return fmt.Errorf("invalid memory size (%d MB), should be at least 2048 MB", hardware.MemoryMB)
+	}
+	if hardware.DiskSizeMB < 20480 {
+		return fmt.Errorf("invalid disk size (%d MB), should be at least 20480 MB", hardware.DiskSizeMB)
+	}
+	if hardware.VRAMMB < 1 || hardware.VRAMMB > 256 {
+		return fmt.Errorf("invalid video memory size (%d MB), should be between 1 and 256 MB", hardware.VRAMMB)
+	}
+	options := provider.HardwareOptions()
+	if err := validateHardwareOption("chipset", hardware.Chipset, options.Chipsets, provider); err != nil {
+		return err
+	}
+	if err := validateHardwareOption("firmware", hardware.Firmware, options.Firmwares, provider); err != nil {
+		return err
+	}
+	if d, err := time.ParseDuration(hardware.BootWait); err != nil || d < 0 {
+		return fmt.Errorf("invalid boot wait (%s), should be a duration, like: 2s", hardware.BootWait)
+	}
+	if d, err := time.ParseDuration(hardware.SSHWaitTimeout); err != nil || d <= 0 {
+		return fmt.Errorf("invalid SSH wait timeout (%s), should be a positive duration, like: 10000s", hardware.SSHWaitTimeout)
+	}
+	return nil
+}
+
+func validateHardwareOption(name, value string, supportedValues []string, provider Provider) error {
+	if len(supportedValues) == 0 {
+		if value != "" {
+			return fmt.Errorf("the %s can't be configured with the %s provider", name, provider.Name())
+		}
+		return nil
+	}
+	for _, aSupportedValue := range supportedValues {
+		if value == aSupportedValue {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s (%s) for the %s provider, should be one of: %s", name, value, provider.Name(), strings.Join(supportedValues, ", "))
+}
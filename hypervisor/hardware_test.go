@@ -0,0 +1,67 @@
+package hypervisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareModel_Merge(t *testing.T) {
+	t.Log("empty - defaults")
+	{
+		require.Equal(t, DefaultHardware(VirtualBox{}), DefaultHardware(VirtualBox{}).Merge(HardwareModel{}))
+	}
+
+	t.Log("override")
+	{
+		hardware := DefaultHardware(VirtualBox{}).Merge(HardwareModel{CPUs: 4, MemoryMB: 8192, BootWait: "5s"})
+		require.Equal(t, HardwareModel{
+			CPUs:           4,
+			MemoryMB:       8192,
+			DiskSizeMB:     40960,
+			VRAMMB:         128,
+			Chipset:        "ich9",
+			Firmware:       "efi",
+			BootWait:       "5s",
+			SSHWaitTimeout: "10000s",
+		}, hardware)
+	}
+}
+
+func TestDefaultHardware(t *testing.T) {
+	require.Equal(t, "ich9", DefaultHardware(VirtualBox{}).Chipset)
+	require.Equal(t, "efi", DefaultHardware(VirtualBox{}).Firmware)
+	require.Equal(t, "", DefaultHardware(VMware{}).Chipset)
+	require.Equal(t, "efi", DefaultHardware(VMware{}).Firmware)
+	require.Equal(t, "", DefaultHardware(Parallels{}).Chipset)
+	require.Equal(t, "", DefaultHardware(Parallels{}).Firmware)
+}
+
+func TestHardwareModel_Validate(t *testing.T) {
+	for _, aProvider := range []Provider{VirtualBox{}, VMware{}, Parallels{}, QEMU{}} {
+		require.NoError(t, DefaultHardware(aProvider).Validate(aProvider), aProvider.Name())
+	}
+	require.NoError(t, DefaultHardware(VirtualBox{}).Merge(HardwareModel{Chipset: "piix3", Firmware: "efi64", BootWait: "0s"}).Validate(VirtualBox{}))
+
+	for _, anInvalid := range []HardwareModel{
+		{CPUs: 33},
+		{MemoryMB: 1024},
+		{DiskSizeMB: 10240},
+		{VRAMMB: 512},
+		{Chipset: "i440fx"},
+		{Firmware: "bios"},
+		{BootWait: "2"},
+		{SSHWaitTimeout: "-1s"},
+	} {
+		require.Error(t, DefaultHardware(VirtualBox{}).Merge(anInvalid).Validate(VirtualBox{}), "%#v", anInvalid)
+	}
+
+	t.Log("the chipset and the firmware of the other providers")
+	{
+		require.Error(t, DefaultHardware(VMware{}).Merge(HardwareModel{Chipset: "ich9"}).Validate(VMware{}))
+		require.Error(t, DefaultHardware(VMware{}).Merge(HardwareModel{Firmware: "efi64"}).Validate(VMware{}))
+		require.Error(t, DefaultHardware(Parallels{}).Merge(HardwareModel{Firmware: "efi"}).Validate(Parallels{}))
+		require.Error(t, DefaultHardware(QEMU{}).Merge(HardwareModel{Chipset: "piix3"}).Validate(QEMU{}))
+		require.NoError(t, DefaultHardware(QEMU{}).Merge(HardwareModel{Chipset: "ich9", Firmware: "efi"}).Validate(QEMU{}))
+	}
+}
@@ -0,0 +1,141 @@
+package hypervisor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// ErrNotSupported is returned by the operations a provider does not support
+var ErrNotSupported = errors.New("not supported by the provider")
+
+// Provider is a hypervisor, which the vagrant box is built with, and the vagrant VM runs on.
+// Every hypervisor specific setting of the box and VM stages belongs to the provider.
+type Provider interface {
+	// Name is the name of the provider in replica (e.g. virtualbox)
+	Name() string
+	// DisplayName is the name of the hypervisor application (e.g. VirtualBox)
+	DisplayName() string
+
+	// ToolVersion returns the version of the installed hypervisor,
+	// or an error if it's not installed
+	ToolVersion() (string, error)
+
+	// HardwareOptions are the chipsets and firmwares the packer builder can configure
+	HardwareOptions() HardwareOptionsModel
+	// PackerBuilder returns the packer builder of the box creation
+	PackerBuilder(hardware HardwareModel) PackerBuilder
+	// PackerVariables are the provider specific packer user variables, with their default values
+	PackerVariables() map[string]string
+
+	// BoxProvider is the provider of the box, created by the vagrant post-processor of packer
+	BoxProvider() string
+	// VagrantProvider is the name of the vagrant provider (vagrant up --provider)
+	VagrantProvider() string
+	// VagrantfileProviderConfig is the content of the provider block of the Vagrantfile
+	// (config.vm.provider "..." do |v| ... end), with the settings of the VM
+	VagrantfileProviderConfig(vm VagrantVMModel) string
+
+	// IsSnapshotSupported returns true if the VM snapshots (vagrant snapshot) are supported
+	IsSnapshotSupported() bool
+
+	// ResizeDiskCommand returns the command which resizes the disk image of the VM
+	ResizeDiskCommand(diskPath string, sizeMB int) (*cmdex.CommandModel, error)
+	// CompactDiskCommand returns the command which reclaims the unused space of the disk image of the VM
+	CompactDiskCommand(diskPath string) (*cmdex.CommandModel, error)
+}
+
+// PackerBuilder is the builder section of the packer template
+type PackerBuilder interface {
+	// BuilderType is the packer builder type (e.g. virtualbox-iso)
+	BuilderType() string
+}
+
+// Options are the provider specific options
+type Options struct {
+	// QEMUFirmwarePath is the EFI firmware of the QEMU VM, default: the firmware of the Homebrew qemu package
+	QEMUFirmwarePath string
+}
+
+const (
+	// VirtualBoxName ...
+	VirtualBoxName = "virtualbox"
+	// VMwareName ...
+	VMwareName = "vmware"
+	// ParallelsName ...
+	ParallelsName = "parallels"
+	// QEMUName ...
+	QEMUName = "qemu"
+
+	// DefaultProviderName is the provider used if none is specified
+	DefaultProviderName = VirtualBoxName
+)
+
+// ProviderNames are the names of the supported providers
+var ProviderNames = []string{VirtualBoxName, VMwareName, ParallelsName, QEMUName}
+
+// NewProvider returns the provider with the given name.
+// An empty name means the default provider.
+func NewProvider(name string, opts Options) (Provider, error) {
+	switch name {
+	case "", VirtualBoxName:
+		return VirtualBox{}, nil
+	case VMwareName:
+		return VMware{}, nil
+	case ParallelsName:
+		return Parallels{}, nil
+	case QEMUName:
+		return QEMU{FirmwarePath: opts.QEMUFirmwarePath}, nil
+	}
+	return nil, fmt.Errorf("invalid provider (%s), should be one of: %s", name, strings.Join(ProviderNames, ", "))
+}
+
+// PackerUserVar returns the reference of a packer user variable
+func PackerUserVar(name string) string {
+	return "{{user `" + name + "`}}"
+}
+
+// VagrantBoxFileName returns the file name of the box the vagrant post-processor of packer creates
+func VagrantBoxFileName(provider Provider, builder PackerBuilder) string {
+	return fmt.Sprintf("packer_%s_%s.box", builder.BuilderType(), provider.BoxProvider())
+}
+
+func toolVersion(toolCmd string, toolCmdArgs ...string) (string, error) {
+	return cmdex.NewCommand(toolCmd, toolCmdArgs...).RunAndReturnTrimmedCombinedOutput()
+}
+
+// commonBuilderModel are the settings shared by every ISO builder
+type commonBuilderModel struct {
+	Type            string `json:"type"`
+	BootWait        string `json:"boot_wait"`
+	DiskSize        int    `json:"disk_size"`
+	ISOChecksumType string `json:"iso_checksum_type"`
+	ISOURL          string `json:"iso_url"`
+	ShutdownCommand string `json:"shutdown_command"`
+	SSHPort         int    `json:"ssh_port"`
+	SSHUsername     string `json:"ssh_username"`
+	SSHPassword     string `json:"ssh_password"`
+	SSHWaitTimeout  string `json:"ssh_wait_timeout"`
+}
+
+// BuilderType ...
+func (builder commonBuilderModel) BuilderType() string {
+	return builder.Type
+}
+
+func newCommonBuilder(builderType string, hardware HardwareModel) commonBuilderModel {
+	return commonBuilderModel{
+		Type:            builderType,
+		BootWait:        hardware.BootWait,
+		DiskSize:        hardware.DiskSizeMB,
+		ISOChecksumType: "none",
+		ISOURL:          PackerUserVar("iso_url"),
+		ShutdownCommand: "echo '" + PackerUserVar("username") + "'|sudo -S shutdown -h now",
+		SSHPort:         22,
+		SSHUsername:     PackerUserVar("username"),
+		SSHPassword:     PackerUserVar("password"),
+		SSHWaitTimeout:  hardware.SSHWaitTimeout,
+	}
+}
@@ -0,0 +1,123 @@
+package hypervisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Log("default")
+	{
+		provider, err := NewProvider("", Options{})
+		require.NoError(t, err)
+		require.Equal(t, VirtualBoxName, provider.Name())
+	}
+
+	t.Log("every provider")
+	{
+		for _, aName := range ProviderNames {
+			provider, err := NewProvider(aName, Options{})
+			require.NoError(t, err)
+			require.Equal(t, aName, provider.Name())
+		}
+	}
+
+	t.Log("provider options")
+	{
+		provider, err := NewProvider(QEMUName, Options{QEMUFirmwarePath: "/opt/ovmf/OVMF.fd"})
+		require.NoError(t, err)
+		require.Equal(t, QEMU{FirmwarePath: "/opt/ovmf/OVMF.fd"}, provider)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := NewProvider("hyperv", Options{})
+		require.Error(t, err)
+	}
+}
+
+func TestProviders(t *testing.T) {
+	hardware := DefaultHardware(VirtualBox{}).Merge(HardwareModel{CPUs: 4, MemoryMB: 8192, DiskSizeMB: 81920, VRAMMB: 64})
+
+	t.Log("virtualbox")
+	{
+		provider := VirtualBox{}
+		builder := provider.PackerBuilder(hardware)
+		require.Equal(t, "packer_virtualbox-iso_virtualbox.box", VagrantBoxFileName(provider, builder))
+		require.Equal(t, "virtualbox", provider.VagrantProvider())
+		require.True(t, provider.IsSnapshotSupported())
+
+		vbox := builder.(virtualboxISOBuilderModel)
+		require.Equal(t, 81920, vbox.DiskSize)
+		require.Contains(t, vbox.VBoxManage, []string{"modifyvm", "{{.Name}}", "--cpus", "4"})
+		require.Contains(t, vbox.VBoxManage, []string{"modifyvm", "{{.Name}}", "--memory", "8192"})
+		require.Contains(t, vbox.VBoxManage, []string{"modifyvm", "{{.Name}}", "--vram", "64"})
+
+		cmd, err := provider.ResizeDiskCommand("/vms/macos/disk.vmdk", 81920)
+		require.NoError(t, err)
+		require.Equal(t, `vboxmanage "modifymedium" "disk" "/vms/macos/disk.vmdk" "--resize" "81920"`, cmd.PrintableCommandArgs())
+	}
+
+	t.Log("vmware")
+	{
+		provider := VMware{}
+		builder := provider.PackerBuilder(hardware)
+		require.Equal(t, "packer_vmware-iso_vmware.box", VagrantBoxFileName(provider, builder))
+		require.Equal(t, "vmware_desktop", provider.VagrantProvider())
+		require.True(t, provider.IsSnapshotSupported())
+
+		vmware := builder.(vmwareISOBuilderModel)
+		require.Equal(t, 81920, vmware.DiskSize)
+		require.Equal(t, "darwin", vmware.ToolsUploadFlavor)
+		require.Equal(t, "4", vmware.VMXData["numvcpus"])
+		require.Equal(t, "8192", vmware.VMXData["memsize"])
+		require.Equal(t, "67108864", vmware.VMXData["svga.vramSize"])
+		require.Equal(t, "TRUE", vmware.VMXData["smc.present"])
+		require.Equal(t, "efi", vmware.VMXData["firmware"])
+	}
+
+	t.Log("parallels")
+	{
+		provider := Parallels{}
+		builder := provider.PackerBuilder(hardware)
+		require.Equal(t, "packer_parallels-iso_parallels.box", VagrantBoxFileName(provider, builder))
+		require.Equal(t, "parallels", provider.VagrantProvider())
+
+		parallels := builder.(parallelsISOBuilderModel)
+		require.Equal(t, 81920, parallels.DiskSize)
+		require.Equal(t, [][]string{
+			{"set", "{{.Name}}", "--cpus", "4"},
+			{"set", "{{.Name}}", "--memsize", "8192"},
+			{"set", "{{.Name}}", "--videosize", "64"},
+		}, parallels.Prlctl)
+
+		cmd, err := provider.CompactDiskCommand("/vms/macos.pvm/harddisk.hdd")
+		require.NoError(t, err)
+		require.Equal(t, `prl_disk_tool "compact" "--hdd" "/vms/macos.pvm/harddisk.hdd"`, cmd.PrintableCommandArgs())
+	}
+
+	t.Log("qemu")
+	{
+		provider := QEMU{}
+		builder := provider.PackerBuilder(hardware)
+		require.Equal(t, "packer_qemu_libvirt.box", VagrantBoxFileName(provider, builder))
+		require.Equal(t, "libvirt", provider.VagrantProvider())
+		require.False(t, provider.IsSnapshotSupported())
+		require.Equal(t, map[string]string{AppleSMCOSKVariable: ""}, provider.PackerVariables())
+
+		qemu := builder.(qemuBuilderModel)
+		require.Equal(t, 81920, qemu.DiskSize)
+		require.Equal(t, "qcow2", qemu.Format)
+		require.Contains(t, qemu.QEMUArgs, []string{"-bios", defaultQEMUFirmwarePath})
+		require.Contains(t, qemu.QEMUArgs, []string{"-device", "isa-applesmc,osk={{user `apple_smc_osk`}}"})
+		require.Contains(t, qemu.QEMUArgs, []string{"-smp", "4"})
+		require.Contains(t, qemu.QEMUArgs, []string{"-m", "8192"})
+
+		builder = QEMU{FirmwarePath: "/opt/ovmf/OVMF.fd"}.PackerBuilder(hardware)
+		require.Contains(t, builder.(qemuBuilderModel).QEMUArgs, []string{"-bios", "/opt/ovmf/OVMF.fd"})
+
+		_, err := provider.CompactDiskCommand("/vms/macos.qcow2")
+		require.Equal(t, ErrNotSupported, err)
+	}
+}
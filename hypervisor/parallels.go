@@ -0,0 +1,90 @@
+package hypervisor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// Parallels is Parallels Desktop
+type Parallels struct{}
+
+// parallelsISOBuilderModel is the packer parallels-iso builder
+type parallelsISOBuilderModel struct {
+	commonBuilderModel
+	GuestOSType          string     `json:"guest_os_type"`
+	ParallelsToolsFlavor string     `json:"parallels_tools_flavor"`
+	ParallelsToolsMode   string     `json:"parallels_tools_mode"`
+	Prlctl               [][]string `json:"prlctl"`
+}
+
+// Name ...
+func (Parallels) Name() string { return ParallelsName }
+
+// DisplayName ...
+func (Parallels) DisplayName() string { return "Parallels Desktop" }
+
+// ToolVersion ...
+func (Parallels) ToolVersion() (string, error) {
+	return toolVersion("prlctl", "--version")
+}
+
+// HardwareOptions returns no options, Parallels Desktop configures the chipset and the (efi) firmware of the macOS VMs
+func (Parallels) HardwareOptions() HardwareOptionsModel { return HardwareOptionsModel{} }
+
+// PackerBuilder ...
+func (Parallels) PackerBuilder(hardware HardwareModel) PackerBuilder {
+	set := func(args ...string) []string {
+		return append([]string{"set", "{{.Name}}"}, args...)
+	}
+
+	return parallelsISOBuilderModel{
+		commonBuilderModel:   newCommonBuilder("parallels-iso", hardware),
+		GuestOSType:          "macosx",
+		ParallelsToolsFlavor: "mac",
+		ParallelsToolsMode:   "disable",
+		Prlctl: [][]string{
+			set("--cpus", strconv.Itoa(hardware.CPUs)),
+			set("--memsize", strconv.Itoa(hardware.MemoryMB)),
+			set("--videosize", strconv.Itoa(hardware.VRAMMB)),
+		},
+	}
+}
+
+// PackerVariables ...
+func (Parallels) PackerVariables() map[string]string { return map[string]string{} }
+
+// BoxProvider ...
+func (Parallels) BoxProvider() string { return "parallels" }
+
+// VagrantProvider is the provider of the vagrant-parallels plugin
+func (Parallels) VagrantProvider() string { return "parallels" }
+
+// VagrantfileProviderConfig ...
+func (Parallels) VagrantfileProviderConfig(vm VagrantVMModel) string {
+	lines := []string{
+		linkedCloneLine(vm),
+		fmt.Sprintf("v.cpus = %d", vm.CPUs),
+		fmt.Sprintf("v.memory = %d", vm.MemoryMB),
+	}
+	if vm.MACAddress != "" {
+		lines = append(lines, fmt.Sprintf(`v.customize ["set", :id, "--device-set", "net0", "--mac", %s]`, RubyString(compactMACAddress(vm.MACAddress))))
+	}
+	return renderProviderConfig(lines, func(name, value string) string {
+		return fmt.Sprintf(`v.customize ["set", :id, %s, %s]`, RubyString(cliOption(name)), RubyString(value))
+	}, vm)
+}
+
+// IsSnapshotSupported ...
+func (Parallels) IsSnapshotSupported() bool { return true }
+
+// ResizeDiskCommand ...
+func (Parallels) ResizeDiskCommand(diskPath string, sizeMB int) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand("prl_disk_tool", "resize", "--hdd", diskPath, "--size", strconv.Itoa(sizeMB)+"M"), nil
+}
+
+// CompactDiskCommand ...
+func (Parallels) CompactDiskCommand(diskPath string) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand("prl_disk_tool", "compact", "--hdd", diskPath), nil
+}
@@ -0,0 +1,121 @@
+package hypervisor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// defaultQEMUFirmwarePath is the EFI firmware of the QEMU VM, as installed by Homebrew (brew install qemu)
+const defaultQEMUFirmwarePath = "/usr/local/share/qemu/edk2-x86_64-code.fd"
+
+// QEMU builds the box for the vagrant libvirt provider
+type QEMU struct {
+	// FirmwarePath is the EFI firmware of the VM, default: the firmware of the Homebrew qemu package
+	FirmwarePath string
+}
+
+// qemuBuilderModel is the packer qemu builder
+type qemuBuilderModel struct {
+	commonBuilderModel
+	Accelerator   string     `json:"accelerator"`
+	DiskInterface string     `json:"disk_interface"`
+	Format        string     `json:"format"`
+	MachineType   string     `json:"machine_type"`
+	NetDevice     string     `json:"net_device"`
+	QEMUArgs      [][]string `json:"qemuargs"`
+}
+
+// Name ...
+func (QEMU) Name() string { return QEMUName }
+
+// DisplayName ...
+func (QEMU) DisplayName() string { return "QEMU" }
+
+// ToolVersion ...
+func (QEMU) ToolVersion() (string, error) {
+	return toolVersion("qemu-system-x86_64", "--version")
+}
+
+// HardwareOptions returns the ich9 chipset (the q35 machine type) and the efi firmware (see: FirmwarePath) only,
+// macOS does not boot on the other machine types
+func (QEMU) HardwareOptions() HardwareOptionsModel {
+	return HardwareOptionsModel{
+		Chipsets:  []string{"ich9"},
+		Firmwares: []string{"efi"},
+	}
+}
+
+// PackerBuilder ...
+func (qemu QEMU) PackerBuilder(hardware HardwareModel) PackerBuilder {
+	firmwarePath := qemu.FirmwarePath
+	if firmwarePath == "" {
+		firmwarePath = defaultQEMUFirmwarePath
+	}
+
+	return qemuBuilderModel{
+		commonBuilderModel: newCommonBuilder("qemu", hardware),
+		Accelerator:        "hvf",
+		DiskInterface:      "ide",
+		Format:             "qcow2",
+		MachineType:        "q35",
+		NetDevice:          "e1000-82545em",
+		QEMUArgs: [][]string{
+			{"-bios", firmwarePath},
+			{"-cpu", "Penryn,vendor=GenuineIntel,+invtsc,vmware-cpuid-freq=on"},
+			{"-device", "isa-applesmc,osk=" + PackerUserVar(AppleSMCOSKVariable)},
+			{"-device", "usb-ehci,id=ehci"},
+			{"-device", "usb-kbd,bus=ehci.0"},
+			{"-device", "usb-tablet,bus=ehci.0"},
+			{"-m", strconv.Itoa(hardware.MemoryMB)},
+			{"-smbios", "type=2"},
+			{"-smp", strconv.Itoa(hardware.CPUs)},
+			{"-vga", "std"},
+		},
+	}
+}
+
+// AppleSMCOSKVariable is the packer user variable of the Apple SMC key,
+// it's passed to packer as a variable, to keep it out of the template
+const AppleSMCOSKVariable = "apple_smc_osk"
+
+// PackerVariables ...
+func (QEMU) PackerVariables() map[string]string {
+	return map[string]string{AppleSMCOSKVariable: ""}
+}
+
+// BoxProvider ...
+func (QEMU) BoxProvider() string { return "libvirt" }
+
+// VagrantProvider is the provider of the vagrant-libvirt plugin
+func (QEMU) VagrantProvider() string { return "libvirt" }
+
+// VagrantfileProviderConfig returns the settings of the vagrant-libvirt provider,
+// which always creates the VM on a copy-on-write image of the box, so there's no linked clone setting.
+// The customizations are settings of the provider, e.g. machine_type = q35.
+func (QEMU) VagrantfileProviderConfig(vm VagrantVMModel) string {
+	lines := []string{
+		fmt.Sprintf("v.cpus = %d", vm.CPUs),
+		fmt.Sprintf("v.memory = %d", vm.MemoryMB),
+	}
+	if vm.MACAddress != "" {
+		lines = append(lines, "v.management_network_mac = "+RubyString(vm.MACAddress))
+	}
+	return renderProviderConfig(lines, func(name, value string) string {
+		return fmt.Sprintf("v.%s = %s", name, RubyString(value))
+	}, vm)
+}
+
+// IsSnapshotSupported returns false, replica does not support snapshots with the libvirt provider (yet)
+func (QEMU) IsSnapshotSupported() bool { return false }
+
+// ResizeDiskCommand ...
+func (QEMU) ResizeDiskCommand(diskPath string, sizeMB int) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand("qemu-img", "resize", diskPath, strconv.Itoa(sizeMB)+"M"), nil
+}
+
+// CompactDiskCommand returns ErrNotSupported, qcow2 images can only be compacted by converting them into a new image
+func (QEMU) CompactDiskCommand(diskPath string) (*cmdex.CommandModel, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,94 @@
+package hypervisor
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// macAddressPrefixes are the OUIs of the MAC addresses the providers assign to the VMs
+var macAddressPrefixes = map[string][]byte{
+	VirtualBoxName: {0x08, 0x00, 0x27},
+	// the static MAC addresses of VMware have to be in the range: 00:50:56:00:00:00 - 00:50:56:3F:FF:FF
+	VMwareName:    {0x00, 0x50, 0x56},
+	ParallelsName: {0x00, 0x1c, 0x42},
+	QEMUName:      {0x52, 0x54, 0x00},
+}
+
+// NewMACAddress generates a random MAC address for a VM of the provider, using the random source
+func NewMACAddress(provider Provider, random io.Reader) (string, error) {
+	prefix, isKnown := macAddressPrefixes[provider.Name()]
+	if !isKnown {
+		return "", fmt.Errorf("No MAC address prefix defined for the provider: %s", provider.Name())
+	}
+	suffix := make([]byte, 3)
+	if _, err := io.ReadFull(random, suffix); err != nil {
+		return "", fmt.Errorf("Failed to generate MAC address, error: %s", err)
+	}
+	if provider.Name() == VMwareName {
+		suffix[0] &= 0x3f
+	}
+
+	segments := []string{}
+	for _, aByte := range append(append([]byte{}, prefix...), suffix...) {
+		segments = append(segments, fmt.Sprintf("%02x", aByte))
+	}
+	return strings.Join(segments, ":"), nil
+}
+
+// compactMACAddress returns the MAC address without separators, in upper case, e.g. 0800271A2B3C
+func compactMACAddress(macAddress string) string {
+	return strings.ToUpper(strings.Replace(macAddress, ":", "", -1))
+}
+
+// VagrantVMModel are the settings of the VM, in the provider block of the Vagrantfile
+type VagrantVMModel struct {
+	CPUs int
+	// MemoryMB is the size of the RAM, in MB
+	MemoryMB int
+	// IsLinkedClone if true the VM is a linked clone of the box, instead of a full copy of it
+	IsLinkedClone bool
+	// MACAddress is the MAC address of the first network interface, e.g. 08:00:27:1a:2b:3c, default: generated by the provider
+	MACAddress string
+	// Customizations are provider specific settings (e.g. VBoxManage modifyvm options
+	// with VirtualBox, or .vmx entries with VMware), by name
+	Customizations map[string]string
+}
+
+// RubyString returns the value as a double quoted Ruby string literal
+func RubyString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `#`, `\#`, "\n", `\n`).Replace(value) + `"`
+}
+
+// sortedCustomizationNames returns the names of the customizations, in alphabetical order
+func (vm VagrantVMModel) sortedCustomizationNames() []string {
+	names := []string{}
+	for aName := range vm.Customizations {
+		names = append(names, aName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// cliOption returns the command line option of the customization, e.g. --vram of vram
+func cliOption(name string) string {
+	return "--" + strings.TrimLeft(name, "-")
+}
+
+// renderProviderConfig renders the common settings of the provider block,
+// followed by the provider specific customization lines
+func renderProviderConfig(lines []string, customizationLine func(name, value string) string, vm VagrantVMModel) string {
+	for _, aName := range vm.sortedCustomizationNames() {
+		lines = append(lines, customizationLine(aName, vm.Customizations[aName]))
+	}
+	config := ""
+	for _, aLine := range lines {
+		config += "    " + aLine + "\n"
+	}
+	return config
+}
+
+func linkedCloneLine(vm VagrantVMModel) string {
+	return fmt.Sprintf("v.linked_clone = %t", vm.IsLinkedClone)
+}
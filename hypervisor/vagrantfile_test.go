@@ -0,0 +1,89 @@
+package hypervisor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRubyString(t *testing.T) {
+	require.Equal(t, `"plain"`, RubyString("plain"))
+	require.Equal(t, `"a \"quoted\" \#{value} C:\\dir\n"`, RubyString("a \"quoted\" #{value} C:\\dir\n"))
+}
+
+func TestNewMACAddress(t *testing.T) {
+	random := bytes.NewReader([]byte{0xff, 0x2b, 0x3c, 0xff, 0x2b, 0x3c})
+
+	macAddress, err := NewMACAddress(VirtualBox{}, random)
+	require.NoError(t, err)
+	require.Equal(t, "08:00:27:ff:2b:3c", macAddress)
+
+	t.Log("vmware: in the range of the static MAC addresses")
+	{
+		macAddress, err := NewMACAddress(VMware{}, random)
+		require.NoError(t, err)
+		require.Equal(t, "00:50:56:3f:2b:3c", macAddress)
+	}
+
+	t.Log("not enough random bytes")
+	{
+		_, err := NewMACAddress(Parallels{}, random)
+		require.Error(t, err)
+	}
+}
+
+func TestVagrantfileProviderConfig(t *testing.T) {
+	vm := VagrantVMModel{
+		CPUs:           4,
+		MemoryMB:       8192,
+		IsLinkedClone:  true,
+		Customizations: map[string]string{"vram": "128", "audio": "none"},
+	}
+	withMAC := func(macAddress string) VagrantVMModel {
+		withMAC := vm
+		withMAC.MACAddress = macAddress
+		return withMAC
+	}
+
+	t.Log("virtualbox")
+	{
+		require.Equal(t, `    v.linked_clone = true
+    v.cpus = 4
+    v.memory = 8192
+    v.customize ["modifyvm", :id, "--audio", "none"]
+    v.customize ["modifyvm", :id, "--vram", "128"]
+`, VirtualBox{}.VagrantfileProviderConfig(vm))
+	}
+
+	t.Log("vmware")
+	{
+		require.Equal(t, `    v.linked_clone = false
+    v.vmx["numvcpus"] = "2"
+    v.vmx["memsize"] = "4096"
+    v.vmx["ethernet0.addressType"] = "static"
+    v.vmx["ethernet0.address"] = "00:50:56:1a:2b:3c"
+    v.vmx["ethernet0.virtualDev"] = "e1000e"
+`, VMware{}.VagrantfileProviderConfig(VagrantVMModel{CPUs: 2, MemoryMB: 4096, MACAddress: "00:50:56:1a:2b:3c", Customizations: map[string]string{"ethernet0.virtualDev": "e1000e"}}))
+	}
+
+	t.Log("parallels")
+	{
+		require.Equal(t, `    v.linked_clone = true
+    v.cpus = 4
+    v.memory = 8192
+    v.customize ["set", :id, "--device-set", "net0", "--mac", "001C421A2B3C"]
+    v.customize ["set", :id, "--audio", "none"]
+    v.customize ["set", :id, "--vram", "128"]
+`, Parallels{}.VagrantfileProviderConfig(withMAC("00:1c:42:1a:2b:3c")))
+	}
+
+	t.Log("qemu: no linked clone setting")
+	{
+		require.Equal(t, `    v.cpus = 4
+    v.memory = 8192
+    v.management_network_mac = "52:54:00:1a:2b:3c"
+    v.machine_type = "q35"
+`, QEMU{}.VagrantfileProviderConfig(VagrantVMModel{CPUs: 4, MemoryMB: 8192, IsLinkedClone: true, MACAddress: "52:54:00:1a:2b:3c", Customizations: map[string]string{"machine_type": "q35"}}))
+	}
+}
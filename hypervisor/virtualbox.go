@@ -0,0 +1,107 @@
+package hypervisor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// VirtualBox ...
+type VirtualBox struct{}
+
+// virtualboxISOBuilderModel is the packer virtualbox-iso builder
+type virtualboxISOBuilderModel struct {
+	commonBuilderModel
+	GuestAdditionsMode string     `json:"guest_additions_mode"`
+	GuestOSType        string     `json:"guest_os_type"`
+	HardDriveInterface string     `json:"hard_drive_interface"`
+	ISOInterface       string     `json:"iso_interface"`
+	VBoxManage         [][]string `json:"vboxmanage"`
+}
+
+// Name ...
+func (VirtualBox) Name() string { return VirtualBoxName }
+
+// DisplayName ...
+func (VirtualBox) DisplayName() string { return "VirtualBox" }
+
+// ToolVersion ...
+func (VirtualBox) ToolVersion() (string, error) {
+	return toolVersion("vboxmanage", "--version")
+}
+
+// HardwareOptions ...
+func (VirtualBox) HardwareOptions() HardwareOptionsModel {
+	return HardwareOptionsModel{
+		Chipsets:  []string{"ich9", "piix3"},
+		Firmwares: []string{"efi", "efi32", "efi64"},
+	}
+}
+
+// PackerBuilder ...
+func (VirtualBox) PackerBuilder(hardware HardwareModel) PackerBuilder {
+	modifyVM := func(args ...string) []string {
+		return append([]string{"modifyvm", "{{.Name}}"}, args...)
+	}
+
+	return virtualboxISOBuilderModel{
+		commonBuilderModel: newCommonBuilder("virtualbox-iso", hardware),
+		GuestAdditionsMode: "disable",
+		GuestOSType:        "MacOS1011_64",
+		HardDriveInterface: "sata",
+		ISOInterface:       "sata",
+		VBoxManage: [][]string{
+			modifyVM("--audiocontroller", "hda"),
+			modifyVM("--boot1", "dvd"),
+			modifyVM("--boot2", "disk"),
+			modifyVM("--chipset", hardware.Chipset),
+			modifyVM("--cpus", strconv.Itoa(hardware.CPUs)),
+			modifyVM("--firmware", hardware.Firmware),
+			modifyVM("--hpet", "on"),
+			modifyVM("--keyboard", "usb"),
+			modifyVM("--memory", strconv.Itoa(hardware.MemoryMB)),
+			modifyVM("--mouse", "usbtablet"),
+			modifyVM("--vram", strconv.Itoa(hardware.VRAMMB)),
+			{"storagectl", "{{.Name}}", "--name", "IDE Controller", "--remove"},
+		},
+	}
+}
+
+// PackerVariables ...
+func (VirtualBox) PackerVariables() map[string]string { return map[string]string{} }
+
+// BoxProvider ...
+func (VirtualBox) BoxProvider() string { return "virtualbox" }
+
+// VagrantProvider ...
+func (VirtualBox) VagrantProvider() string { return "virtualbox" }
+
+// VagrantfileProviderConfig ...
+func (VirtualBox) VagrantfileProviderConfig(vm VagrantVMModel) string {
+	customizationLine := func(name, value string) string {
+		return fmt.Sprintf(`v.customize ["modifyvm", :id, %s, %s]`, RubyString(cliOption(name)), RubyString(value))
+	}
+	lines := []string{
+		linkedCloneLine(vm),
+		fmt.Sprintf("v.cpus = %d", vm.CPUs),
+		fmt.Sprintf("v.memory = %d", vm.MemoryMB),
+	}
+	if vm.MACAddress != "" {
+		lines = append(lines, customizationLine("macaddress1", compactMACAddress(vm.MACAddress)))
+	}
+	return renderProviderConfig(lines, customizationLine, vm)
+}
+
+// IsSnapshotSupported ...
+func (VirtualBox) IsSnapshotSupported() bool { return true }
+
+// ResizeDiskCommand ...
+func (VirtualBox) ResizeDiskCommand(diskPath string, sizeMB int) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand("vboxmanage", "modifymedium", "disk", diskPath, "--resize", strconv.Itoa(sizeMB)), nil
+}
+
+// CompactDiskCommand ...
+func (VirtualBox) CompactDiskCommand(diskPath string) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand("vboxmanage", "modifymedium", "disk", diskPath, "--compact"), nil
+}
@@ -0,0 +1,102 @@
+package hypervisor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+const vmwareFusionAppPath = "/Applications/VMware Fusion.app"
+
+// VMware is VMware Fusion
+type VMware struct{}
+
+// vmwareISOBuilderModel is the packer vmware-iso builder
+type vmwareISOBuilderModel struct {
+	commonBuilderModel
+	DiskAdapterType   string            `json:"disk_adapter_type"`
+	GuestOSType       string            `json:"guest_os_type"`
+	ToolsUploadFlavor string            `json:"tools_upload_flavor"`
+	VMXData           map[string]string `json:"vmx_data"`
+}
+
+// Name ...
+func (VMware) Name() string { return VMwareName }
+
+// DisplayName ...
+func (VMware) DisplayName() string { return "VMware Fusion" }
+
+// ToolVersion ...
+func (VMware) ToolVersion() (string, error) {
+	return toolVersion("defaults", "read", vmwareFusionAppPath+"/Contents/Info.plist", "CFBundleShortVersionString")
+}
+
+// HardwareOptions returns only the efi firmware, the chipset of VMware Fusion can't be configured
+func (VMware) HardwareOptions() HardwareOptionsModel {
+	return HardwareOptionsModel{Firmwares: []string{"efi"}}
+}
+
+// PackerBuilder ...
+func (VMware) PackerBuilder(hardware HardwareModel) PackerBuilder {
+	return vmwareISOBuilderModel{
+		commonBuilderModel: newCommonBuilder("vmware-iso", hardware),
+		DiskAdapterType:    "sata",
+		GuestOSType:        "darwin15-64",
+		ToolsUploadFlavor:  "darwin",
+		VMXData: map[string]string{
+			"cpuid.coresPerSocket":    "1",
+			"ehci.present":            "TRUE",
+			"firmware":                hardware.Firmware,
+			"hpet0.present":           "TRUE",
+			"ich7m.present":           "TRUE",
+			"keyboardAndMouseProfile": "macProfile",
+			"memsize":                 strconv.Itoa(hardware.MemoryMB),
+			"numvcpus":                strconv.Itoa(hardware.CPUs),
+			"smc.present":             "TRUE",
+			"svga.vramSize":           strconv.Itoa(hardware.VRAMMB * 1024 * 1024),
+			"usb.present":             "TRUE",
+		},
+	}
+}
+
+// PackerVariables ...
+func (VMware) PackerVariables() map[string]string { return map[string]string{} }
+
+// BoxProvider ...
+func (VMware) BoxProvider() string { return "vmware" }
+
+// VagrantProvider is the provider of the vagrant-vmware-desktop plugin
+func (VMware) VagrantProvider() string { return "vmware_desktop" }
+
+// VagrantfileProviderConfig ...
+func (VMware) VagrantfileProviderConfig(vm VagrantVMModel) string {
+	customizationLine := func(name, value string) string {
+		return fmt.Sprintf(`v.vmx[%s] = %s`, RubyString(name), RubyString(value))
+	}
+	lines := []string{
+		linkedCloneLine(vm),
+		fmt.Sprintf(`v.vmx["numvcpus"] = "%d"`, vm.CPUs),
+		fmt.Sprintf(`v.vmx["memsize"] = "%d"`, vm.MemoryMB),
+	}
+	if vm.MACAddress != "" {
+		lines = append(lines,
+			customizationLine("ethernet0.addressType", "static"),
+			customizationLine("ethernet0.address", vm.MACAddress),
+		)
+	}
+	return renderProviderConfig(lines, customizationLine, vm)
+}
+
+// IsSnapshotSupported ...
+func (VMware) IsSnapshotSupported() bool { return true }
+
+// ResizeDiskCommand ...
+func (VMware) ResizeDiskCommand(diskPath string, sizeMB int) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand(vmwareFusionAppPath+"/Contents/Library/vmware-vdiskmanager", "-x", strconv.Itoa(sizeMB)+"MB", diskPath), nil
+}
+
+// CompactDiskCommand ...
+func (VMware) CompactDiskCommand(diskPath string) (*cmdex.CommandModel, error) {
+	return cmdex.NewCommand(vmwareFusionAppPath+"/Contents/Library/vmware-vdiskmanager", "-k", diskPath), nil
+}
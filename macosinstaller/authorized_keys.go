@@ -0,0 +1,60 @@
+package macosinstaller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/replica/sshkey"
+)
+
+// renderAuthorizedKeys returns the content of the authorized_keys file,
+// which is installed for the user account by the firstboot package.
+// The default keys (the vagrant insecure public key) are only used
+// if neither an authorized key file nor a generated key is provided.
+func renderAuthorizedKeys(authorizedKeyPaths []string, generatedAuthorizedKey, defaultAuthorizedKeys string) (string, error) {
+	keys := []string{}
+	addKeys := func(newKeys []string) {
+		for _, aNewKey := range newKeys {
+			isAlreadyAdded := false
+			for _, aKey := range keys {
+				if aKey == aNewKey {
+					isAlreadyAdded = true
+					break
+				}
+			}
+			if !isAlreadyAdded {
+				keys = append(keys, aNewKey)
+			}
+		}
+	}
+
+	for _, aKeyPath := range authorizedKeyPaths {
+		cont, err := fileutil.ReadStringFromFile(aKeyPath)
+		if err != nil {
+			return "", fmt.Errorf("Failed to read authorized key file (path: %s), error: %s", aKeyPath, err)
+		}
+		fileKeys, err := sshkey.ParseAuthorizedKeys(cont)
+		if err != nil {
+			return "", fmt.Errorf("Invalid authorized key file (path: %s), error: %s", aKeyPath, err)
+		}
+		if len(fileKeys) < 1 {
+			return "", fmt.Errorf("No public key found in authorized key file (path: %s)", aKeyPath)
+		}
+		addKeys(fileKeys)
+	}
+
+	if generatedAuthorizedKey != "" {
+		addKeys([]string{generatedAuthorizedKey})
+	}
+
+	if len(keys) < 1 {
+		defaultKeys, err := sshkey.ParseAuthorizedKeys(defaultAuthorizedKeys)
+		if err != nil {
+			return "", fmt.Errorf("Invalid default authorized keys, error: %s", err)
+		}
+		addKeys(defaultKeys)
+	}
+
+	return strings.Join(keys, "\n") + "\n", nil
+}
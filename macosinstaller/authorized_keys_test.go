@@ -0,0 +1,64 @@
+package macosinstaller
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderAuthorizedKeys(t *testing.T) {
+	const defaultKeys = "ssh-rsa AAAAB3NzaC1yc2EAAAABIwAAAQEA6NF8 vagrant insecure public key\n"
+	const generatedKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIE1h replica"
+
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	teamKeysPath := filepath.Join(tmpDir, "team.pub")
+	require.NoError(t, ioutil.WriteFile(teamKeysPath, []byte(`# CI team
+ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDEy ci@example.com
+ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIE1h replica
+`), 0600))
+
+	t.Log("defaults to the vagrant insecure key")
+	{
+		cont, err := renderAuthorizedKeys([]string{}, "", defaultKeys)
+		require.NoError(t, err)
+		require.Equal(t, defaultKeys, cont)
+	}
+
+	t.Log("generated key replaces the default key")
+	{
+		cont, err := renderAuthorizedKeys([]string{}, generatedKey, defaultKeys)
+		require.NoError(t, err)
+		require.Equal(t, generatedKey+"\n", cont)
+	}
+
+	t.Log("authorized key files and generated key, without duplicates")
+	{
+		cont, err := renderAuthorizedKeys([]string{teamKeysPath}, generatedKey, defaultKeys)
+		require.NoError(t, err)
+		require.Equal(t, `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDEy ci@example.com
+ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIE1h replica
+`, cont)
+	}
+
+	t.Log("missing authorized key file")
+	{
+		_, err := renderAuthorizedKeys([]string{filepath.Join(tmpDir, "missing.pub")}, "", defaultKeys)
+		require.Error(t, err)
+	}
+
+	t.Log("authorized key file without keys")
+	{
+		emptyKeysPath := filepath.Join(tmpDir, "empty.pub")
+		require.NoError(t, ioutil.WriteFile(emptyKeysPath, []byte("# nothing here\n"), 0600))
+		_, err := renderAuthorizedKeys([]string{emptyKeysPath}, "", defaultKeys)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,79 @@
+package macosinstaller
+
+import (
+	"text/template"
+
+	"github.com/bitrise-io/go-utils/templateutil"
+)
+
+func renderInstallProfilesScriptTemplate(profilesDirPath, launchDaemonLabel string) (string, error) {
+	type TemplateInventory struct {
+		ProfilesDirPath   string
+		LaunchDaemonLabel string
+	}
+	inv := TemplateInventory{
+		ProfilesDirPath:   profilesDirPath,
+		LaunchDaemonLabel: launchDaemonLabel,
+	}
+
+	result, err := templateutil.EvaluateTemplateStringToString(`#!/bin/sh
+# Installs the configuration profiles staged by replica, at first boot
+PROFILES_DIR="{{ .ProfilesDirPath }}"
+LAUNCH_DAEMON_PLIST="/Library/LaunchDaemons/{{ .LaunchDaemonLabel }}.plist"
+
+FAILED=0
+for PROFILE in "$PROFILES_DIR"/*.mobileconfig; do
+    [ -f "$PROFILE" ] || continue
+    if /usr/bin/profiles -I -F "$PROFILE"; then
+        echo "Installed configuration profile: $PROFILE"
+        rm -f "$PROFILE"
+    else
+        echo "Failed to install configuration profile: $PROFILE"
+        FAILED=1
+    fi
+done
+
+# Every profile is installed, the launch daemon is not required anymore
+if [ "$FAILED" = 0 ]; then
+    rm -f "$LAUNCH_DAEMON_PLIST"
+    rm -rf "$PROFILES_DIR"
+    rm -f "$0"
+fi
+`, inv, template.FuncMap{})
+
+	return result, err
+}
+
+func renderInstallProfilesLaunchDaemonPlistTemplate(launchDaemonLabel, scriptPath string) (string, error) {
+	type TemplateInventory struct {
+		LaunchDaemonLabel string
+		ScriptPath        string
+	}
+	inv := TemplateInventory{
+		LaunchDaemonLabel: launchDaemonLabel,
+		ScriptPath:        scriptPath,
+	}
+
+	result, err := templateutil.EvaluateTemplateStringToString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{ .LaunchDaemonLabel }}</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/bin/sh</string>
+		<string>{{ .ScriptPath }}</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>StandardOutPath</key>
+	<string>/var/log/{{ .LaunchDaemonLabel }}.log</string>
+	<key>StandardErrorPath</key>
+	<string>/var/log/{{ .LaunchDaemonLabel }}.log</string>
+</dict>
+</plist>
+`, inv, template.FuncMap{})
+
+	return result, err
+}
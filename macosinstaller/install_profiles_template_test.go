@@ -0,0 +1,61 @@
+package macosinstaller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderInstallProfilesScriptTemplate(t *testing.T) {
+	result, err := renderInstallProfilesScriptTemplate("/private/var/replica/profiles", "io.bitrise.replica.install-profiles")
+	require.NoError(t, err)
+	require.Equal(t, `#!/bin/sh
+# Installs the configuration profiles staged by replica, at first boot
+PROFILES_DIR="/private/var/replica/profiles"
+LAUNCH_DAEMON_PLIST="/Library/LaunchDaemons/io.bitrise.replica.install-profiles.plist"
+
+FAILED=0
+for PROFILE in "$PROFILES_DIR"/*.mobileconfig; do
+    [ -f "$PROFILE" ] || continue
+    if /usr/bin/profiles -I -F "$PROFILE"; then
+        echo "Installed configuration profile: $PROFILE"
+        rm -f "$PROFILE"
+    else
+        echo "Failed to install configuration profile: $PROFILE"
+        FAILED=1
+    fi
+done
+
+# Every profile is installed, the launch daemon is not required anymore
+if [ "$FAILED" = 0 ]; then
+    rm -f "$LAUNCH_DAEMON_PLIST"
+    rm -rf "$PROFILES_DIR"
+    rm -f "$0"
+fi
+`, result)
+}
+
+func Test_renderInstallProfilesLaunchDaemonPlistTemplate(t *testing.T) {
+	result, err := renderInstallProfilesLaunchDaemonPlistTemplate("io.bitrise.replica.install-profiles", "/private/var/replica/install-profiles.sh")
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>io.bitrise.replica.install-profiles</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/bin/sh</string>
+		<string>/private/var/replica/install-profiles.sh</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>StandardOutPath</key>
+	<string>/var/log/io.bitrise.replica.install-profiles.log</string>
+	<key>StandardErrorPath</key>
+	<string>/var/log/io.bitrise.replica.install-profiles.log</string>
+</dict>
+</plist>
+`, result)
+}
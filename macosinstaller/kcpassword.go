@@ -0,0 +1,20 @@
+package macosinstaller
+
+// kcPasswordKey is the magic 11 bytes key, used to obfuscate the /etc/kcpassword file.
+// Based on Gavin Brock's Perl kcpassword generator: http://www.brock-family.org/gavin/perl/kcpassword.html
+var kcPasswordKey = []byte{0x7D, 0x89, 0x52, 0x23, 0xD2, 0xBC, 0xDD, 0xEA, 0xA3, 0xB9, 0x1F}
+
+// encodeKCPassword returns the content of the /etc/kcpassword file,
+// which is used by the login window for the automatic GUI login.
+func encodeKCPassword(password string) []byte {
+	encoded := []byte(password)
+	// pad password length out to an even multiple of key length
+	if r := len(encoded) % len(kcPasswordKey); r > 0 {
+		encoded = append(encoded, make([]byte, len(kcPasswordKey)-r)...)
+	}
+
+	for idx := range encoded {
+		encoded[idx] = encoded[idx] ^ kcPasswordKey[idx%len(kcPasswordKey)]
+	}
+	return encoded
+}
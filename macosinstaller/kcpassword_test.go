@@ -0,0 +1,15 @@
+package macosinstaller
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_encodeKCPassword(t *testing.T) {
+	require.Equal(t, "0be83551b3d2a9eaa3b91f", hex.EncodeToString(encodeKCPassword("vagrant")))
+	require.Equal(t, "1ceb3147b7daba82cad374", hex.EncodeToString(encodeKCPassword("abcdefghijk")))
+	require.Equal(t, "1ceb3147b7daba82cad37411895223d2bcddeaa3b91f", hex.EncodeToString(encodeKCPassword("abcdefghijkl")))
+	require.Equal(t, "", hex.EncodeToString(encodeKCPassword("")))
+}
@@ -6,6 +6,8 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/DHowett/go-plist"
 	"github.com/bitrise-io/go-utils/cmdex"
@@ -13,42 +15,74 @@ import (
 	"github.com/bitrise-io/go-utils/fileutil"
 	"github.com/bitrise-io/go-utils/pathutil"
 	"github.com/bitrise-io/goinp/goinp"
+	"github.com/bitrise-io/replica/artifacts"
 	"github.com/bitrise-io/replica/resources"
+	"github.com/bitrise-io/replica/sshkey"
 )
 
+// Options ...
+type Options struct {
+	// AuthorizedKeyPaths are public key files, the keys of which will be
+	// installed as the authorized SSH keys of the user account.
+	// If empty (and no key is generated) the vagrant insecure public key is used.
+	AuthorizedKeyPaths []string
+	// IsGenerateSSHKey if true an ed25519 key pair is generated for the DMG,
+	// the private key is saved next to the DMG.
+	IsGenerateSSHKey bool
+	// ProfilePaths are configuration profiles (.mobileconfig), which are
+	// staged in the firstboot package and installed at first boot.
+	ProfilePaths []string
+}
+
+// ResultModel ...
+type ResultModel struct {
+	DMGPath string
+	// SSHPrivateKeyPath is the path of the generated SSH private key, if any
+	SSHPrivateKeyPath string
+	// ProfileIdentifiers are the identifiers of the embedded configuration profiles
+	ProfileIdentifiers []string
+}
+
 // CreateInstallDMGFromInstallMacOSApp ...
-func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, error) {
+func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string, opts Options) (ResultModel, error) {
+	result := ResultModel{}
 	accountUsername := "vagrant"
-	// accountPassword := "vagrant"
+	// the password shadow hash (usr-password-shadow resource) is generated for this password
+	accountPassword := "vagrant"
 
 	dataBox, err := resources.GetResourcesBox()
 	if err != nil {
-		return "", fmt.Errorf("Failed to find 'data' resource box, error: %s", err)
+		return ResultModel{}, fmt.Errorf("Failed to find 'data' resource box, error: %s", err)
+	}
+
+	profiles, err := readConfigurationProfiles(opts.ProfilePaths)
+	if err != nil {
+		return ResultModel{}, err
 	}
 
 	outDir := "./_out"
 	{
 		p, err := pathutil.AbsPath(outDir)
 		if err != nil {
-			return "", fmt.Errorf("Failed to get absolute path of output directory, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to get absolute path of output directory, error: %s", err)
 		}
 		outDir = p
 	}
 	if err := pathutil.EnsureDirExist(outDir); err != nil {
-		return "", fmt.Errorf("Failed to create output directory (path:%s), error: %s", outDir, err)
+		return ResultModel{}, fmt.Errorf("Failed to create output directory (path:%s), error: %s", outDir, err)
 	}
 
 	// ESD="$ESD/Contents/SharedSupport/InstallESD.dmg"
 	installESDPath := filepath.Join(installMacOSAppPath, "Contents/SharedSupport/InstallESD.dmg")
 	if isExist, err := pathutil.IsPathExists(installESDPath); err != nil {
-		return "", fmt.Errorf("Failed to locate InstallESD.dmg, error: %s", err)
+		return ResultModel{}, fmt.Errorf("Failed to locate InstallESD.dmg, error: %s", err)
 	} else if !isExist {
-		return "", fmt.Errorf("InstallESD.dmg does not exist inside the installer at path: %s", installESDPath)
+		return ResultModel{}, fmt.Errorf("InstallESD.dmg does not exist inside the installer at path: %s", installESDPath)
 	}
 
 	tmpDir, err := pathutil.NormalizedOSTempDirPath("replica")
 	if err != nil {
-		return "", fmt.Errorf("Failed to create temporary ESD mount directory, error: %s", err)
+		return ResultModel{}, fmt.Errorf("Failed to create temporary ESD mount directory, error: %s", err)
 	}
 
 	isFinishedWithSuccess := false
@@ -68,16 +102,16 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 	// MNT_ESD=$(/usr/bin/mktemp -d /tmp/veewee-osx-esd.XXXX)
 	tmpESDMountDir := filepath.Join(tmpDir, "mnt", "esd")
 	if err := pathutil.EnsureDirExist(tmpESDMountDir); err != nil {
-		return "", fmt.Errorf("Failed to create temporary ESD mount directory, error: %s", err)
+		return ResultModel{}, fmt.Errorf("Failed to create temporary ESD mount directory, error: %s", err)
 	}
 	{
 		// SHADOW_FILE=$(/usr/bin/mktemp /tmp/veewee-osx-shadow.XXXX)
 		tmpESDShadowFilePath := filepath.Join(tmpDir, "esd-shadow")
 		// rm "$SHADOW_FILE"
 		if isExist, err := pathutil.IsPathExists(tmpESDShadowFilePath); err != nil {
-			return "", fmt.Errorf("Failed to check whether the temporary ESD shadow file already exists, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to check whether the temporary ESD shadow file already exists, error: %s", err)
 		} else if isExist {
-			return "", fmt.Errorf("Temporary ESD shadow file already exists at path: %s", tmpESDShadowFilePath)
+			return ResultModel{}, fmt.Errorf("Temporary ESD shadow file already exists at path: %s", tmpESDShadowFilePath)
 		}
 
 		// hdiutil attach "$ESD" -mountpoint "$MNT_ESD" -shadow "$SHADOW_FILE" -nobrowse -owners on
@@ -90,7 +124,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		log.Printf("$ %s", cmd.PrintableCommandArgs())
 		fmt.Println()
 		if err := cmd.Run(); err != nil {
-			return "", fmt.Errorf("Failed to mount InstallESD into a temporary directory (path:%s), error: %s", tmpESDMountDir, err)
+			return ResultModel{}, fmt.Errorf("Failed to mount InstallESD into a temporary directory (path:%s), error: %s", tmpESDMountDir, err)
 		}
 
 		// cleanup
@@ -117,13 +151,13 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 	isTmpBaseSystemMountDirPathDetached := false
 	{
 		if isExist, err := pathutil.IsPathExists(baseSystemDMGPath); err != nil {
-			return "", fmt.Errorf("Failed to check whether BaseSystem.dmg exists (path:%s), error: %s", baseSystemDMGPath, err)
+			return ResultModel{}, fmt.Errorf("Failed to check whether BaseSystem.dmg exists (path:%s), error: %s", baseSystemDMGPath, err)
 		} else if !isExist {
-			return "", fmt.Errorf("BaseSystem.dmg does not exist (path:%s)", baseSystemDMGPath)
+			return ResultModel{}, fmt.Errorf("BaseSystem.dmg does not exist (path:%s)", baseSystemDMGPath)
 		}
 
 		if err := pathutil.EnsureDirExist(tmpBaseSystemMountDirPath); err != nil {
-			return "", fmt.Errorf("Failed to create temporary 'Base System' mount directory, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to create temporary 'Base System' mount directory, error: %s", err)
 		}
 		// hdiutil attach "$BASE_SYSTEM_DMG" -mountpoint "$MNT_BASE_SYSTEM" -nobrowse -owners on
 		cmd := cmdex.NewCommandWithStandardOuts("hdiutil",
@@ -134,7 +168,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		log.Printf("$ %s", cmd.PrintableCommandArgs())
 		fmt.Println()
 		if err := cmd.Run(); err != nil {
-			return "", fmt.Errorf("Failed to mount BaseSystem.dmg into a temporary directory (path:%s), error: %s", tmpBaseSystemMountDirPath, err)
+			return ResultModel{}, fmt.Errorf("Failed to mount BaseSystem.dmg into a temporary directory (path:%s), error: %s", tmpBaseSystemMountDirPath, err)
 		}
 
 		// cleanup
@@ -158,7 +192,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		// DMG_OS_VERS=$(/usr/libexec/PlistBuddy -c 'Print :ProductVersion' "$SYSVER_PLIST_PATH")
 		macOSVer, err := readMacOSVersionFromPlist(systemVersionPlistFilePath)
 		if err != nil {
-			return "", fmt.Errorf("Failed to read MacOS version, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to read MacOS version, error: %s", err)
 		}
 		// msg_status "OS X version detected: 10.$DMG_OS_VERS_MAJOR.$DMG_OS_VERS_MINOR, build $DMG_OS_BUILD"
 		log.Printf("OS X version detected: %#v", macOSVer)
@@ -169,18 +203,29 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 	outDMGPath := filepath.Join(outDir, fmt.Sprintf("OSX_InstallESD_%s_%s.dmg", macOSVersion.Version, macOSVersion.Build))
 	log.Printf("outDMGPath: %s", outDMGPath)
 	if isExist, err := pathutil.IsPathExists(outDMGPath); err != nil {
-		return "", fmt.Errorf("Failed to check whether the output DMG file already exists, error: %s", err)
+		return ResultModel{}, fmt.Errorf("Failed to check whether the output DMG file already exists, error: %s", err)
 	} else if isExist {
 		if isShouldOverwrite, err := goinp.AskForBoolWithDefault(
 			fmt.Sprintf("A DMG already exists at the path (%s), do you want to overwrite it?", outDMGPath), true); err != nil {
-			return "", fmt.Errorf("Failed to read input, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to read input, error: %s", err)
 		} else if isShouldOverwrite {
 			if err := os.Remove(outDMGPath); err != nil {
-				return "", fmt.Errorf("Failed to delete DMG (path: %s), error: %s", outDMGPath, err)
+				return ResultModel{}, fmt.Errorf("Failed to delete DMG (path: %s), error: %s", outDMGPath, err)
 			}
 		} else {
-			return "", fmt.Errorf("Output DMG already exists (at path: %s) - covardly refusing to overwrite it", outDMGPath)
+			return ResultModel{}, fmt.Errorf("Output DMG already exists (at path: %s) - covardly refusing to overwrite it", outDMGPath)
+		}
+	}
+
+	generatedKeyPair := sshkey.KeyPairModel{}
+	if opts.IsGenerateSSHKey {
+		fmt.Println()
+		log.Println(colorstring.Green(" => Generating SSH key pair.."))
+		keyPair, err := sshkey.GenerateED25519KeyPair(accountUsername + "@replica")
+		if err != nil {
+			return ResultModel{}, fmt.Errorf("Failed to generate SSH key pair, error: %s", err)
 		}
+		generatedKeyPair = keyPair
 	}
 
 	fmt.Println()
@@ -190,7 +235,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 	{
 		tmpInstallerPkgPath := filepath.Join(tmpDir, "pkginst")
 		if err := pathutil.EnsureDirExist(tmpInstallerPkgPath); err != nil {
-			return "", fmt.Errorf("Failed to create tmp installer pkg dir, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to create tmp installer pkg dir, error: %s", err)
 		}
 		log.Println(" ==> Created temporary installer pkg directory at path: ", tmpInstallerPkgPath)
 
@@ -198,17 +243,17 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 
 		// mkdir -p "$SUPPORT_DIR/pkgroot/private/var/db/dslocal/nodes/Default/users"
 		if err := pathutil.EnsureDirExist(filepath.Join(pkgBuildPkgRootPath, "private/var/db/dslocal/nodes/Default/users")); err != nil {
-			return "", fmt.Errorf("Failed to create pkg users dir, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to create pkg users dir, error: %s", err)
 		}
 		// mkdir -p "$SUPPORT_DIR/pkgroot/private/var/db/shadow/hash"
 		if err := pathutil.EnsureDirExist(filepath.Join(pkgBuildPkgRootPath, "private/var/db/shadow/hash")); err != nil {
-			return "", fmt.Errorf("Failed to create pkg hash dir, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to create pkg hash dir, error: %s", err)
 		}
 
 		// BASE64_IMAGE=$(openssl base64 -in "$IMAGE_PATH")
 		imgContBytes, err := dataBox.Bytes("vagrant.jpg")
 		if err != nil {
-			return "", fmt.Errorf("Failed to read user account image, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to read user account image, error: %s", err)
 		}
 		// Originally this was generate with: $ openssl base64 -in path/to/image.jpg
 		rawBase64UserImage := base64.StdEncoding.EncodeToString(imgContBytes)
@@ -226,7 +271,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		accountGeneratedUID := "11112222-3333-4444-AAAA-BBBBCCCCDDDD"
 		userPlistContent, err := renderUserPlistTemplate(accountUsername, multilineBase64UserImage, accountGeneratedUID)
 		if err != nil {
-			return "", fmt.Errorf("Failed to render User.plist template, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to render User.plist template, error: %s", err)
 		}
 		// fmt.Println()
 		// fmt.Println(userPlistContent)
@@ -235,10 +280,35 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		userPlistPath := filepath.Join(pkgBuildPkgRootPath,
 			"private/var/db/dslocal/nodes/Default/users", accountUsername+".plist")
 		if err := fileutil.WriteStringToFile(userPlistPath, userPlistContent); err != nil {
-			return "", fmt.Errorf("Failed to write User.plist into file, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to write User.plist into file, error: %s", err)
 		}
 		log.Println("User.plist (" + accountUsername + ".plist) saved into file - [OK]")
 
+		// authorized SSH keys of the user, instead of downloading the vagrant key during the packer build
+		{
+			defaultAuthorizedKeysBytes, err := dataBox.Bytes("vagrant.pub")
+			if err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to read vagrant public key, error: %s", err)
+			}
+			authorizedKeysCont, err := renderAuthorizedKeys(opts.AuthorizedKeyPaths, generatedKeyPair.AuthorizedKey, string(defaultAuthorizedKeysBytes))
+			if err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to collect authorized SSH keys, error: %s", err)
+			}
+
+			userSSHDirPath := filepath.Join(pkgBuildPkgRootPath, "Users", accountUsername, ".ssh")
+			if err := os.MkdirAll(userSSHDirPath, 0700); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to create .ssh dir, error: %s", err)
+			}
+			if err := os.Chmod(userSSHDirPath, 0700); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to chmod .ssh dir, error: %s", err)
+			}
+			authorizedKeysPath := filepath.Join(userSSHDirPath, "authorized_keys")
+			if err := fileutil.WriteStringToFileWithPermission(authorizedKeysPath, authorizedKeysCont, 0600); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to write authorized_keys into file, error: %s", err)
+			}
+			log.Println("authorized_keys saved into file - [OK]")
+		}
+
 		// "$SUPPORT_DIR/generate_shadowhash" "$PASSWORD" > "$SUPPORT_DIR/pkgroot/private/var/db/shadow/hash/$USER_GUID"
 		// # Generate a shadowhash from the supplied password
 		// user shadow hash (password)
@@ -247,22 +317,46 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		accountPasswordShadowHashFilePath := filepath.Join(
 			pkgBuildPkgRootPath, "private/var/db/shadow/hash", accountGeneratedUID)
 		// if err := fileutil.WriteStringToFile(accountPasswordShadowHashFilePath, accountPasswordShadowHash); err != nil {
-		// 	return "", fmt.Errorf("Failed to write account password shadow hash into file (%s), error: %s", accountPasswordShadowHashFilePath, err)
+		// 	return ResultModel{}, fmt.Errorf("Failed to write account password shadow hash into file (%s), error: %s", accountPasswordShadowHashFilePath, err)
 		// }
 		{
 			usrPswShadowBytes, err := dataBox.Bytes("usr-password-shadow")
 			if err != nil {
-				return "", fmt.Errorf("Failed to read user password shadow data, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to read user password shadow data, error: %s", err)
 			}
 
 			fmt.Println()
 			fmt.Println(" => Writing user password shadow hash into file ...")
 			fmt.Println()
 			if err := fileutil.WriteBytesToFile(accountPasswordShadowHashFilePath, usrPswShadowBytes); err != nil {
-				return "", fmt.Errorf("Failed to write user password shadow hash into file (path: %s), error: %s", accountPasswordShadowHashFilePath, err)
+				return ResultModel{}, fmt.Errorf("Failed to write user password shadow hash into file (path: %s), error: %s", accountPasswordShadowHashFilePath, err)
+			}
+		}
+
+		// configuration profiles, installed at first boot by a launch daemon
+		if len(profiles) > 0 {
+			log.Println("Staging configuration profiles ...")
+			if err := stageConfigurationProfiles(profiles, pkgBuildPkgRootPath); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to stage configuration profiles, error: %s", err)
+			}
+			for _, aProfile := range profiles {
+				log.Println(" * " + aProfile.Identifier + " (" + aProfile.Path + ") - [OK]")
+				result.ProfileIdentifiers = append(result.ProfileIdentifiers, aProfile.Identifier)
 			}
 		}
 
+		// obfuscated password for the automatic GUI login, the autoLoginUser preference is set by the post install script
+		{
+			if err := pathutil.EnsureDirExist(filepath.Join(pkgBuildPkgRootPath, "private/etc")); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to create pkg etc dir, error: %s", err)
+			}
+			kcPasswordFilePath := filepath.Join(pkgBuildPkgRootPath, "private/etc/kcpassword")
+			if err := fileutil.WriteBytesToFileWithPermission(kcPasswordFilePath, encodeKCPassword(accountPassword), 0600); err != nil {
+				return ResultModel{}, fmt.Errorf("Failed to write kcpassword into file, error: %s", err)
+			}
+			log.Println("kcpassword saved into file - [OK]")
+		}
+
 		//
 		// cat "$SUPPORT_DIR/pkg-postinstall" \
 		// | sed -e "s/__USER__PLACEHOLDER__/${USER}/" \
@@ -276,22 +370,22 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		disableSIP := false
 		postInstScriptCont, err := renderPostInstallScriptTemplate(accountUsername, disableRemoteManagement, disableScreenSharing, disableSIP)
 		if err != nil {
-			return "", fmt.Errorf("Failed to render post install script template, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to render post install script template, error: %s", err)
 		}
 
 		postInstallScriptDirPath := filepath.Join(tmpInstallerPkgPath, "tmp/Scripts")
 		// mkdir -p "$SUPPORT_DIR/tmp/Scripts"
 		if err := pathutil.EnsureDirExist(postInstallScriptDirPath); err != nil {
-			return "", fmt.Errorf("Failed to create post install Scripts directory (path:%s), error: %s", postInstallScriptDirPath, err)
+			return ResultModel{}, fmt.Errorf("Failed to create post install Scripts directory (path:%s), error: %s", postInstallScriptDirPath, err)
 		}
 		postInstallScriptPath := filepath.Join(postInstallScriptDirPath, "postinstall")
 		if err := fileutil.WriteStringToFile(postInstallScriptPath, postInstScriptCont); err != nil {
-			return "", fmt.Errorf("Failed to write Post Install script into file, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to write Post Install script into file, error: %s", err)
 		}
 		log.Println("Post Install script saved into file - [OK]")
 		// chmod a+x "$SUPPORT_DIR/tmp/Scripts/postinstall"
 		if err := os.Chmod(postInstallScriptPath, 0755); err != nil {
-			return "", fmt.Errorf("Failed to chmod postInstallScriptPath, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to chmod postInstallScriptPath, error: %s", err)
 		}
 		log.Println("Post Install script made executable - [OK]")
 
@@ -318,7 +412,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to build package, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to build package, error: %s", err)
 			}
 		}
 
@@ -338,7 +432,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to build package, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to build package, error: %s", err)
 			}
 		}
 
@@ -352,7 +446,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to run command, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 			}
 			isTmpBaseSystemMountDirPathDetached = true
 		}
@@ -378,13 +472,13 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to run command, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 			}
 		}
 
 		tmpBaseSystemDMGRWMountDirPath := filepath.Join(tmpDir, "mnt", "dmg-basesystem-rw")
 		if err := pathutil.EnsureDirExist(tmpBaseSystemDMGRWMountDirPath); err != nil {
-			return "", fmt.Errorf("Failed to create temporary 'Base System' mount directory, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to create temporary 'Base System' mount directory, error: %s", err)
 		}
 
 		// hdiutil attach "$BASE_SYSTEM_DMG_RW" -mountpoint "$MNT_BASE_SYSTEM" -nobrowse -owners on
@@ -398,7 +492,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to run command, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 			}
 		}
 		// cleanup
@@ -437,7 +531,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to run command, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 			}
 		}
 
@@ -450,7 +544,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 			log.Printf("$ %s", cmd.PrintableCommandArgs())
 			fmt.Println()
 			if err := cmd.Run(); err != nil {
-				return "", fmt.Errorf("Failed to run command, error: %s", err)
+				return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 			}
 			isTmpBaseSystemDMGRWMountDirPathDetached = true
 		}
@@ -464,7 +558,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 
 			// rm "$PACKAGES_DIR"
 			if err := os.Remove(packagesDir); err != nil {
-				return "", fmt.Errorf("Failed to remove mounted Packages dir (path:%s), error: %s", packagesDir, err)
+				return ResultModel{}, fmt.Errorf("Failed to remove mounted Packages dir (path:%s), error: %s", packagesDir, err)
 			}
 
 			// msg_status "Moving 'Packages' directory from the ESD to BaseSystem.."
@@ -481,7 +575,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 				log.Printf("$ %s", cmd.PrintableCommandArgs())
 				fmt.Println()
 				if err := cmd.Run(); err != nil {
-					return "", fmt.Errorf("Failed to run command, error: %s", err)
+					return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 				}
 			}
 
@@ -503,7 +597,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 				log.Printf("$ %s", cmd.PrintableCommandArgs())
 				fmt.Println()
 				if err := cmd.Run(); err != nil {
-					return "", fmt.Errorf("Failed to run command, error: %s", err)
+					return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 				}
 			}
 			// cp "$MNT_ESD/BaseSystem.chunklist" "$MNT_BASE_SYSTEM/"
@@ -516,7 +610,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 				log.Printf("$ %s", cmd.PrintableCommandArgs())
 				fmt.Println()
 				if err := cmd.Run(); err != nil {
-					return "", fmt.Errorf("Failed to run command, error: %s", err)
+					return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 				}
 			}
 
@@ -537,18 +631,18 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 				    diskutil eraseDisk jhfs+ "Macintosh HD" GPTFormat disk1
 				fi`
 				if err := fileutil.WriteStringToFile(cdromDotLocalFilePath, cdromFileCont); err != nil {
-					return "", fmt.Errorf("Failed to write rc.cdrom.local content into file, error: %s", err)
+					return ResultModel{}, fmt.Errorf("Failed to write rc.cdrom.local content into file, error: %s", err)
 				}
 				// chmod a+x "$CDROM_LOCAL"
 				if err := os.Chmod(cdromDotLocalFilePath, 0755); err != nil {
-					return "", fmt.Errorf("Failed to chmod cdromDotLocalFilePath, error: %s", err)
+					return ResultModel{}, fmt.Errorf("Failed to chmod cdromDotLocalFilePath, error: %s", err)
 				}
 
 				{
 					// mkdir "$PACKAGES_DIR/Extras"
 					packagesExtrasDirPath := filepath.Join(packagesDir, "Extras")
 					if err := pathutil.EnsureDirExist(packagesExtrasDirPath); err != nil {
-						return "", fmt.Errorf("Failed to create Packages/Extras, error: %s", err)
+						return ResultModel{}, fmt.Errorf("Failed to create Packages/Extras, error: %s", err)
 					}
 
 					// cp "$SUPPORT_DIR/minstallconfig.xml" "$PACKAGES_DIR/Extras/"
@@ -571,7 +665,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 `
 					fpth := filepath.Join(packagesExtrasDirPath, "minstallconfig.xml")
 					if err := fileutil.WriteStringToFile(fpth, minstallconfigXMLContent); err != nil {
-						return "", fmt.Errorf("Failed to write 'minstallconfig.xml' into file, error: %s", err)
+						return ResultModel{}, fmt.Errorf("Failed to write 'minstallconfig.xml' into file, error: %s", err)
 					}
 				}
 				// cp "$SUPPORT_DIR/OSInstall.collection" "$PACKAGES_DIR/"
@@ -588,7 +682,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 `
 					fpth := filepath.Join(packagesDir, "OSInstall.collection")
 					if err := fileutil.WriteStringToFile(fpth, osInstallCollectioncont); err != nil {
-						return "", fmt.Errorf("Failed to write 'OSInstall.collection' into file, error: %s", err)
+						return ResultModel{}, fmt.Errorf("Failed to write 'OSInstall.collection' into file, error: %s", err)
 					}
 				}
 
@@ -602,7 +696,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 					log.Printf("$ %s", cmd.PrintableCommandArgs())
 					fmt.Println()
 					if err := cmd.Run(); err != nil {
-						return "", fmt.Errorf("Failed to run command, error: %s", err)
+						return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 					}
 				}
 				// rm -rf "$SUPPORT_DIR/tmp"
@@ -621,7 +715,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		log.Printf("$ %s", cmd.PrintableCommandArgs())
 		fmt.Println()
 		if err := cmd.Run(); err != nil {
-			return "", fmt.Errorf("Failed to run command, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 		}
 	}
 
@@ -639,7 +733,7 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 		log.Printf("$ %s", cmd.PrintableCommandArgs())
 		fmt.Println()
 		if err := cmd.Run(); err != nil {
-			return "", fmt.Errorf("Failed to run command, error: %s", err)
+			return ResultModel{}, fmt.Errorf("Failed to run command, error: %s", err)
 		}
 	}
 
@@ -649,8 +743,49 @@ func CreateInstallDMGFromInstallMacOSApp(installMacOSAppPath string) (string, er
 
 	// msg_status "Done. Built image is located at $OUTPUT_DMG. Add this iso and its checksum to your template."
 
+	if opts.IsGenerateSSHKey {
+		privateKeyPath := sshkey.PrivateKeyPathFor(outDMGPath)
+		if err := fileutil.WriteBytesToFileWithPermission(privateKeyPath, generatedKeyPair.PrivateKeyPEM, 0600); err != nil {
+			return ResultModel{}, fmt.Errorf("Failed to write SSH private key into file, error: %s", err)
+		}
+		log.Println(colorstring.Green(" => SSH private key saved to:"), privateKeyPath)
+		result.SSHPrivateKeyPath = privateKeyPath
+	}
+
+	if err := artifacts.WriteManifest(outDMGPath, artifacts.ManifestModel{
+		Kind:         artifacts.KindDMG,
+		MacOSVersion: macOSVersion.Version,
+		MacOSBuild:   macOSVersion.Build,
+		CreatedAt:    time.Now(),
+		SourcePath:   installMacOSAppPath,
+		Settings:     manifestSettings(opts, result),
+	}); err != nil {
+		return ResultModel{}, err
+	}
+
 	isFinishedWithSuccess = true
-	return outDMGPath, nil
+	result.DMGPath = outDMGPath
+	return result, nil
+}
+
+// manifestSettings returns the customization settings of the DMG, recorded in its manifest
+func manifestSettings(opts Options, result ResultModel) map[string]string {
+	settings := map[string]string{}
+	authorizedKeys := []string{}
+	for _, aPath := range opts.AuthorizedKeyPaths {
+		authorizedKeys = append(authorizedKeys, filepath.Base(aPath))
+	}
+	if opts.IsGenerateSSHKey {
+		authorizedKeys = append(authorizedKeys, "generated ed25519 key")
+	}
+	if len(authorizedKeys) == 0 {
+		authorizedKeys = append(authorizedKeys, "vagrant insecure key")
+	}
+	settings["authorized_keys"] = strings.Join(authorizedKeys, ", ")
+	if len(result.ProfileIdentifiers) > 0 {
+		settings["profiles"] = strings.Join(result.ProfileIdentifiers, ", ")
+	}
+	return settings
 }
 
 // MacOSVersionModel ...
@@ -1 +1,19 @@
 package macosinstaller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_manifestSettings(t *testing.T) {
+	require.Equal(t, map[string]string{"authorized_keys": "vagrant insecure key"}, manifestSettings(Options{}, ResultModel{}))
+
+	require.Equal(t, map[string]string{
+		"authorized_keys": "id_ed25519.pub, generated ed25519 key",
+		"profiles":        "com.example.wifi, com.example.proxy",
+	}, manifestSettings(Options{
+		AuthorizedKeyPaths: []string{"/Users/me/.ssh/id_ed25519.pub"},
+		IsGenerateSSHKey:   true,
+	}, ResultModel{ProfileIdentifiers: []string{"com.example.wifi", "com.example.proxy"}}))
+}
@@ -99,6 +99,10 @@ if [ "$OSX_VERS" -ge 10 ]; then
     $PlistBuddy -c "Add :ThirdPartyDataSubmitVersion integer 4" "${CRASHREPORTER_DIAG_PLIST}"
 fi
 
+# Enable automatic GUI login for the user (the obfuscated password is installed into /etc/kcpassword)
+$PlistBuddy -c 'Delete :autoLoginUser' "$3/Library/Preferences/com.apple.loginwindow.plist" 2> /dev/null
+$PlistBuddy -c 'Add :autoLoginUser string '"$USER" "$3/Library/Preferences/com.apple.loginwindow.plist"
+
 # Disable loginwindow screensaver to save CPU cycles
 $PlistBuddy -c 'Add :loginWindowIdleTime integer 0' "$3/Library/Preferences/com.apple.screensaver.plist"
 
@@ -0,0 +1,154 @@
+package macosinstaller
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/DHowett/go-plist"
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+const (
+	// profilesDirPathInPkg is the directory (relative to the pkg root) where the
+	// configuration profiles are staged, until they are installed at first boot
+	profilesDirPathInPkg = "private/var/replica/profiles"
+	// installProfilesLaunchDaemonLabel is the label of the launch daemon which
+	// installs the staged configuration profiles at first boot
+	installProfilesLaunchDaemonLabel = "io.bitrise.replica.install-profiles"
+)
+
+var profileIdentifierRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ConfigurationProfileModel ...
+type ConfigurationProfileModel struct {
+	Path          string
+	Identifier    string
+	UUID          string
+	DisplayName   string
+	PayloadsCount int
+}
+
+type configurationProfilePlistModel struct {
+	Identifier  string                   `plist:"PayloadIdentifier"`
+	UUID        string                   `plist:"PayloadUUID"`
+	Type        string                   `plist:"PayloadType"`
+	Version     int                      `plist:"PayloadVersion"`
+	DisplayName string                   `plist:"PayloadDisplayName"`
+	Content     []map[string]interface{} `plist:"PayloadContent"`
+}
+
+// FileName is the name of the profile file in the firstboot package
+func (profile ConfigurationProfileModel) FileName() string {
+	return profile.Identifier + ".mobileconfig"
+}
+
+// parseConfigurationProfile parses and validates an (unsigned) configuration profile
+func parseConfigurationProfile(content []byte) (ConfigurationProfileModel, error) {
+	profile := configurationProfilePlistModel{}
+	if _, err := plist.Unmarshal(content, &profile); err != nil {
+		return ConfigurationProfileModel{}, fmt.Errorf("Not a valid profile plist (signed profiles are not supported), error: %s", err)
+	}
+
+	if profile.Type != "Configuration" {
+		return ConfigurationProfileModel{}, fmt.Errorf("Invalid PayloadType (%s), should be: Configuration", profile.Type)
+	}
+	if profile.Identifier == "" {
+		return ConfigurationProfileModel{}, fmt.Errorf("PayloadIdentifier is not defined")
+	}
+	if !profileIdentifierRegexp.MatchString(profile.Identifier) {
+		return ConfigurationProfileModel{}, fmt.Errorf("Invalid PayloadIdentifier: %s", profile.Identifier)
+	}
+	if profile.UUID == "" {
+		return ConfigurationProfileModel{}, fmt.Errorf("PayloadUUID is not defined")
+	}
+	if profile.Version != 1 {
+		return ConfigurationProfileModel{}, fmt.Errorf("Invalid PayloadVersion (%d), should be: 1", profile.Version)
+	}
+	for idx, aPayload := range profile.Content {
+		if payloadType, ok := aPayload["PayloadType"].(string); !ok || payloadType == "" {
+			return ConfigurationProfileModel{}, fmt.Errorf("PayloadType of the payload #%d is not defined", idx)
+		}
+		if payloadIdentifier, ok := aPayload["PayloadIdentifier"].(string); !ok || payloadIdentifier == "" {
+			return ConfigurationProfileModel{}, fmt.Errorf("PayloadIdentifier of the payload #%d is not defined", idx)
+		}
+	}
+
+	return ConfigurationProfileModel{
+		Identifier:    profile.Identifier,
+		UUID:          profile.UUID,
+		DisplayName:   profile.DisplayName,
+		PayloadsCount: len(profile.Content),
+	}, nil
+}
+
+// readConfigurationProfiles reads and validates the configuration profiles.
+// Every profile has to have a unique identifier.
+func readConfigurationProfiles(profilePaths []string) ([]ConfigurationProfileModel, error) {
+	profiles := []ConfigurationProfileModel{}
+	profilePathByIdentifier := map[string]string{}
+	for _, aProfilePath := range profilePaths {
+		content, err := fileutil.ReadBytesFromFile(aProfilePath)
+		if err != nil {
+			return []ConfigurationProfileModel{}, fmt.Errorf("Failed to read configuration profile (path: %s), error: %s", aProfilePath, err)
+		}
+
+		profile, err := parseConfigurationProfile(content)
+		if err != nil {
+			return []ConfigurationProfileModel{}, fmt.Errorf("Invalid configuration profile (path: %s), error: %s", aProfilePath, err)
+		}
+		profile.Path = aProfilePath
+
+		if otherPath, isExist := profilePathByIdentifier[profile.Identifier]; isExist {
+			return []ConfigurationProfileModel{}, fmt.Errorf("Configuration profiles (paths: %s, %s) have the same identifier: %s", otherPath, aProfilePath, profile.Identifier)
+		}
+		profilePathByIdentifier[profile.Identifier] = aProfilePath
+
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// stageConfigurationProfiles copies the profiles into the pkg root,
+// with the launch daemon (and its script) which installs them at first boot
+func stageConfigurationProfiles(profiles []ConfigurationProfileModel, pkgRootPath string) error {
+	profilesDirPath := filepath.Join(pkgRootPath, profilesDirPathInPkg)
+	if err := os.MkdirAll(profilesDirPath, 0700); err != nil {
+		return fmt.Errorf("Failed to create profiles dir, error: %s", err)
+	}
+
+	for _, aProfile := range profiles {
+		content, err := fileutil.ReadBytesFromFile(aProfile.Path)
+		if err != nil {
+			return fmt.Errorf("Failed to read configuration profile (path: %s), error: %s", aProfile.Path, err)
+		}
+		if err := fileutil.WriteBytesToFileWithPermission(filepath.Join(profilesDirPath, aProfile.FileName()), content, 0600); err != nil {
+			return fmt.Errorf("Failed to write configuration profile into file, error: %s", err)
+		}
+	}
+
+	scriptPathInPkg := filepath.Join(filepath.Dir(profilesDirPathInPkg), "install-profiles.sh")
+	scriptCont, err := renderInstallProfilesScriptTemplate("/"+profilesDirPathInPkg, installProfilesLaunchDaemonLabel)
+	if err != nil {
+		return fmt.Errorf("Failed to render install profiles script template, error: %s", err)
+	}
+	if err := fileutil.WriteStringToFileWithPermission(filepath.Join(pkgRootPath, scriptPathInPkg), scriptCont, 0700); err != nil {
+		return fmt.Errorf("Failed to write install profiles script into file, error: %s", err)
+	}
+
+	launchDaemonsDirPath := filepath.Join(pkgRootPath, "Library/LaunchDaemons")
+	if err := os.MkdirAll(launchDaemonsDirPath, 0755); err != nil {
+		return fmt.Errorf("Failed to create LaunchDaemons dir, error: %s", err)
+	}
+	plistCont, err := renderInstallProfilesLaunchDaemonPlistTemplate(installProfilesLaunchDaemonLabel, "/"+scriptPathInPkg)
+	if err != nil {
+		return fmt.Errorf("Failed to render launch daemon plist template, error: %s", err)
+	}
+	plistPath := filepath.Join(launchDaemonsDirPath, installProfilesLaunchDaemonLabel+".plist")
+	if err := fileutil.WriteStringToFileWithPermission(plistPath, plistCont, 0644); err != nil {
+		return fmt.Errorf("Failed to write launch daemon plist into file, error: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package macosinstaller
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testWiFiProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadIdentifier</key>
+			<string>com.example.wifi.payload</string>
+			<key>PayloadType</key>
+			<string>com.apple.wifi.managed</string>
+			<key>PayloadUUID</key>
+			<string>6A1D7F8E-3A0B-4D1E-9B7C-2E5F0C1D2A3B</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+			<key>SSID_STR</key>
+			<string>office</string>
+		</dict>
+	</array>
+	<key>PayloadDisplayName</key>
+	<string>Office Wi-Fi</string>
+	<key>PayloadIdentifier</key>
+	<string>com.example.wifi</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadUUID</key>
+	<string>0B7E1C2D-5F3A-4B6C-8D9E-1F2A3B4C5D6E</string>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+</dict>
+</plist>
+`
+
+func Test_parseConfigurationProfile(t *testing.T) {
+	t.Log("valid profile")
+	{
+		profile, err := parseConfigurationProfile([]byte(testWiFiProfile))
+		require.NoError(t, err)
+		require.Equal(t, ConfigurationProfileModel{
+			Identifier:    "com.example.wifi",
+			UUID:          "0B7E1C2D-5F3A-4B6C-8D9E-1F2A3B4C5D6E",
+			DisplayName:   "Office Wi-Fi",
+			PayloadsCount: 1,
+		}, profile)
+		require.Equal(t, "com.example.wifi.mobileconfig", profile.FileName())
+	}
+
+	t.Log("not a profile plist")
+	{
+		_, err := parseConfigurationProfile([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>ProductVersion</key>
+	<string>10.12.1</string>
+</dict>
+</plist>
+`))
+		require.Error(t, err)
+	}
+
+	t.Log("not a plist")
+	{
+		_, err := parseConfigurationProfile([]byte{0x30, 0x82, 0x01, 0x00, 0x06, 0x09})
+		require.Error(t, err)
+	}
+
+	t.Log("invalid identifier")
+	{
+		_, err := parseConfigurationProfile([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>PayloadIdentifier</key>
+	<string>../com.example.wifi</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadUUID</key>
+	<string>0B7E1C2D-5F3A-4B6C-8D9E-1F2A3B4C5D6E</string>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+</dict>
+</plist>
+`))
+		require.Error(t, err)
+	}
+
+	t.Log("payload without type")
+	{
+		_, err := parseConfigurationProfile([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadIdentifier</key>
+			<string>com.example.wifi.payload</string>
+		</dict>
+	</array>
+	<key>PayloadIdentifier</key>
+	<string>com.example.wifi</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+	<key>PayloadUUID</key>
+	<string>0B7E1C2D-5F3A-4B6C-8D9E-1F2A3B4C5D6E</string>
+	<key>PayloadVersion</key>
+	<integer>1</integer>
+</dict>
+</plist>
+`))
+		require.Error(t, err)
+	}
+}
+
+func Test_readConfigurationProfiles(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	profilePath := filepath.Join(tmpDir, "wifi.mobileconfig")
+	require.NoError(t, ioutil.WriteFile(profilePath, []byte(testWiFiProfile), 0600))
+	samePath := filepath.Join(tmpDir, "wifi-copy.mobileconfig")
+	require.NoError(t, ioutil.WriteFile(samePath, []byte(testWiFiProfile), 0600))
+
+	t.Log("valid")
+	{
+		profiles, err := readConfigurationProfiles([]string{profilePath})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(profiles))
+		require.Equal(t, profilePath, profiles[0].Path)
+		require.Equal(t, "com.example.wifi", profiles[0].Identifier)
+	}
+
+	t.Log("duplicated identifier")
+	{
+		_, err := readConfigurationProfiles([]string{profilePath, samePath})
+		require.Error(t, err)
+	}
+
+	t.Log("missing file")
+	{
+		_, err := readConfigurationProfiles([]string{filepath.Join(tmpDir, "missing.mobileconfig")})
+		require.Error(t, err)
+	}
+}
+
+func Test_stageConfigurationProfiles(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	profilePath := filepath.Join(tmpDir, "wifi.mobileconfig")
+	require.NoError(t, ioutil.WriteFile(profilePath, []byte(testWiFiProfile), 0600))
+	profiles, err := readConfigurationProfiles([]string{profilePath})
+	require.NoError(t, err)
+
+	pkgRootPath := filepath.Join(tmpDir, "pkgroot")
+	require.NoError(t, stageConfigurationProfiles(profiles, pkgRootPath))
+
+	{
+		cont, err := ioutil.ReadFile(filepath.Join(pkgRootPath, "private/var/replica/profiles/com.example.wifi.mobileconfig"))
+		require.NoError(t, err)
+		require.Equal(t, testWiFiProfile, string(cont))
+	}
+	{
+		info, err := os.Stat(filepath.Join(pkgRootPath, "private/var/replica/install-profiles.sh"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	}
+	{
+		cont, err := ioutil.ReadFile(filepath.Join(pkgRootPath, "Library/LaunchDaemons/io.bitrise.replica.install-profiles.plist"))
+		require.NoError(t, err)
+		require.Contains(t, string(cont), "<string>/private/var/replica/install-profiles.sh</string>")
+	}
+}
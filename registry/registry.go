@@ -0,0 +1,251 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/xcode"
+)
+
+// registryFileName is the name of the registry file, in the replica home directory
+const registryFileName = "vms.json"
+
+// BoxModel is the vagrant box the VM was created from
+type BoxModel struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// XcodeModel is an Xcode.app synced into the VM
+type XcodeModel struct {
+	xcode.VersionModel
+	// Path is the path of the Xcode.app in the VM
+	Path     string    `json:"path"`
+	SyncedAt time.Time `json:"synced_at"`
+	// IsSelected is true if the Xcode.app is the default one of the VM, selected with xcode-select
+	IsSelected bool `json:"selected,omitempty"`
+}
+
+// VMModel is a VM created by replica
+type VMModel struct {
+	// Name identifies the VM in the registry, by default the name of its directory
+	Name string `json:"name"`
+	// Dir is the absolute path of the directory of the VM's Vagrantfile
+	Dir string `json:"dir"`
+	// Provider is the hypervisor of the VM (see: hypervisor.ProviderNames)
+	Provider  string       `json:"provider"`
+	Box       BoxModel     `json:"box"`
+	CreatedAt time.Time    `json:"created_at"`
+	Snapshots []string     `json:"snapshots,omitempty"`
+	Xcodes    []XcodeModel `json:"xcodes,omitempty"`
+}
+
+// AddSnapshot records the snapshot of the VM
+func (vm *VMModel) AddSnapshot(name string) {
+	vm.RemoveSnapshot(name)
+	vm.Snapshots = append(vm.Snapshots, name)
+}
+
+// RemoveSnapshot removes the snapshot from the VM's records
+func (vm *VMModel) RemoveSnapshot(name string) {
+	snapshots := []string{}
+	for _, aSnapshot := range vm.Snapshots {
+		if aSnapshot != name {
+			snapshots = append(snapshots, aSnapshot)
+		}
+	}
+	vm.Snapshots = snapshots
+}
+
+// SetXcode records the Xcode.app synced into the VM, replacing the one with the same path
+func (vm *VMModel) SetXcode(xcodeApp XcodeModel) {
+	vm.RemoveXcode(xcodeApp.Path)
+	vm.Xcodes = append(vm.Xcodes, xcodeApp)
+	sort.SliceStable(vm.Xcodes, func(i, j int) bool {
+		return vm.Xcodes[i].Path < vm.Xcodes[j].Path
+	})
+}
+
+// SelectXcode records the Xcode.app of the path as the default one of the VM
+func (vm *VMModel) SelectXcode(path string) {
+	for idx := range vm.Xcodes {
+		vm.Xcodes[idx].IsSelected = vm.Xcodes[idx].Path == path
+	}
+}
+
+// RemoveXcode removes the Xcode.app of the path from the VM's records
+func (vm *VMModel) RemoveXcode(path string) {
+	xcodes := []XcodeModel{}
+	for _, anXcode := range vm.Xcodes {
+		if anXcode.Path != path {
+			xcodes = append(xcodes, anXcode)
+		}
+	}
+	vm.Xcodes = xcodes
+}
+
+// RegistryModel is the list of the VMs created by replica
+type RegistryModel struct {
+	VMs []VMModel `json:"vms"`
+}
+
+// DefaultPath returns the path of the registry: $REPLICA_HOME/vms.json, or ~/.replica/vms.json
+func DefaultPath() string {
+	home := os.Getenv("REPLICA_HOME")
+	if home == "" {
+		home = filepath.Join(pathutil.UserHomeDir(), ".replica")
+	}
+	return filepath.Join(home, registryFileName)
+}
+
+// Find returns the VM, by name or by directory
+func (registry RegistryModel) Find(nameOrDir string) (VMModel, bool) {
+	for _, aVM := range registry.VMs {
+		if aVM.Name == nameOrDir {
+			return aVM, true
+		}
+	}
+	if absDir, err := filepath.Abs(nameOrDir); err == nil {
+		for _, aVM := range registry.VMs {
+			if aVM.Dir == absDir {
+				return aVM, true
+			}
+		}
+	}
+	return VMModel{}, false
+}
+
+// uniqueName returns the name, or the name with a numeric suffix, which no other VM (with another directory) has
+func (registry RegistryModel) uniqueName(name, dir string) string {
+	isTaken := func(candidate string) bool {
+		for _, aVM := range registry.VMs {
+			if aVM.Name == candidate && aVM.Dir != dir {
+				return true
+			}
+		}
+		return false
+	}
+	candidate := name
+	for idx := 2; isTaken(candidate); idx++ {
+		candidate = fmt.Sprintf("%s-%d", name, idx)
+	}
+	return candidate
+}
+
+// Put adds the VM to the registry, or replaces the VM with the same directory.
+// The name of the VM is made unique, returns the VM as it was added.
+func (registry *RegistryModel) Put(vm VMModel) VMModel {
+	if vm.Name == "" {
+		vm.Name = filepath.Base(vm.Dir)
+	}
+	vm.Name = registry.uniqueName(vm.Name, vm.Dir)
+
+	vms := []VMModel{}
+	for _, aVM := range registry.VMs {
+		if aVM.Dir != vm.Dir {
+			vms = append(vms, aVM)
+		}
+	}
+	registry.VMs = append(vms, vm)
+	sort.SliceStable(registry.VMs, func(i, j int) bool {
+		return registry.VMs[i].Name < registry.VMs[j].Name
+	})
+	return vm
+}
+
+// Remove removes the VM of the directory from the registry, returns false if it was not registered
+func (registry *RegistryModel) Remove(dir string) bool {
+	vms := []VMModel{}
+	for _, aVM := range registry.VMs {
+		if aVM.Dir != dir {
+			vms = append(vms, aVM)
+		}
+	}
+	isRemoved := len(vms) != len(registry.VMs)
+	registry.VMs = vms
+	return isRemoved
+}
+
+// Modify calls modify with the VM of the directory, returns false if the VM is not registered
+func (registry *RegistryModel) Modify(dir string, modify func(vm *VMModel)) bool {
+	for idx := range registry.VMs {
+		if registry.VMs[idx].Dir == dir {
+			modify(&registry.VMs[idx])
+			return true
+		}
+	}
+	return false
+}
+
+// Read reads the registry, a missing registry file is an empty registry
+func Read(path string) (RegistryModel, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return RegistryModel{VMs: []VMModel{}}, nil
+	} else if err != nil {
+		return RegistryModel{}, fmt.Errorf("Failed to read the VM registry (%s), error: %s", path, err)
+	}
+
+	registry := RegistryModel{}
+	if err := json.Unmarshal(content, &registry); err != nil {
+		return RegistryModel{}, fmt.Errorf("Failed to parse the VM registry (%s), error: %s", path, err)
+	}
+	if registry.VMs == nil {
+		registry.VMs = []VMModel{}
+	}
+	return registry, nil
+}
+
+// write replaces the registry file atomically
+func write(path string, registry RegistryModel) error {
+	content, err := json.MarshalIndent(registry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Failed to serialize the VM registry, error: %s", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, content, 0600); err != nil {
+		return fmt.Errorf("Failed to write the VM registry (%s), error: %s", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("Failed to write the VM registry (%s), error: %s", path, err)
+	}
+	return nil
+}
+
+// Update reads the registry, calls update with it, then writes it back. The registry is locked meanwhile,
+// so the VMs created at the same time (e.g. by replica create fleet) can update it safely.
+func Update(path string, update func(registry *RegistryModel) error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("Failed to create the directory of the VM registry, error: %s", err)
+	}
+	lockPath := path + ".lock"
+	lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to open the lock file of the VM registry (%s), error: %s", lockPath, err)
+	}
+	defer func() {
+		if err := lockFile.Close(); err != nil {
+			log.Printf(" [!] Failed to close the lock file of the VM registry (%s), error: %s", lockPath, err)
+		}
+	}()
+	if err := syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("Failed to lock the VM registry, error: %s", err)
+	}
+
+	registry, err := Read(path)
+	if err != nil {
+		return err
+	}
+	if err := update(&registry); err != nil {
+		return err
+	}
+	return write(path, registry)
+}
@@ -0,0 +1,131 @@
+package registry
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/replica/xcode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryModel(t *testing.T) {
+	registry := RegistryModel{}
+
+	t.Log("put: the name is the directory name by default, and it's unique")
+	{
+		vm := registry.Put(VMModel{Dir: "/ci/vms/replica-01"})
+		require.Equal(t, "replica-01", vm.Name)
+
+		vm = registry.Put(VMModel{Dir: "/other/replica-01"})
+		require.Equal(t, "replica-01-2", vm.Name)
+
+		vm = registry.Put(VMModel{Name: "replica-01", Dir: "/ci/vms/replica-01", Provider: "vmware"})
+		require.Equal(t, "replica-01", vm.Name)
+		require.Equal(t, 2, len(registry.VMs))
+	}
+
+	t.Log("find by name or directory")
+	{
+		vm, isFound := registry.Find("replica-01-2")
+		require.True(t, isFound)
+		require.Equal(t, "/other/replica-01", vm.Dir)
+
+		vm, isFound = registry.Find("/ci/vms/replica-01")
+		require.True(t, isFound)
+		require.Equal(t, "vmware", vm.Provider)
+
+		_, isFound = registry.Find("replica-02")
+		require.False(t, isFound)
+	}
+
+	t.Log("modify")
+	{
+		require.True(t, registry.Modify("/ci/vms/replica-01", func(vm *VMModel) {
+			vm.AddSnapshot("bitrise-replica-initial")
+			vm.AddSnapshot("replica-20170102-150405")
+			vm.AddSnapshot("bitrise-replica-initial")
+			vm.RemoveSnapshot("replica-20170102-150405")
+			vm.SetXcode(XcodeModel{VersionModel: xcode.VersionModel{Version: "8.3.2"}, Path: "/Applications/Xcode.app"})
+			vm.SetXcode(XcodeModel{VersionModel: xcode.VersionModel{Version: "8.3.3"}, Path: "/Applications/Xcode.app"})
+			vm.SetXcode(XcodeModel{VersionModel: xcode.VersionModel{Version: "8.2.1"}, Path: "/Applications/Xcode-8.2.1.app"})
+			vm.SelectXcode("/Applications/Xcode-8.2.1.app")
+		}))
+		vm, _ := registry.Find("replica-01")
+		require.Equal(t, []string{"bitrise-replica-initial"}, vm.Snapshots)
+		require.Equal(t, []XcodeModel{
+			{VersionModel: xcode.VersionModel{Version: "8.2.1"}, Path: "/Applications/Xcode-8.2.1.app", IsSelected: true},
+			{VersionModel: xcode.VersionModel{Version: "8.3.3"}, Path: "/Applications/Xcode.app"},
+		}, vm.Xcodes)
+
+		require.False(t, registry.Modify("/missing", func(vm *VMModel) {}))
+	}
+
+	t.Log("remove")
+	{
+		require.True(t, registry.Remove("/other/replica-01"))
+		require.False(t, registry.Remove("/other/replica-01"))
+		require.Equal(t, 1, len(registry.VMs))
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+	pth := filepath.Join(tmpDir, ".replica", "vms.json")
+
+	t.Log("missing registry")
+	{
+		registry, err := Read(pth)
+		require.NoError(t, err)
+		require.Equal(t, RegistryModel{VMs: []VMModel{}}, registry)
+	}
+
+	t.Log("parallel updates")
+	{
+		createdAt := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+		var waitGroup sync.WaitGroup
+		for idx := 1; idx <= 5; idx++ {
+			waitGroup.Add(1)
+			go func(idx int) {
+				defer waitGroup.Done()
+				require.NoError(t, Update(pth, func(registry *RegistryModel) error {
+					registry.Put(VMModel{Dir: fmt.Sprintf("/vms/replica-%02d", idx), CreatedAt: createdAt})
+					return nil
+				}))
+			}(idx)
+		}
+		waitGroup.Wait()
+
+		registry, err := Read(pth)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(registry.VMs))
+		require.Equal(t, "replica-01", registry.VMs[0].Name)
+		require.Equal(t, createdAt, registry.VMs[0].CreatedAt)
+	}
+
+	t.Log("failed update is not written")
+	{
+		require.Error(t, Update(pth, func(registry *RegistryModel) error {
+			registry.VMs = []VMModel{}
+			return fmt.Errorf("failed")
+		}))
+		registry, err := Read(pth)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(registry.VMs))
+	}
+
+	t.Log("invalid registry")
+	{
+		require.NoError(t, ioutil.WriteFile(pth, []byte("not json"), 0600))
+		_, err := Read(pth)
+		require.Error(t, err)
+	}
+}
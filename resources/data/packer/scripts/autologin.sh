@@ -1,8 +1,17 @@
 #!/bin/bash
 set -ex
 
-echo "Enabling automatic GUI login for the '$USERNAME' user.."
-
-python /private/tmp/set_kcpassword.py "$PASSWORD"
-
-/usr/bin/defaults write /Library/Preferences/com.apple.loginwindow autoLoginUser "$USERNAME"
+# The automatic GUI login is configured by the firstboot package (replica create dmg):
+# the obfuscated password is in /etc/kcpassword, and the autoLoginUser preference is set.
+if [ "$AUTOLOGIN" == "true" ]; then
+    echo "Automatic GUI login is enabled for the '$USERNAME' user"
+    if [ ! -f /etc/kcpassword ]; then
+        echo "/etc/kcpassword does not exist"
+        exit 1
+    fi
+    /usr/bin/defaults write /Library/Preferences/com.apple.loginwindow autoLoginUser "$USERNAME"
+else
+    echo "Disabling automatic GUI login.."
+    rm -f /etc/kcpassword
+    /usr/bin/defaults delete /Library/Preferences/com.apple.loginwindow autoLoginUser || true
+fi
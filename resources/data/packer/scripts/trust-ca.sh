@@ -0,0 +1,18 @@
+#!/bin/sh
+set -ex
+
+# The root CA certificates uploaded by replica (replica create box --trust-ca)
+TRUSTED_CA_DIR=/private/tmp/trusted-ca
+
+for CERT in "$TRUSTED_CA_DIR"/*.pem; do
+    [ -f "$CERT" ] || continue
+    echo "Adding trusted root CA certificate: $CERT"
+    # System keychain: Security framework based tools (Xcode, system curl & git)
+    security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain "$CERT"
+    # OpenSSL / LibreSSL based tools read the system certificate bundle
+    if [ -f /private/etc/ssl/cert.pem ]; then
+        cat "$CERT" >> /private/etc/ssl/cert.pem
+    fi
+done
+
+rm -rf "$TRUSTED_CA_DIR"
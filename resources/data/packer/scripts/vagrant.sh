@@ -7,10 +7,14 @@ COMPNAME=osx-10_${OSX_VERS}
 scutil --set ComputerName ${COMPNAME}
 scutil --set HostName ${COMPNAME}.vagrantup.com
 
-echo "Installing vagrant keys for $USERNAME user"
-mkdir "/Users/$USERNAME/.ssh"
+# The authorized keys are installed by the firstboot package (replica create dmg),
+# no network access is required to set them up
+echo "Checking the authorized keys of the $USERNAME user"
+if [ ! -s "/Users/$USERNAME/.ssh/authorized_keys" ]; then
+    echo "No authorized keys found for the $USERNAME user"
+    exit 1
+fi
 chmod 700 "/Users/$USERNAME/.ssh"
-curl -L 'https://raw.githubusercontent.com/mitchellh/vagrant/master/keys/vagrant.pub' > "/Users/$USERNAME/.ssh/authorized_keys"
 chmod 600 "/Users/$USERNAME/.ssh/authorized_keys"
 chown -R "$USERNAME" "/Users/$USERNAME/.ssh"
 
@@ -1,13 +1,60 @@
 #!/bin/sh
 set -ex
 
-# Get and install Xcode CLI tools
-# on 10.9+, we can leverage SUS to get the latest CLI tools
-# create the placeholder file that's checked by CLI updates' .dist code
-# in Apple's SUS catalog
-touch /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress
-# find the CLI Tools update
-PROD=$(softwareupdate -l | grep "\*.*Command Line" | head -n 1 | awk -F"*" '{print $2}' | sed -e 's/^ *//' | tr -d '\n')
-# install it
-softwareupdate -i "$PROD" --verbose
-rm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress
+# The Xcode CLI tools package uploaded by replica (replica create box --clt-package)
+CLT_PACKAGE_DIR=/private/tmp/clt
+
+if [ -n "$CLT_PACKAGE" ]; then
+    # the package was requested, don't fall back to softwareupdate if it was not uploaded
+    if [ ! -f "$CLT_PACKAGE_DIR/$CLT_PACKAGE" ]; then
+        echo "Xcode CLI tools package not found: $CLT_PACKAGE_DIR/$CLT_PACKAGE"
+        exit 1
+    fi
+    echo "Installing Xcode CLI tools from the local package: $CLT_PACKAGE"
+    case "$CLT_PACKAGE" in
+        *.dmg)
+            MNT_CLT=$(mktemp -d /tmp/clt-mnt.XXXX)
+            hdiutil attach "$CLT_PACKAGE_DIR/$CLT_PACKAGE" -mountpoint "$MNT_CLT" -nobrowse
+            PKG=$(find "$MNT_CLT" -maxdepth 1 \( -name "*.pkg" -o -name "*.mpkg" \) | head -n 1)
+            if [ -z "$PKG" ]; then
+                echo "No installer package found in the DMG: $CLT_PACKAGE"
+                hdiutil detach "$MNT_CLT"
+                exit 1
+            fi
+            installer -pkg "$PKG" -target /
+            hdiutil detach "$MNT_CLT"
+            ;;
+        *.pkg)
+            installer -pkg "$CLT_PACKAGE_DIR/$CLT_PACKAGE" -target /
+            ;;
+        *)
+            echo "Unsupported Xcode CLI tools package: $CLT_PACKAGE"
+            exit 1
+            ;;
+    esac
+    rm -rf "$CLT_PACKAGE_DIR"
+else
+    # Get and install Xcode CLI tools
+    # on 10.9+, we can leverage SUS to get the latest CLI tools
+    # create the placeholder file that's checked by CLI updates' .dist code
+    # in Apple's SUS catalog
+    touch /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress
+    # find the CLI Tools update
+    PROD=$(softwareupdate -l | grep "\*.*Command Line" | head -n 1 | awk -F"*" '{print $2}' | sed -e 's/^ *//' | tr -d '\n')
+    if [ -z "$PROD" ]; then
+        echo "Xcode CLI tools not found in the softwareupdate listing"
+        rm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress
+        exit 1
+    fi
+    # install it
+    softwareupdate -i "$PROD" --verbose
+    rm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress
+fi
+
+# Verify the installation
+CLT_PATH=$(xcode-select -p)
+if [ ! -d "$CLT_PATH" ]; then
+    echo "Xcode CLI tools path ($CLT_PATH) does not exist"
+    exit 1
+fi
+echo "Xcode CLI tools installed at: $CLT_PATH"
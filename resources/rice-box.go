@@ -8,147 +8,112 @@ import (
 func init() {
 
 	// define files
-	file2 := &embedded.EmbeddedFile{
-		Filename:    `.DS_Store`,
-		FileModTime: time.Unix(1477395835, 0),
-		Content:     string("\x00\x00\x00\x01Bud1\x00\x00\x18\x00\x00\x00\b\x00\x00\x00\x18\x00\x00\x00\x10\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\b\x00\x00\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\t\x00\x00\x00\x01\x00\x00\x10\x00\x00e\x00rbwspblob\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\b\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00 \x00\x00\x00\x01\x00\x00\x00@\x00\x00\x00\x01\x00\x00\x00\x80\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x10\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\t\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rbwspblob\x00\x00\x00\xc8bplist00\xd7\x01\x02\x03\x04\x05\x06\a\b\b\b\v\b\r\b]ShowStatusBar[ShowSidebar[ShowToolbar[ShowTabView_\x10\x14ContainerShowSidebar\\WindowBounds[ShowPathbar\t\t\t\b\t_\x10\x17{{219, 86}, {906, 629}}\t\b\x17%1=I`myz{|}~\x98\x00\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x00\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x99\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rdsclbool\x01\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rlg1Scomp\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rlsvCblob\x00\x00\x02\x97bplist00\xd8\x01\x02\x03\x04\x05\x06\a\b\t\t\v\x1bIJKL_\x10\x10useRelativeDates_\x10\x0fshowIconPreviewWcolumns_\x10\x11calculateAllSizesXtextSizeZsortColumnXiconSize_\x10\x12viewOptionsVersion\t\t\xab\f\x15\x1d\"&+05:?D\xd4\r\x0e\x0f\x10\t\x12\t\x14WvisibleUwidthYascendingZidentifier\t\x11\x01|\tTname\xd4\x10\x16\x17\x18\x19\x1a\x1b\x1bUwidthYascendingWvisibleXubiquity\x10#\b\b\xd4\x10\x0e\x0f\r\x1e\x1f\x1b\t\\dateModified\x10\xb5\b\t\xd4\x10\x0e\x0f\r#\x1f\x1b\x1b[dateCreated\b\b\xd4\x10\x0e\x0f\r'(\x1b\tTsize\x10a\b\t\xd4\x10\x0e\x0f\r,-\t\tTkind\x10s\t\t\xd4\x10\x0e\x0f\r12\t\x1bUlabel\x10d\t\b\xd4\x10\x0e\x0f\r67\t\x1bWversion\x10K\t\b\xd4\x10\x0e\x0f\r;<\t\x1bXcomments\x11\x01,\t\b\xd4\x10\x0e\x0f\r@A\x1b\x1b^dateLastOpened\x10\xc8\b\b\xd4\x10\x16\x17\x18E\x1f\x1b\x1bYdateAdded\b\b\b#@(\x00\x00\x00\x00\x00\x00Tname#@0\x00\x00\x00\x00\x00\x00\x10\x01\x00\b\x00\x19\x00,\x00>\x00F\x00Z\x00c\x00n\x00w\x00\x8c\x00\x8d\x00\x8e\x00\x9a\x00\xa3\x00\xab\x00\xb1\x00\xbb\x00\xc6\x00\xc7\x00\xca\x00\xcb\x00\xd0\x00\xd9\x00\xdf\x00\xe9\x00\xf1\x00\xfa\x00\xfc\x00\xfd\x00\xfe\x01\a\x01\x14\x01\x16\x01\x17\x01\x18\x01!\x01-\x01.\x01/\x018\x01=\x01?\x01@\x01A\x01J\x01O\x01Q\x01R\x01S\x01\\\x01b\x01d\x01e\x01f\x01o\x01w\x01y\x01z\x01{\x01\x84\x01\x8d\x01\x90\x01\x91\x01\x92\x01\x9b\x01\xaa\x01\xac\x01\xad\x01\xae\x01\xb7\x01\xc1\x01\xc2\x01\xc3\x01\xc4\x01\xcd\x01\xd2\x01\xdb\x00\x00\x00\x00\x00\x00\x02\x01\x00\x00\x00\x00\x00\x00\x00M\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\xdd\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rlsvpblob\x00\x00\x02^bplist00\xd8\x01\x02\x03\x04\x05\x06\a\b\t\t\v\x1aFGHD_\x10\x10useRelativeDates_\x10\x0fshowIconPreviewWcolumns_\x10\x11calculateAllSizesXtextSizeZsortColumnXiconSize_\x10\x12viewOptionsVersion\t\t\xd9\f\r\x0e\x0f\x10\x11\x12\x13\x14\x15\x1e#(-27<AXcomments^dateLastOpened[dateCreatedTsizeUlabelTkindWversionTname\\dateModified\xd4\x16\x17\x18\x19\x1a\x1b\t\x1dWvisibleUwidthYascendingUindex\b\x11\x01,\t\x10\a\xd4\x16\x17\x18\x19\x1a \x1a\"\b\x10\xc8\b\x10\b\xd4\x16\x17\x18\x19\x1a%\x1a'\b\x10\xb5\b\x10\x02\xd4\x16\x17\x18\x19\t*\x1a,\t\x10a\b\x10\x03\xd4\x16\x17\x18\x19\x1a/\t1\b\x10d\t\x10\x05\xd4\x16\x17\x18\x19\t4\t6\t\x10s\t\x10\x04\xd4\x16\x17\x18\x19\x1a9\t;\b\x10K\t\x10\x06\xd4\x19\x17\x18\x16=>\t\t\x10\x00\x11\x01|\t\t\xd4\x16\x17\x18\x19\t%\x1aD\t\b\x10\x01\b#@(\x00\x00\x00\x00\x00\x00Tname#@0\x00\x00\x00\x00\x00\x00\x00\b\x00\x19\x00,\x00>\x00F\x00Z\x00c\x00n\x00w\x00\x8c\x00\x8d\x00\x8e\x00\xa1\x00\xaa\x00\xb9\x00\xc5\x00\xca\x00\xd0\x00\xd5\x00\xdd\x00\xe2\x00\xef\x00\xf8\x01\x00\x01\x06\x01\x10\x01\x16\x01\x17\x01\x1a\x01\x1b\x01\x1d\x01&\x01'\x01)\x01*\x01,\x015\x016\x018\x019\x01;\x01D\x01E\x01G\x01H\x01J\x01S\x01T\x01V\x01W\x01Y\x01b\x01c\x01e\x01f\x01h\x01q\x01r\x01t\x01u\x01w\x01\x80\x01\x82\x01\x85\x01\x86\x01\x87\x01\x90\x01\x91\x01\x92\x01\x94\x01\x95\x01\x9e\x01\xa3\x00\x00\x00\x00\x00\x00\x02\x01\x00\x00\x00\x00\x00\x00\x00I\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\xac\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rmoDDdutc\x00\x00\xd44\xf5\x92\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rmodDdutc\x00\x00\xd44\xf5\x92\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rph1Scomp\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rvSrnlong\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x18\v\x00\x00\x00E\x00\x00\x10\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x04DSDB\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00 \x00\x00\x00`\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x80\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x01\x00\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00hYascendingUindex\b\x11\x01,\t\x10\a\xd4\x16\x17\x18\x19\x1a \x1a\"\b\x10\xc8\b\x10\b\xd4\x16\x17\x18\x19\x1a%\x1a'\b\x10\xb5\b\x10\x02\xd4\x16\x17\x18\x19\t*\x1a,\t\x10a\b\x10\x03\xd4\x16\x17\x18\x19\x1a/\t1\b\x10d\t\x10\x05\xd4\x16\x17\x18\x19\t4\t6\t\x10s\t\x10\x04\xd4\x16\x17\x18\x19\x1a9\t;\b\x10K\t\x10\x06\xd4\x19\x17\x18\x16=>\t\t\x10\x00\x11\x01|\t\t\xd4\x16\x17\x18\x19\t%\x1aD\t\b\x10\x01\b#@(\x00\x00\x00\x00\x00\x00Tname#@0\x00\x00\x00\x00\x00\x00\x00\b\x00\x19\x00,\x00>\x00F\x00Z\x00c\x00n\x00w\x00\x8c\x00\x8d\x00\x8e\x00\xa1\x00\xaa\x00\xb9\x00\xc5\x00\xca\x00\xd0\x00\xd5\x00\xdd\x00\xe2\x00\xef\x00\xf8\x01\x00\x01\x06\x01\x10\x01\x16\x01\x17\x01\x1a\x01\x1b\x01\x1d\x01&\x01'\x01)\x01*\x01,\x015\x016\x018\x019\x01;\x01D\x01E\x01G\x01H\x01J\x01S\x01T\x01V\x01W\x01Y\x01b\x01c\x01e\x01f\x01h\x01q\x01r\x01t\x01u\x01w\x01\x80\x01\x82\x01\x85\x01\x86\x01\x87\x01\x90\x01\x91\x01\x92\x01\x94\x01\x95\x01\x9e\x01\xa3\x00\x00\x00\x00\x00\x00\x02\x01\x00\x00\x00\x00\x00\x00\x00I\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\xac\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rmoDDdutc\x00\x00\xd44\xf5\x92\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rmodDdutc\x00\x00\xd44\xf5\x92\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rph1Scomp\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00p\x00a\x00c\x00k\x00e\x00rvSrnlong\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
-	}
 	file4 := &embedded.EmbeddedFile{
-		Filename:    `packer/.DS_Store`,
-		FileModTime: time.Unix(1479245620, 0),
-		Content:     string("\x00\x00\x00\x01Bud1\x00\x00\x10\x00\x00\x00\b\x00\x00\x00\x10\x00\x00\x00\x00\x87\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\b\x00\x00\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x01\x00\x00\x10\x00\x00e\x00r\x00_\x00c\x00a\x00c\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\f\x00p\x00a\x00c\x00k\x00e\x00r\x00_\x00c\x00a\x00c\x00h\x00edsclbool\x00\x00\x00\x00\a\x00s\x00c\x00r\x00i\x00p\x00t\x00sdsclbool\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\b\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00 \x00\x00\x00\x01\x00\x00\x00@\x00\x00\x00\x01\x00\x00\x00\x80\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x10\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x10\v\x00\x00\x00E\x00\x00\x00\x87\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x04DSDB\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00 \x00\x00\x00`\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x02\x00\x00\b\x00\x00\x00\x18\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
-	}
-	file6 := &embedded.EmbeddedFile{
-		Filename:    `packer/scripts/.DS_Store`,
-		FileModTime: time.Unix(1477396173, 0),
-		Content:     string("\x00\x00\x00\x01Bud1\x00\x00\x10\x00\x00\x00\b\x00\x00\x00\x10\x00\x00\x00\x00\x86\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\b\x00\x00\x00\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x10\x00\x00o\x00r\x00tdsclbo\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\a\x00s\x00u\x00p\x00p\x00o\x00r\x00tdsclbool\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\b\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00 \x00\x00\x00\x01\x00\x00\x00@\x00\x00\x00\x01\x00\x00\x00\x80\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x10\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x10\v\x00\x00\x00E\x00\x00\x00\x86\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x04DSDB\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00 \x00\x00\x00`\x00\x00\x00\x01\x00\x00\x00\xc0\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x00\x00\x00\x00\x01\x00\x00\x02\x00\x00\x00\x00\x01\x00\x00\x04\x00\x00\x00\x00\x02\x00\x00\b\x00\x00\x00\x18\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00 \x00\x00\x00\x00\x01\x00\x00@\x00\x00\x00\x00\x01\x00\x00\x80\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x00\x02\x00\x00\x00\x00\x00\x01\x00\x04\x00\x00\x00\x00\x00\x01\x00\b\x00\x00\x00\x00\x00\x01\x00\x10\x00\x00\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x01\x00@\x00\x00\x00\x00\x00\x01\x00\x80\x00\x00\x00\x00\x00\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x00\x00\x00\x00\x00\x00\x01\x04\x00\x00\x00\x00\x00\x00\x01\b\x00\x00\x00\x00\x00\x00\x01\x10\x00\x00\x00\x00\x00\x00\x01 \x00\x00\x00\x00\x00\x00\x01@\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
-	}
-	file7 := &embedded.EmbeddedFile{
 		Filename:    `packer/scripts/add-network-interface-detection.sh`,
 		FileModTime: time.Unix(1479257723, 0),
 		Content:     string("#!/bin/sh\nset -ex\n\n# This script adds a Mac OS Launch Daemon, which runs every time the\n# machine is booted. The daemon will re-detect the attached network\n# interfaces. If this is not done, network devices may not work.\nPLIST=/Library/LaunchDaemons/com.github.timsutton.osx-vm-templates.detectnewhardware.plist\ncat <<EOF > \"${PLIST}\"\n<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<!DOCTYPE plist PUBLIC \"-//Apple//DTD PLIST 1.0//EN\" \"http://www.apple.com/DTDs/PropertyList-1.0.dtd\">\n<plist version=\"1.0\">\n<dict>\n    <key>Label</key>\n    <string>com.github.timsutton.osx-vm-templates.detectnewhardware</string>\n    <key>ProgramArguments</key>\n    <array>\n        <string>/usr/sbin/networksetup</string>\n        <string>-detectnewhardware</string>\n    </array>\n    <key>RunAtLoad</key>\n    <true/>\n</dict>\n</plist>\nEOF\n\n# These should be already set as follows, but since they're required\n# in order to load properly, we set them explicitly.\n/bin/chmod 644 \"${PLIST}\"\n/usr/sbin/chown root:wheel \"${PLIST}\"\n"),
 	}
-	file8 := &embedded.EmbeddedFile{
+	file5 := &embedded.EmbeddedFile{
 		Filename:    `packer/scripts/autologin.sh`,
-		FileModTime: time.Unix(1479257723, 0),
-		Content:     string("#!/bin/bash\nset -ex\n\necho \"Enabling automatic GUI login for the '$USERNAME' user..\"\n\npython /private/tmp/set_kcpassword.py \"$PASSWORD\"\n\n/usr/bin/defaults write /Library/Preferences/com.apple.loginwindow autoLoginUser \"$USERNAME\"\n"),
+		FileModTime: time.Unix(1792422203, 0),
+		Content:     string("#!/bin/bash\nset -ex\n\n# The automatic GUI login is configured by the firstboot package (replica create dmg):\n# the obfuscated password is in /etc/kcpassword, and the autoLoginUser preference is set.\nif [ \"$AUTOLOGIN\" == \"true\" ]; then\n    echo \"Automatic GUI login is enabled for the '$USERNAME' user\"\n    if [ ! -f /etc/kcpassword ]; then\n        echo \"/etc/kcpassword does not exist\"\n        exit 1\n    fi\n    /usr/bin/defaults write /Library/Preferences/com.apple.loginwindow autoLoginUser \"$USERNAME\"\nelse\n    echo \"Disabling automatic GUI login..\"\n    rm -f /etc/kcpassword\n    /usr/bin/defaults delete /Library/Preferences/com.apple.loginwindow autoLoginUser || true\nfi\n"),
 	}
-	file9 := &embedded.EmbeddedFile{
+	file6 := &embedded.EmbeddedFile{
 		Filename:    `packer/scripts/shrink.sh`,
 		FileModTime: time.Unix(1479257723, 0),
 		Content:     string("#!/bin/bash\nset -ex\n\nOSX_VERS=$(sw_vers -productVersion | awk -F \".\" '{print $2}')\n\n# Turn off hibernation and get rid of the sleepimage\npmset hibernatemode 0\nrm -f /var/vm/sleepimage\n\n# # Stop the pager process and drop swap files. These will be re-created on boot.\n# # Starting with El Cap we can only stop the dynamic pager if SIP is disabled.\n# if [ \"$OSX_VERS\" -lt 11 ] || $(csrutil status | grep -q disabled); then\n#     launchctl unload /System/Library/LaunchDaemons/com.apple.dynamic_pager.plist\n#     sleep 5\n# fi\nrm -rf /private/var/vm/swap*\n"),
 	}
-	fileb := &embedded.EmbeddedFile{
-		Filename:    `packer/scripts/support/set_kcpassword.py`,
-		FileModTime: time.Unix(1479257723, 0),
-		Content:     string("#!/usr/bin/env python\n\n# Port of Gavin Brock's Perl kcpassword generator to Python, by Tom Taylor\n# <tom@tomtaylor.co.uk>.\n# Perl version: http://www.brock-family.org/gavin/perl/kcpassword.html\n\nimport sys\nimport os\n\ndef kcpassword(passwd):\n    # The magic 11 bytes - these are just repeated\n    # 0x7D 0x89 0x52 0x23 0xD2 0xBC 0xDD 0xEA 0xA3 0xB9 0x1F\n    key = [125,137,82,35,210,188,221,234,163,185,31]\n    key_len = len(key)\n\n    passwd = [ord(x) for x in list(passwd)]\n    # pad passwd length out to an even multiple of key length\n    r = len(passwd) % key_len\n    if (r > 0):\n        passwd = passwd + [0] * (key_len - r)\n\n    for n in range(0, len(passwd), len(key)):\n        ki = 0\n        for j in range(n, min(n+len(key), len(passwd))):\n            passwd[j] = passwd[j] ^ key[ki]\n            ki += 1\n\n    passwd = [chr(x) for x in passwd]\n    return \"\".join(passwd)\n\nif __name__ == \"__main__\":\n    passwd = kcpassword(sys.argv[1])\n    fd = os.open('/etc/kcpassword', os.O_WRONLY | os.O_CREAT, 0o600)\n    file = os.fdopen(fd, 'w')\n    file.write(passwd)\n    file.close()\n"),
+	file7 := &embedded.EmbeddedFile{
+		Filename:    `packer/scripts/trust-ca.sh`,
+		FileModTime: time.Unix(1792422330, 0),
+		Content:     string("#!/bin/sh\nset -ex\n\n# The root CA certificates uploaded by replica (replica create box --trust-ca)\nTRUSTED_CA_DIR=/private/tmp/trusted-ca\n\nfor CERT in \"$TRUSTED_CA_DIR\"/*.pem; do\n    [ -f \"$CERT\" ] || continue\n    echo \"Adding trusted root CA certificate: $CERT\"\n    # System keychain: Security framework based tools (Xcode, system curl & git)\n    security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain \"$CERT\"\n    # OpenSSL / LibreSSL based tools read the system certificate bundle\n    if [ -f /private/etc/ssl/cert.pem ]; then\n        cat \"$CERT\" >> /private/etc/ssl/cert.pem\n    fi\ndone\n\nrm -rf \"$TRUSTED_CA_DIR\"\n"),
 	}
-	filec := &embedded.EmbeddedFile{
+	file8 := &embedded.EmbeddedFile{
 		Filename:    `packer/scripts/vagrant.sh`,
-		FileModTime: time.Unix(1479257723, 0),
-		Content:     string("#!/bin/sh\nset -ex\nOSX_VERS=$(sw_vers -productVersion | awk -F \".\" '{print $2}')\n\n# Set computer/hostname\nCOMPNAME=osx-10_${OSX_VERS}\nscutil --set ComputerName ${COMPNAME}\nscutil --set HostName ${COMPNAME}.vagrantup.com\n\necho \"Installing vagrant keys for $USERNAME user\"\nmkdir \"/Users/$USERNAME/.ssh\"\nchmod 700 \"/Users/$USERNAME/.ssh\"\ncurl -L 'https://raw.githubusercontent.com/mitchellh/vagrant/master/keys/vagrant.pub' > \"/Users/$USERNAME/.ssh/authorized_keys\"\nchmod 600 \"/Users/$USERNAME/.ssh/authorized_keys\"\nchown -R \"$USERNAME\" \"/Users/$USERNAME/.ssh\"\n\n# Create a group and assign the user to it\ndseditgroup -o create \"$USERNAME\"\ndseditgroup -o edit -a \"$USERNAME\" \"$USERNAME\"\n"),
+		FileModTime: time.Unix(1792422112, 0),
+		Content:     string("#!/bin/sh\nset -ex\nOSX_VERS=$(sw_vers -productVersion | awk -F \".\" '{print $2}')\n\n# Set computer/hostname\nCOMPNAME=osx-10_${OSX_VERS}\nscutil --set ComputerName ${COMPNAME}\nscutil --set HostName ${COMPNAME}.vagrantup.com\n\n# The authorized keys are installed by the firstboot package (replica create dmg),\n# no network access is required to set them up\necho \"Checking the authorized keys of the $USERNAME user\"\nif [ ! -s \"/Users/$USERNAME/.ssh/authorized_keys\" ]; then\n    echo \"No authorized keys found for the $USERNAME user\"\n    exit 1\nfi\nchmod 700 \"/Users/$USERNAME/.ssh\"\nchmod 600 \"/Users/$USERNAME/.ssh/authorized_keys\"\nchown -R \"$USERNAME\" \"/Users/$USERNAME/.ssh\"\n\n# Create a group and assign the user to it\ndseditgroup -o create \"$USERNAME\"\ndseditgroup -o edit -a \"$USERNAME\" \"$USERNAME\"\n"),
 	}
-	filed := &embedded.EmbeddedFile{
+	file9 := &embedded.EmbeddedFile{
 		Filename:    `packer/scripts/xcode-cli-tools.sh`,
-		FileModTime: time.Unix(1479257723, 0),
-		Content:     string("#!/bin/sh\nset -ex\n\n# Get and install Xcode CLI tools\n# on 10.9+, we can leverage SUS to get the latest CLI tools\n# create the placeholder file that's checked by CLI updates' .dist code\n# in Apple's SUS catalog\ntouch /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress\n# find the CLI Tools update\nPROD=$(softwareupdate -l | grep \"\\*.*Command Line\" | head -n 1 | awk -F\"*\" '{print $2}' | sed -e 's/^ *//' | tr -d '\\n')\n# install it\nsoftwareupdate -i \"$PROD\" --verbose\nrm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress\n"),
+		FileModTime: time.Unix(1792427186, 0),
+		Content:     string("#!/bin/sh\nset -ex\n\n# The Xcode CLI tools package uploaded by replica (replica create box --clt-package)\nCLT_PACKAGE_DIR=/private/tmp/clt\n\nif [ -n \"$CLT_PACKAGE\" ]; then\n    # the package was requested, don't fall back to softwareupdate if it was not uploaded\n    if [ ! -f \"$CLT_PACKAGE_DIR/$CLT_PACKAGE\" ]; then\n        echo \"Xcode CLI tools package not found: $CLT_PACKAGE_DIR/$CLT_PACKAGE\"\n        exit 1\n    fi\n    echo \"Installing Xcode CLI tools from the local package: $CLT_PACKAGE\"\n    case \"$CLT_PACKAGE\" in\n        *.dmg)\n            MNT_CLT=$(mktemp -d /tmp/clt-mnt.XXXX)\n            hdiutil attach \"$CLT_PACKAGE_DIR/$CLT_PACKAGE\" -mountpoint \"$MNT_CLT\" -nobrowse\n            PKG=$(find \"$MNT_CLT\" -maxdepth 1 \\( -name \"*.pkg\" -o -name \"*.mpkg\" \\) | head -n 1)\n            if [ -z \"$PKG\" ]; then\n                echo \"No installer package found in the DMG: $CLT_PACKAGE\"\n                hdiutil detach \"$MNT_CLT\"\n                exit 1\n            fi\n            installer -pkg \"$PKG\" -target /\n            hdiutil detach \"$MNT_CLT\"\n            ;;\n        *.pkg)\n            installer -pkg \"$CLT_PACKAGE_DIR/$CLT_PACKAGE\" -target /\n            ;;\n        *)\n            echo \"Unsupported Xcode CLI tools package: $CLT_PACKAGE\"\n            exit 1\n            ;;\n    esac\n    rm -rf \"$CLT_PACKAGE_DIR\"\nelse\n    # Get and install Xcode CLI tools\n    # on 10.9+, we can leverage SUS to get the latest CLI tools\n    # create the placeholder file that's checked by CLI updates' .dist code\n    # in Apple's SUS catalog\n    touch /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress\n    # find the CLI Tools update\n    PROD=$(softwareupdate -l | grep \"\\*.*Command Line\" | head -n 1 | awk -F\"*\" '{print $2}' | sed -e 's/^ *//' | tr -d '\\n')\n    if [ -z \"$PROD\" ]; then\n        echo \"Xcode CLI tools not found in the softwareupdate listing\"\n        rm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress\n        exit 1\n    fi\n    # install it\n    softwareupdate -i \"$PROD\" --verbose\n    rm /tmp/.com.apple.dt.CommandLineTools.installondemand.in-progress\nfi\n\n# Verify the installation\nCLT_PATH=$(xcode-select -p)\nif [ ! -d \"$CLT_PATH\" ]; then\n    echo \"Xcode CLI tools path ($CLT_PATH) does not exist\"\n    exit 1\nfi\necho \"Xcode CLI tools installed at: $CLT_PATH\"\n"),
 	}
-	filee := &embedded.EmbeddedFile{
-		Filename:    `packer/template.json`,
-		FileModTime: time.Unix(1479257723, 0),
-		Content:     string("{\n  \"builders\": [\n    {\n      \"boot_wait\": \"2s\",\n      \"disk_size\": 40960,\n      \"guest_additions_mode\": \"disable\",\n      \"guest_os_type\": \"MacOS1011_64\",\n      \"hard_drive_interface\": \"sata\",\n      \"iso_checksum_type\": \"none\",\n      \"iso_interface\": \"sata\",\n      \"iso_url\": \"{{user `iso_url`}}\",\n      \"shutdown_command\": \"echo '{{user `username`}}'|sudo -S shutdown -h now\",\n      \"ssh_port\": 22,\n      \"ssh_username\": \"{{user `username`}}\",\n      \"ssh_password\": \"{{user `password`}}\",\n      \"ssh_wait_timeout\": \"10000s\",\n      \"type\": \"virtualbox-iso\",\n      \"vboxmanage\": [\n        [\"modifyvm\", \"{{.Name}}\", \"--audiocontroller\", \"hda\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--boot1\", \"dvd\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--boot2\", \"disk\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--chipset\", \"ich9\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--firmware\", \"efi\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--hpet\", \"on\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--keyboard\", \"usb\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--memory\", \"2048\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--mouse\", \"usbtablet\"],\n        [\"modifyvm\", \"{{.Name}}\", \"--vram\", \"128\"],\n        [\"storagectl\", \"{{.Name}}\", \"--name\", \"IDE Controller\", \"--remove\"]\n      ]\n    }\n  ],\n  \"min_packer_version\": \"0.7.0\",\n  \"post-processors\": [\n    \"vagrant\"\n  ],\n  \"provisioners\": [\n    {\n      \"type\": \"shell-local\",\n      \"command\": \"sleep {{user `provisioning_delay`}}\"\n    },\n    {\n      \"destination\": \"/private/tmp/set_kcpassword.py\",\n      \"source\": \"./scripts/support/set_kcpassword.py\",\n      \"type\": \"file\"\n    },\n    {\n      \"execute_command\": \"chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}\",\n      \"scripts\": [\n        \"./scripts/vagrant.sh\",\n        \"./scripts/xcode-cli-tools.sh\",\n        \"./scripts/add-network-interface-detection.sh\",\n        \"./scripts/autologin.sh\",\n        \"./scripts/shrink.sh\"\n      ],\n      \"environment_vars\": [\n        \"AUTOLOGIN={{user `autologin`}}\",\n        \"INSTALL_VAGRANT_KEYS={{user `install_vagrant_keys`}}\",\n        \"NOCM={{user `nocm`}}\",\n        \"INSTALL_XCODE_CLI_TOOLS={{user `install_xcode_cli_tools`}}\",\n        \"PASSWORD={{user `password`}}\",\n        \"USERNAME={{user `username`}}\"\n      ],\n      \"type\": \"shell\"\n    }\n  ],\n  \"variables\": {\n    \"autologin\": \"true\",\n    \"install_vagrant_keys\": \"true\",\n    \"install_xcode_cli_tools\": \"true\",\n    \"iso_url\": \"OSX_InstallESD_10.X.X_XXXXX.dmg\",\n    \"password\": \"vagrant\",\n    \"provisioning_delay\": \"0\",\n    \"username\": \"vagrant\"\n  }\n}\n"),
-	}
-	filef := &embedded.EmbeddedFile{
+	filea := &embedded.EmbeddedFile{
 		Filename:    `usr-password-shadow`,
 		FileModTime: time.Unix(1479257723, 0),
 		Content:     string("00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000032AA0E1D67AA6E7A4D01512E9127D8B3280E7394342CF7D60000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
 	}
-	fileg := &embedded.EmbeddedFile{
+	fileb := &embedded.EmbeddedFile{
 		Filename:    `vagrant.jpg`,
 		FileModTime: time.Unix(1479257723, 0),
 		Content:     string("\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00\xff\xe2\a\xb8ICC_PROFILE\x00\x01\x01\x00\x00\a\xa8appl\x02 \x00\x00mntrRGB XYZ \a\xd9\x00\x02\x00\x19\x00\v\x00\x1a\x00\vacspAPPL\x00\x00\x00\x00appl\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xf6\xd6\x00\x01\x00\x00\x00\x00\xd3-appl\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\vdesc\x00\x00\x01\b\x00\x00\x00odscm\x00\x00\x01x\x00\x00\x05lcprt\x00\x00\x06\xe4\x00\x00\x008wtpt\x00\x00\a\x1c\x00\x00\x00\x14rXYZ\x00\x00\a0\x00\x00\x00\x14gXYZ\x00\x00\aD\x00\x00\x00\x14bXYZ\x00\x00\aX\x00\x00\x00\x14rTRC\x00\x00\al\x00\x00\x00\x0echad\x00\x00\a|\x00\x00\x00,bTRC\x00\x00\al\x00\x00\x00\x0egTRC\x00\x00\al\x00\x00\x00\x0edesc\x00\x00\x00\x00\x00\x00\x00\x14Generic RGB Profile\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14Generic RGB Profile\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00mluc\x00\x00\x00\x00\x00\x00\x00\x1e\x00\x00\x00\fskSK\x00\x00\x00(\x00\x00\x01xhrHR\x00\x00\x00(\x00\x00\x01\xa0caES\x00\x00\x00$\x00\x00\x01\xc8ptBR\x00\x00\x00&\x00\x00\x01\xecukUA\x00\x00\x00*\x00\x00\x02\x12frFU\x00\x00\x00(\x00\x00\x02<zhTW\x00\x00\x00\x16\x00\x00\x02ditIT\x00\x00\x00(\x00\x00\x02znbNO\x00\x00\x00&\x00\x00\x02\xa2koKR\x00\x00\x00\x16\x00\x00\x02\xc8csCZ\x00\x00\x00\"\x00\x00\x02\xdeheIL\x00\x00\x00\x1e\x00\x00\x03\x00deDE\x00\x00\x00,\x00\x00\x03\x1ehuHU\x00\x00\x00(\x00\x00\x03JsvSE\x00\x00\x00&\x00\x00\x02\xa2zhCN\x00\x00\x00\x16\x00\x00\x03rjaJP\x00\x00\x00\x1a\x00\x00\x03\x88roRO\x00\x00\x00$\x00\x00\x03\xa2elGR\x00\x00\x00\"\x00\x00\x03\xc6ptPO\x00\x00\x00&\x00\x00\x03\xe8nlNL\x00\x00\x00(\x00\x00\x04\x0eesES\x00\x00\x00&\x00\x00\x03\xe8thTH\x00\x00\x00$\x00\x00\x046trTR\x00\x00\x00\"\x00\x00\x04ZfiFI\x00\x00\x00(\x00\x00\x04|plPL\x00\x00\x00,\x00\x00\x04\xa4ruRU\x00\x00\x00\"\x00\x00\x04\xd0arEG\x00\x00\x00&\x00\x00\x04\xf2enUS\x00\x00\x00&\x00\x00\x05\x18daDK\x00\x00\x00.\x00\x00\x05>\x00V\x01a\x00e\x00o\x00b\x00e\x00c\x00n\x00\xfd\x00 \x00R\x00G\x00B\x00 \x00p\x00r\x00o\x00f\x00i\x00l\x00G\x00e\x00n\x00e\x00r\x00i\x01\r\x00k\x00i\x00 \x00R\x00G\x00B\x00 \x00p\x00r\x00o\x00f\x00i\x00l\x00P\x00e\x00r\x00f\x00i\x00l\x00 \x00R\x00G\x00B\x00 \x00g\x00e\x00n\x00\xe8\x00r\x00i\x00c\x00P\x00e\x00r\x00f\x00i\x00l\x00 \x00R\x00G\x00B\x00 \x00G\x00e\x00n\x00\xe9\x00r\x00i\x00c\x00o\x04\x17\x040\x043\x040\x04;\x04L\x04=\x048\x049\x00 \x04?\x04@\x04>\x04D\x040\x049\x04;\x00 \x00R\x00G\x00B\x00P\x00r\x00o\x00f\x00i\x00l\x00 \x00g\x00\xe9\x00n\x00\xe9\x00r\x00i\x00q\x00u\x00e\x00 \x00R\x00V\x00B\x90\x1au(\x00 \x00R\x00G\x00B\x00 \x82r_icϏ\xf0\x00P\x00r\x00o\x00f\x00i\x00l\x00o\x00 \x00R\x00G\x00B\x00 \x00g\x00e\x00n\x00e\x00r\x00i\x00c\x00o\x00G\x00e\x00n\x00e\x00r\x00i\x00s\x00k\x00 \x00R\x00G\x00B\x00-\x00p\x00r\x00o\x00f\x00i\x00l\xc7|\xbc\x18\x00 \x00R\x00G\x00B\x00 \xd5\x04\xb8\\\xd3\f\xc7|\x00O\x00b\x00e\x00c\x00n\x00\xfd\x00 \x00R\x00G\x00B\x00 \x00p\x00r\x00o\x00f\x00i\x00l\x05\xe4\x05\xe8\x05\xd5\x05\xe4\x05\xd9\x05\xdc\x00 \x00R\x00G\x00B\x00 \x05\xdb\x05\xdc\x05\xdc\x05\xd9\x00A\x00l\x00l\x00g\x00e\x00m\x00e\x00i\x00n\x00e\x00s\x00 \x00R\x00G\x00B\x00-\x00P\x00r\x00o\x00f\x00i\x00l\x00\xc1\x00l\x00t\x00a\x00l\x00\xe1\x00n\x00o\x00s\x00 \x00R\x00G\x00B\x00 \x00p\x00r\x00o\x00f\x00i\x00lfn\x90\x1a\x00 \x00R\x00G\x00B\x00 cϏ\xf0e\x87N\xf6N\x00\x82,\x00 \x00R\x00G\x00B\x00 0\xd70\xed0\xd50\xa10\xa40\xeb\x00P\x00r\x00o\x00f\x00i\x00l\x00 \x00R\x00G\x00B\x00 \x00g\x00e\x00n\x00e\x00r\x00i\x00c\x03\x93\x03\xb5\x03\xbd\x03\xb9\x03\xba\x03\xcc\x00 \x03\xc0\x03\xc1\x03\xbf\x03\xc6\x03\xaf\x03\xbb\x00 \x00R\x00G\x00B\x00P\x00e\x00r\x00f\x00i\x00l\x00 \x00R\x00G\x00B\x00 \x00g\x00e\x00n\x00\xe9\x00r\x00i\x00c\x00o\x00A\x00l\x00g\x00e\x00m\x00e\x00e\x00n\x00 \x00R\x00G\x00B\x00-\x00p\x00r\x00o\x00f\x00i\x00e\x00l\x0eB\x0e\x1b\x0e#\x0eD\x0e\x1f\x0e%\x0eL\x00 \x00R\x00G\x00B\x00 \x0e\x17\x0e1\x0eH\x0e'\x0eD\x0e\x1b\x00G\x00e\x00n\x00e\x00l\x00 \x00R\x00G\x00B\x00 \x00P\x00r\x00o\x00f\x00i\x00l\x00i\x00Y\x00l\x00e\x00i\x00n\x00e\x00n\x00 \x00R\x00G\x00B\x00-\x00p\x00r\x00o\x00f\x00i\x00i\x00l\x00i\x00U\x00n\x00i\x00w\x00e\x00r\x00s\x00a\x00l\x00n\x00y\x00 \x00p\x00r\x00o\x00f\x00i\x00l\x00 \x00R\x00G\x00B\x04\x1e\x041\x04I\x048\x049\x00 \x04?\x04@\x04>\x04D\x048\x04;\x04L\x00 \x00R\x00G\x00B\x06E\x06D\x06A\x00 \x06*\x069\x061\x06J\x06A\x00 \x00R\x00G\x00B\x00 \x06'\x06D\x069\x06'\x06E\x00G\x00e\x00n\x00e\x00r\x00i\x00c\x00 \x00R\x00G\x00B\x00 \x00P\x00r\x00o\x00f\x00i\x00l\x00e\x00G\x00e\x00n\x00e\x00r\x00e\x00l\x00 \x00R\x00G\x00B\x00-\x00b\x00e\x00s\x00k\x00r\x00i\x00v\x00e\x00l\x00s\x00etext\x00\x00\x00\x00Copyright 2007 Apple Inc., all rights reserved.\x00XYZ \x00\x00\x00\x00\x00\x00\xf3R\x00\x01\x00\x00\x00\x01\x16\xcfXYZ \x00\x00\x00\x00\x00\x00tM\x00\x00=\xee\x00\x00\x03\xd0XYZ \x00\x00\x00\x00\x00\x00Zu\x00\x00\xacs\x00\x00\x174XYZ \x00\x00\x00\x00\x00\x00(\x1a\x00\x00\x15\x9f\x00\x00\xb86curv\x00\x00\x00\x00\x00\x00\x00\x01\x01\xcd\x00\x00sf32\x00\x00\x00\x00\x00\x01\fB\x00\x00\x05\xde\xff\xff\xf3&\x00\x00\a\x92\x00\x00\xfd\x91\xff\xff\xfb\xa2\xff\xff\xfd\xa3\x00\x00\x03\xdc\x00\x00\xc0l\xff\xdb\x00C\x00\x02\x02\x02\x02\x02\x01\x02\x02\x02\x02\x02\x02\x02\x03\x03\x06\x04\x03\x03\x03\x03\a\x05\x05\x04\x06\b\a\b\b\b\a\b\b\t\n\r\v\t\t\f\n\b\b\v\x0f\v\f\r\x0e\x0e\x0e\x0e\t\v\x10\x11\x0f\x0e\x11\r\x0e\x0e\x0e\xff\xdb\x00C\x01\x02\x02\x02\x03\x03\x03\x06\x04\x04\x06\x0e\t\b\t\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\x0e\xff\xc0\x00\x11\b\x011\x01.\x03\x01\"\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1f\x00\x00\x01\x05\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\x06\a\b\t\n\v\xff\xc4\x00\xb5\x10\x00\x02\x01\x03\x03\x02\x04\x03\x05\x05\x04\x04\x00\x00\x01}\x01\x02\x03\x00\x04\x11\x05\x12!1A\x06\x13Qa\a\"q\x142\x81\x91\xa1\b#B\xb1\xc1\x15R\xd1\xf0$3br\x82\t\n\x16\x17\x18\x19\x1a%&'()*456789:CDEFGHIJSTUVWXYZcdefghijstuvwxyz\x83\x84\x85\x86\x87\x88\x89\x8a\x92\x93\x94\x95\x96\x97\x98\x99\x9a\xa2\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xaa\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9\xba\xc2\xc3\xc4\xc5\xc6\xc7\xc8\xc9\xca\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xda\xe1\xe2\xe3\xe4\xe5\xe6\xe7\xe8\xe9\xea\xf1\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff\xc4\x00\x1f\x01\x00\x03\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\x06\a\b\t\n\v\xff\xc4\x00\xb5\x11\x00\x02\x01\x02\x04\x04\x03\x04\a\x05\x04\x04\x00\x01\x02w\x00\x01\x02\x03\x11\x04\x05!1\x06\x12AQ\aaq\x13\"2\x81\b\x14B\x91\xa1\xb1\xc1\t#3R\xf0\x15br\xd1\n\x16$4\xe1%\xf1\x17\x18\x19\x1a&'()*56789:CDEFGHIJSTUVWXYZcdefghijstuvwxyz\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x92\x93\x94\x95\x96\x97\x98\x99\x9a\xa2\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xaa\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9\xba\xc2\xc3\xc4\xc5\xc6\xc7\xc8\xc9\xca\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xda\xe2\xe3\xe4\xe5\xe6\xe7\xe8\xe9\xea\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff\xda\x00\f\x03\x01\x00\x02\x11\x03\x11\x00?\x00\xfd\xfc\xa2\x8aο\xd5l\xf4\xe8\xff\x00\u007f 2c\xe5\x89yc\xf8v\xfch\x03F\xb0\xf5\x1d~\xce\xc3tj\xdfi\xb9\x1f\xc0\x87\x81\xf55\xc8\xea\x1e!\xbd\xbd\r\x1cg\xec\x90\x1f\xe1C\xf3\x1f\xa9\xff\x00\n\xe7\xe8\x03Z\xefZ\xd4/.\xd6F\x9d\xe2\nr\x89\x19*\x17\xfc\xfb\xd6\xee\x9d\xe2\x926Ũ\xae\xe1\xd3\xceA\xcf\xe2?¸\xde\xfd\x0f\xe5ILg\xb2\xc3<7\x16\xeb,\x12$\xb1\x9e\x8c\xa7\"\xa5\xaf \xb4\xbe\xb9\xb0\xb8\xf3-\xa5x\xcfq\xd5O\xd4Wo\xa7x\x9a\xde\xe0\xacW\xa0[M\xfd\xf1\xf7\x0f\xf8~4\x84u\x14R\x02\x19C)\x04\x11\x90GzZ\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\x8a\x00(\xa2\xa0\xb8\xb9\x82\xd6\xdc\xcbq*C\x18\xee\xc7\xfc\xe6\x80'\xa2\xb8ˏ\x16\x81|\xa2\xda\xdb̷\a\xe6.p\xcd\xf4\xf4\xae\x8a\xc3U\xb3\xd4c\xcc\x12bA\xf7\xa2n\x18~\x1d\xff\x00\n\x00\xb7r\x01\xd3\xe7\x04\x02\fm\x90~\x95\xf8\xbb\xf0\x83\xf6\xb7\xf8\x87\xf0\xbehtm^G\xf1\xb7\x83\xe3m\xa2\xc6\xfec\xf6\x8be\xcf\xfc\xb1\x9c\xe5\x80\x03\xa2\xb6\xe5\xec\x02\xf5\xaf\xda;\x8f\xf8\xf1\x9f\xfe\xb9\x9f\xe5_̈́\xbf\xf1\xf3'\xfb\xc7\xf9\xd7\xea\x9e\x1b\xe5\xb8\\u\x1c],D\x14\xa3\xeeo\xff\x00ol\xf7O\xd0\xfc\xa7ļ\xcf\x15\x81\xab\x84\xab\x87\x9b\x8c\xbd\xfd\xbf\xed\xdd\xd6\xcdy3\xf7\xdf\xe1o\xc7\x0f\x87\u007f\x17\xb4%\xb8\U0001ec9f\xdai\x18k\xad\x1e\xf3\x11^\xdb\xfa\xe52w/\xfbHY}\xf3\xc5z\xed~\x00\xfc$\xf8a\xf1;\xe2\x17\x8b\xad\xf5\x0f\x87\x96\xf7zd6S\x82\xfe'\x96\xe1\xed-,XwY\x97\xe6y\x06~\xe4[\x9b\xe9_\xb4>\x02\xd4u\xdf\x0e\xfc>\xd2\xf4_\x18x\x96\xe3\xc6ڴ\x11m\xb9\xd6\xde\xc9-\x9af\xf6\x8d\u007f\x84\x0e\x03\x13\xb8\xf5nk\xe7\xb8Ǉ\xb0Y]~\\=u+\xfd\x9d\xe5\x1fV\xb4\xfb\xec\xfc\x9e\xe7\xd0\xf0o\x11\xe3sZ\x1c؊\x0e6\xfb_f^\x89\xeb\xf7]zlz\xed\x15\x05\xbd\xcc\x17V\xe2[yRh\xcfu5=|a\xf6\xa7\x92\xe9?\x144\x1f\x1c\xf815\xbf\x01\xeb6\x1a\u0588\xe7k^\xdaɹ\xe3o\xeeH\x87\r\x13\xff\x00\xb2\xe0\x1a\xa4\xce\xcf!wfw'%\x89\xc95\xf8k\xe1\xcf\x17x\xa3\xc0\xbe?o\x10x;]\xd4<;\xac+\x90\xd3Z\xbf\xcb2\xe7\xeeJ\x87+*\u007f\xb2\xe0\x8a\xfb\xeb\xe1o\xed\x85\xe1\xcdw\xec\xfa?\xc5;[o\a\xeb\a\b\xba\xed\xa2\xb3i\x97\a\xd6T\xe5\xed\x89\xf5\xf9\xa3\xf7Z\xfd\x17?\xf0\xef\x1b\x83\xbd\\/\xefa\xdb\xed/\x97_U\xaf\x92?8\xe1\xef\x11\xb0XƩb\xff\x00u>\xff\x00e\xfc\xfa|\xf4\xf3>Σ\xb7\xadG\x14\xb0\xcfcowm<\x17V\x97\b$\xb7\xb8\xb7\x95e\x8ad=\x19\x1dIV\a\xd4\x13O\xe6\xbf;\xb1\xfa:i\xab\xa1{\xd2R\xfazQڀ\x13\U000a3de5\x1d\xe8\xef@\xcdm?Y\xbdӜ\b\xdc\xc9\x0fx\x9f\x95\xfc=+\xbbӵ\xcb-C\b\xad\xe4\xdc\x1f\xf9d\xe7\x93\xf4=\xeb\xcb\xfbQс\xf7\xeb@\x8fi\xa2\xbc\xebN\xf1%ݦخ\xb3u\x00\xe3$\xfc\xe3\xe8{\xfe5\xdc\xd9\xdf\xda\xdf\xc1\xe6[J\xaf\xfd\xe5<2\xfdE .QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00R3*!g`\xaa\x06I'\x00V&\xa3\xafY\xd8\x06Eo\xb4\\\x8f\xf9f\x87\xa7\xd4\xf6\xae\x12\xff\x00V\xbc\xd4\\\xf9\xd2b,\xfc\xb1'\n?\xc7\xf1\xa0\x0e\xb3Q\xf1<\x10\x86\x8e\xc1E̝<\xc3\xf7\a\xf8\xd7\x13uwsypf\xb9\x95\xe5\u007f~\x83\xe8;U|\xe3\xd8\xfa\xd2\xf0FO\x14\xc0o\xb5=Y\x92@\xe8̎\xbc\x82\xa7\x04SH#?Γ\xbfց\x9de\x8f\x89e\xf2Z\xdbP\xfd\xe22\x15\x13(\xf9\x87\x1cdt?\xa5|\x03\xf0\xc3\xf6=д\x89 ־+\xdd\xdb\xf8\xa7UϘ\xbe\x1f\xb1\x91\x97N\x80\xf5\xc4\xd2\f=\xc1\x1d\xd5v\xa7\xfb\xd5\xf6\x9d\x1d\x0ek\xd4\xcb\xf3\xbcn\x06\x95Jxy\xf2\xa9\xda\xedo\xa5\xf6{\xad\xfajy\x19\x86G\x82\xc7U\xa7S\x11\x0eg\v\xd9=\xb5\xb6\xeb\xae\xddt\"\x82\x18-4\xdbk\x1b;{k+\vd\xf2\xed\xadm\xa1X\xa1\x81\u007f\xba\x88\xa0*\x8f`*^ԕ\x95\xae\xeb\xda'\x85\xbc8ڿ\x88\xf5KM\x1fM\x1c,\xb7\ŗ\xfb\xb1\xa8\xf9\xa4oe\a\xf0\xaf:\x10\x9dI\xa8\xc5^O\xa2նzs\x9c)A\xcaM(\xae\xfa$\xbfCz\xda\xee\xe2\xca\xe4Km3\xc4\xe3\xae:\x1f\xa8\xefWu?\x8b\xbe\x10\xf0\xcd\xccV\x1e)\xd5!\xb0՝7\xad\x9c\x11\xbc\xd3\x14\xfe\xfbF\x80\xb2.H屜\xf1_\x11x\xe3\xf6\x88\xd65?;N\xf0\x1c\x13\xf8sO9V\xd5.\x14\x1b\xe9G\xaa/+\x00>\xbf3\xfb\x8a\xf1\xdf\t<\x92\xf8\xc6\xf6\xe2y%\x9e\xe6X\x19\xe5\x9aW/$\x8cYrY\x8f$\xfb\x93_\xa0\xe5|\x01^t\xddlc\xe4_ʾ/\x9b\xd9~/\xd0\xfc\xf34\xf1\x02\x84j*X(\xf3\xbf\xe6\u007f\x0f\xc9h߮\x8b\xd4\xf8\xfe\u007f\xf8\xfd\x9b\xfd\xf3\xfc\xea*\xea|o\xe1}K\xc1_\x17\xbcI\xe1=]q\xa8iZ\x84\xb6Ҷ\xdc\t6\xb1\xda\xe3\xfd\x96\\0\xf6\"\xb9j\xfd\xee\x95HԄe\x17t\xd5\xd1\xfc\xff\x00V\x9c\xa9\xceP\x92\xb3N\xcc\xfak\xe0g\xc4o\x16\xf8\a\xc1\xb2\r\aP\x12if\xfd\xcc\xdaE\xe6d\xb3\x93\x85\xce\x179\x8d\xbf\xdaB\x0f־\xfb\xf0'\xc6o\b\xf8\xdeHl$\x93\xfe\x11\xaf\x11\xbf\x03M\xbf\x94l\x99\xbf\xe9\x84\xdc+\xff\x00\xbav\xb7\xb1\xaf\xcc\xcf\x00\u007fȇ/\xfd~\xc9\xff\x00\xa0\xadv\xf1\xdb\xc9yw\x05\x94\x16\xd3^\xdc\xce\xe1a\xb6\x86#$\x92\xb7`\xaa\x01$\xfd+\xe28\x8b\x84\xf0\x19\x94\xa59.I\xff\x002\xfdV\xcf篚>\xef\x878\xb3\x1f\x96\xc20\x83\xe6\x87\xf2\xbf\xd1\xee\xbeZy3\xf5]\x95\x91\xd9X2\xb0ᔌ\x11M\xfc\xeb\xe5K\u007f\x13|P\xf8%\xfb6\\\xf8\xc7\xc7\xe8\x9e!\xd0mn\xedmm\xfc;qr\x0e\xa7n\x93I\xb3w\xday\t\xb4r\"}\xfc\xf5+^\xd9\xf0\xf3◁>*hoy\xe0\xbdm/nbMךM\xca\xf9:\x85\x9f\xfdt\x84\x9c\x95\xff\x00m\v'\xbd~!\x8f\xc8\xeb\xe1\xe3*\xb0j\xa5$\xed\xcf\x1dc}7\xed\xba\xf2\xe8\x9b?p\xc0g\xf8|L\xe3JiӪ\xd5\xf9%\xa4\xad\xe5\xdfg\xe7\xdd#\xbf\xef\xcd\x1d\xe9z\xf7\xfdi+\xc6=\xc1{RR\xfeX\xa4\xa0\x05=)\xf1K,\x13\xac\xb0\xc8\xf1H\x0eC)\xc1\xa6R~t\x01\xda\xe9\xde)\xfb\xb0\xea+\x9e\xder\x0f\xe6?\xc3\xf2\xae\xc6)\xa2\x9e\x05\x96\x19\x12Xۣ)\xc85\xe3\\\xe7\xa7Z\xe2\xfcW\xf1sß\fY\x86\xa1\xa8\xc9s\xad\x15ܚ%\x89\x12\\I\xe8d\a\xe5\x89\u007f\xda|\x1f@k|.\x12\xb6&\xa2\xa7F.R}\x17\xf5\xf8\xecsb\xb1tpԝZ\xd3Q\x8a\xea\xff\x00\xaf\xc0\xfav\x8a\xe6\xfc\x1d\xafI⏅^\x1d\xf1$\xb6\xabe&\xa7\xa7\xc5t\xd6\xeb&\xf1\x11u\r\xb7v\x06q\x9cg\x15\xd2VUiʜ\xdc%\xba\xd0֕HԂ\x9cvj\xeb\xe6\x14QEAaE\x14P\x01E\x14P\x01E\x15\xe0?\x1e\xbe7\xdb|\x11\xd0\xfc)\xa9j:5\uea64\xeaڃ\xda]\xcdc\"\xfd\xa2\xd1D{\x84\x89\x1b|\xb2{\xae\xe5>\x875Ӄ\xc1\xd6\xc5VThǚOe\xdfK\x9c\xb8\xcce\x1c%\x17Z\xb4\xad\x15\xbb\xf9\xd8\xf7\v\xddF\xd3O\x87u̡X\x8c\xaa\x0eY\xbe\x82\xb8mK\xc4wwe\xa3\x836\xb6粟\x99\xbe\xa7\xfa\n\xf3\xaf\nx\xdf\xc2\xff\x00\x11<*|G\xe1\x1f\x10\xd9\xf8\x93O$y\xf2D\xc7ηc\xfc\x13\xc6\xdf<M\xec\xc3\x1e\x84\xd7G\xc5eV\x94\xe9M¤Z\x92\xdd=\x1a\xf5F\xd4kS\xad\x05R\x9c\x94\xa2\xf6kT\xfeaފ?*\xe4\xfc]\xe3\x8f\v\xf8\x17I[\x9f\x12\xeaik4\x8b\xba\xda\xc2\x15\xf3n\xee\u007f܈\x1c\xe3\xfd\xa6\xc2\xfb\xd5P\xa1V\xb5EN\x94\\\xa4\xf6KV,F\"\x95\nn\xa5Y(\xc5n۲:\xba;\xd7\xca\xfa\x17\xedi\xe0k\x8f\x89\xf7\x9e\x1b\xf1\x96\x99w\xe0\x8b}\xc8t\xfdZI\xbe\xd3lU\x97!nv\xaebl\xff\x00\x1a\x86O\xa7Z\xfa\x96\x19`\xb9ӭ\xef-.-\xef,n\x10Imum*\xcb\f\xc9\xfd\xe4u%X{\x83]\xb9\x96O\x8d\xcb棉\xa6\xe3}\xbb?F\xae\x9f\x9e\xba\x1c9fu\x81\xcc\"冨\xa5m\xfb\xafT\xec\xff\x00\x02L\x9c{R\xf0O\x1di3J\xaa\xcf DVgn\x8a\xa3$טzbt5\x1c\xf3Ak\xa6\xdc\xdf^\\[YX\xdb'\x99sus2\xc5\f\t\xfd\xe7v!T{\x93^]㯌~\x11\xf0;Kc\xe6\xff\x00\xc2I\xe2\x14\x18\xfeͰ\x94m\x84\xff\x00\xd3i\x86U?\xdd\x19oa_\x9b\x9f\x19|e\xf1G\xe2\x0e\xa6\xf7^)\xd5?\xb4</\x14\x85\xed4\x9d)\fV6c\xb1xrK\xb8\xff\x00\x9e\x8eX\x9fQ_g\xc3\xdc\x13\x8d\xccڜ\xff\x00wO\xbb\xdd\xff\x00\x85u\xf5v]\x9b>+\x88\xb8\xe3\x05\x96'\b~\xf2\xa7e\xb2\xf5}=\x15\xdf{\x1fQ\xfcQ\xfd\xb14-\x1f\xed:7\u009b;\u007f\x15j\x83(\xde \xbf\x8d\x86\x9d\x01\xe9\x98c8k\x82;3mO\xf7\xab\xe6]\x1f\xc5>$\xf1\xad\xad߈\xbc]\xaej\x1e\"\xd6弐\x1b\xab\xc93\xb1p\xb8H\xd4acA\xd9T\x01_?\x82\b\xc8 \x83Ѓ^\xc5\xe0\x1f\xf9\x10\xa4\xff\x00\xaf\xd9?\x92\xd7\xedYo\r`2\xaa6\xc3\xc3\xde\xeb'\xac\x9fϢ\xf2V^G\xe2Y\x8f\x13f\x19\xb5{\xe2'\xee\xf4\x8a\xd2+\xe5\xfa\xbb\xbf3\xb5\xae\xb3\xc1\xdf\xf23O\xff\x00^\xad\xff\x00\xa1-rg\xa5{w\xc0\xef\x87Z\x9f\xc4\x1f\x1dk\x10\xd9]Game`\x1a{\x99#,\xa1\x9eE\b\x9cw!\\\x8f\xf7M\x19\x9e\"\x9d\f,\xeaTv\x8a\xea^Y\x87\xa9_\x15\nt\xd5\xe4\xfa\x0e\xfd\xbd\xbe\x1c\xff\x00f\xfcJ\xf0\xef\xc4\xeb\b6\xdak\x11\r?Te^\x05\xccK\x98\x98\x9fW\x88\x15\xfaA_\x9f\x15\xfb\xf9\xf1\xd3\xe1\xda|Q\xfd\x97<W\xe15\x89d\xd4\xe4\xb57\x1aS\x1cen\xa2\xf9\xe2\xc1\xed\xb8\x8d\x84\xff\x00uڿ\x01]\x1e9\x9e9\x15\x92E$2\xb0\xc1\x04u\x04W\x8f\xe1\xd6o\xf5\xbc\xb3\xd8\xc9\xfbԴ\xf9}\x9f\xd5|\x8f[\xc4|\x9fꙧ\xb6\x8a\xf7j\xeb\xf3\xfb_\xa3\xf9\x9fR\xfe\xce\xdf\x0e\xae>%Y\xdf\xe9\x96\xda\xfe\x8f\xa4\xad\x9d\xd3Ky\x1b\xca$\xbe\xf2\xc8_\x9a+|\xe5\xc7_\x9c\xe1F9\xaf\xd1_\x06|?𧀬\x19<9\xa7\x05\xbd\x916Ϫ]\x11%\xe4\xff\x00W\xc7ȿ\xec\xa0\x03\xeb_\x89V\x97w\x9av\xb5i\xa9i\xb7\x97\x9an\xa7k \x92\xd6\xf2\xd2f\x8ah\x18\u007f\x12:\x90T\xfd\r}\xb5\xf0\xbb\xf6\xc7\xd5t\xf3o\xa3|[\xb2\x9b^\xb2\x18E\xf1&\x9b\x02\x8b\xd8\xc7L\xdc@0\xb3\x8fWM\xaf܆\xae\x0e9\xe1\xfc\xe7\x18\x9c\xf0\xd59\xa9\xf5\x82\xd1\xff\x00\xf6ޏn\x89\xb3\xbf\x80\xf8\x83&\xc1\xb5\fM>Z\x9d&\xf5_\xfd\xaf\xaa\xf9\xb4\x8f\xa0?kO\xf90\xff\x00\x10\x81\xff\x00A\x8d7\xff\x00G\x9a\xfc\xa7\xb1\xbe\xbeҵ\xeb=WJ\xbf\xbdҵ[I\x04\x96\xb7\xb6S\xb43\xc0ި\xeaA\x1f\u05fd~\x9f\xfe\xd2~#\xf0\xf7\x8b\u007f\xe0\x9czο\xe1]sL\xf1\x16\x8b>\xb1\xa7yw\x963o\\\xf9\xfc\xab\x0f\xbc\x8c22\xac\x01\x1e\x95\xf9o]^\x1aRq\xcajBjϞI\xa7\xfe\x18\xe8\xd7\xe8r\xf8\x97Z2\xcd\xe9ԧ+\xaeH\xb4\xd3\U000d6a63\xee\u007f\x85߶N\xa1hm\xf4\u007f\x8b\xd62\xea\xf6\xbc*x\x9bK\xb7\x02\xe9\a\xadŸ\xc2\xcb\xee\xf1\xedn\xe5Z\xbe\xf4\xd15\xbd\x17\xc4\xde\x12\xb4\xd7\xfc7\xab\xe9\xda\xfe\x87r\a\x91}c0\x92&8\xce\xd3\xddXwV\x01\x87\xa5~\x12Wa\xe0\x8f\x1f\xf8\xcb\xe1Ǌγ\xe0\xbdz\xefD\xbb~.b\\=\xb5\xda\xff\x00vh[\xe4\x90}F}\b\xacx\x83Ì&*\xf5pmS\x9fo\xb2\xfe_g姑\xb7\x0e\xf8\x93\x8b\xc2Z\x961{Hw\xfbK\xe7\xf6\xbez\xf9\x9f\xb7\xd8\xefKھK\xf8]\xfb[x7\xc5\xefm\xa3\xf8\xfa\x1bo\x00x\x91\xc8E\xbc2\x16Ү\xd8\xf1ß\x9aܟ\xee\xbeW=\x18W\xd6\x17\x13Ag\xa5I\xa8\xde]Z\xdai\xa9\x10\x95\xef&\x99V\x00\x84p\xfefv\x95=\x88<\xf6\xcd~1\x99\xe4\xf8̾\xb7\xb2\xc4\xd3q};?G\xb3\xf9\x1f\xb5\xe5y\xd6\v1\xa3\xedp\xf5\x14\x92ߺ\xf5]\a\xfeU\x8b\xe2\x1f\x12\xe8\x1e\x12\xf0\xef\xf6\xaf\x89uk]\"\xcc\xe4De9\x92s\xfd\xd8\xe3\x1f3\x9f\xa0ǩ\x15\xf3\xf7\x8e?h\xbb\x1bO;N\xf8}m\x1e\xa9sʝj\xfa#\xf6d\xf7\x86#\x83'\xfbυ\xf65\U000a6beb\xea\xde \xf1\x14ھ\xbb\xa9^\xeb\x1a\xa4\xbf~\xe6\xeeM\xef\x8f\xee\x8eʿ\xec\xa8\x03ھ\xb7#\xe0\x1cV*\xd51_\xbb\x87o\xb4\xfe]>z\xf9\x1f#\x9eq\xfe\x17\rzxE\xed'\xdf쯟\xda\xf9i\xe6{\xb7\x8e?h]{Y3i\xde\n\x86\u007f\viM\x95k\xf90u\tǪ\x91\x95\x80\x1f\xf6r\xdf\xed\n\xf9\xe0妒Fg\x92Y\x18\xbc\x92Hŝ\xd8\xf5,ǒ}\xcd\x14W\xebynS\x84\xcb\xe9\xfb<<9W^\xef\xd5\xee\xff\x00N\x87䙖m\x8b\xc7\xd5\xf6\x98\x89\xb9>\x9d\x97\xa2\xd9\u007fW?_\xfe\x13\u007fɱx\x03\xfe\xc0\x16\xbf\xfa)kЫ\xcf~\x12\xff\x00ɰ\xf8\x03\xfe\xc0\x16\xbf\xfa)kЫ\xf9\xc71\xff\x00{\xab\xfe'\xf9\x9f\xd1\xf9o\xfb\xa5/\xf0\xaf\xc9\x05\x14Q\\GhQE\x14\x00QE\x14\x00W\xe7\xef\xfc\x14\x13\xfeH\x8f\x80\u007f\xec9/\xfe\x885\xfa\x05_\x9f\xbf\xf0PO\xf9\">\x01\xff\x00\xb0\xe4\xbf\xfa \xd7\xd5pG\xfc\x8f0\xfe\xaf\xf2g\xcaq\xc7\xfc\x88\xb1\x1e\x8b\xf3G\xe6\x1f\x87<I\xe2\x1f\a\xf8\xc6\xdf\xc4>\x14\xd6\xf5/\x0e\xebp\xf0\x97\x962\xecr?\xba㣡\xee\xac\b>\x95\xf7\xd7\xc2\xcf\xdb\x17J\xd5Z\xd7C\xf8\xb1c\x16\x83\xa91\x11\xc7\xe2-2\x06k)\x9b\xa0\xf3\xe0\x19hI?ě\x93\x9f\xba\xb5\xf9\xd7V,\xff\x00\xe45c\xff\x00_1\xff\x00\xe8b\xbf|\xce\xf8o\x01\x9a\xc2؈{\xcbi-$\xbe\u007f\xa3\xba\xf2?\x9f\xb2>$\xcc2\xaa\x97\xc3\xcfN\xb1z\xc5\xfc\xbfUg\xe6~\x98\xf8\xeb\xf6\x8a\xbb\x96Y\xf4χ֯a\x10%\x1f[\xbf\x84\x19\xdb\xde\x18NB{3廀+\xe6;\xab\x9b\xab\xfdZ\xe3P\xbf\xba\xb9\xbf\xd4'm\xd3\xdd\\\xcad\x96S\xea\xccy?қ/\xfc|\xc9\xfe\xf1\xfeu\x1f\xe3\xcdpeY.\x0f.\xa7ɇ\x85\xbb\xbe\xaf\xd5\xfe\x9bvG\xa9\x9a\xe7X\xccƧ>\"w\xec\xba/E\xfa\xefݞ'\xe3\u007f\xf9)\x97\x9f\xf5\xc2\x1f\xfd\x02\xba_\x86\x9f\x18\xfc}\xf0\x9fR'\u009a\xb0}\x1eI7\xddhW\xe0\xcdaq\xeavg1\xb7\xfbq\x95?Z\xe6\xbco\xff\x00%2\xf3\xfe\xb8C\xff\x00\xa0W)_EW\tG\x13\x87\xf6U\xa0\xa5\x16\xb5M]\x1f1K\x17[\r\x88\xf6\xb4f\xe3$\xf4iٟ\xab\x1e\a\xfd\xaa\xfe\x1a\xf8\xb3\xc27\x17\x1a\xb4z\xa7\x85|Gk\x0e\xf9\xf4S\x11\xb9\xfbO8&\xdaU\x00:\xe7\x19\x0f\xb5\x94\x1c\x9c\xf5\xaf,\xf1\xdf\xc7o\x14\xf8\xb2\x1b\x8d;E\x12xK\xc3\xee\n\xb46\xd3f\xee\xe1\u007f\xe9\xacà?\xddL\x0fs_\x18\xf8\x03\xfeG\xb9\xbf\xeb\xcaO\xe6\xb5\xec\x19\xcf\xff\x00Z\xbe7\r\xc1YV\v\x12\xea\xc2\x17{\xa5'u\x1fO\xf3w~g\xda\xe28\xdb6\xc7aU*\x95,\xb6vVr\xf5\xff\x00%e\xdd\b\x00U\xc0\x00\f\xf4\x14\xe0J\xb6T\x95>\xa2\x93\x1cQ_J|\xe1\xc9k^\x0e\xd2\xf5V\x92{}\xbaU\xfbrd\x89?w!\xff\x00m?\xaa\xe0\xfdjǅ4\xbb\xcd\x1f\xc33X\xdf$k0\xbbvS\x1b\xeeWR\x17\f\x0f\xe0z\xf3]-\x15n\xa4\x9cyY\x92\xa5\x15.e\xb8\xbd\xbd+\xf5\x13\xf6p\xf0W\xfc\"?\xb3v\x9fws\b\x8fU\xd7\x0f\xf6\x85\xc1#\x90\x8c1\n\xfd6a\xb1ػW\xe7\xd7\xc2\xef\a?\x8f>:\xf8{\xc3{X\xd9\xcdp$\xbea\x9f\x96\xdd>y9\xecJ\x82\xa0\xfa\xb0\xaf\xd7\xf4D\x8a\x14\x8e4X\xe3E\n\x88\xa3\x01@\xe8\x00\xec+\xf2\xcf\x11\xf3NZt\xf0\x91{\xfb\xcfӧ\xe3\u007f\xb8\xfdW\xc3|\xaf\x9a\xa5Ld\x96\xde\xea\xf5{\xfe\x1a|\xc7W\xe2/\xeds\xf0\xe7\xfe\x15\xff\x00퉭\\ZA\xe5h\x9e\"\x1f\xda\xd6;Gʭ#\x1f==8\x949\xc0讕\xfbu_\x1e\xfeڿ\x0e?\xe13\xfd\x94$\xf1-\x94\x1en\xb3\xe19\x8d\xf2\x15\\\xb3Z\xbe\x16\xe1~\x80\x04\x90\x9fH\x8d|\xbf\x01\xe6\xff\x00Q\xcd`\xa4\xfdڞ\xeb\xf9\xec\xfe\xff\x00\xc1\xb3\xea8\xfb'\xfa\xf6S7\x15\xefS\xf7\x97\xcbu\xf7~)\x1f\x8d4QE\u007fH\x1f\xcdD\xf0\xdd][Y^\xda\xdb\xdd\\\xc1iy\xb3\xed\x96\xf1\xca\xcb\x1d\xceù<\xc5\a\fT\xf2\t\x19\a\xa5C\xc1\xe9\xc1\xf4\xa4\xa2\x95\x90]\xbd\u008a\\\xf1\x83Ȥ\xa6\x00@ \x82\x01\x04`\x83\u07bd\x9b\xc0Z\xb6\xadw\xf0\xde\xe3D\xbbյ;\xad\x16\xc6\xf85\x96\x9f5ӽ\xbd\xb9d\xc9\u0604\xe1ry\xe0q\xdb\x15\xe35\xea\xff\x00\x0e\xff\x00\xe4]\xd5\xff\x00\xeb\xf1?\xf4\n\xe7\xc5E8]\xad\x8e\xac$\xa4\xaah\xf7=\x034S]\xd64\xdd#*\xaf\xa9?\x90\xaf@\xbf\xf8a\xe3M#\xf6s\xf1\x1f\xc5-oH\x9bF\xf0Γh\x97\x11\xc5x\fww\xc1\x9d\x10yq\x9eU~pw>3\xd8\x1e\xb5\xe4W\xc4ң\xcb\xed$\x973I_\xab{%\xdc\xf6h\xe1\xabV\xe6\xe4\x8b|\xa9\xb7n\x89j\xdb\xecp<△\xf4\xddWO\xd5\xec\x8c\xfau\xd2\\\"\x8f\xde&6\xc9\x1f\xfb\xcay\x1f^\x9e\xf5\u007f\xbdn\xd3Z3\x04\xef\xaa?Q?f\xef\x17'\x8a?fM.\xceG_\xed\r\r\x8e\x9d:\x8e\x0e\xc5\x00\xc4\xd8\xf4\xd8UsܫW\xbe\xd7\xe6\xbf\xec\xb3\xe3/\xf8G\xff\x00h\a\xf0\xfdĻ4\xff\x00\x10[\xf9\x18'\n.#\xcb\xc4\u007f\x11\xe6'\xd5\xc5~\x94W\xf3\xdf\x18\xe5\x9fS\xcd*$\xbd\xd9{\xcb\xe7\xbf\xe3s\xfa\x17\x83\xb3?\xae\xe5t\xdb~\xf4}\xd7\xf2\xdb\xf0\xb0QE\x15\xf2\xe7ԅ\x14Q@\x05\x14Q@\x05~V\xfe\xdf>?MK⇅\xfe\x1cٺ<Z=\xb1\xbf\xd4\n\xf2D\xf3\fF\x87Ьk\xbb\xdcJ=+\xf5\x0fT\xd4\xec\xb4_\f\xea:ƥ:\xda\xe9\xd66\xb2\\\xddL\xdd#\x8e5.\xec}\x80\x04\xd7\xf3\xcd\xf1\x03\xc6\x17\xbe?\xf8\xd9\xe2\u007f\x19j\x1b\xd6\xe3W\xd4$\xb9\x11\xb1ϔ\x84\xe28\xf3\xe8\x88\x15G\xb2\x8a\xfd+\xc3<\xab\xdb\xe6\x12\xc4\xc9iMi\xfe'\xa7\xe5\u007f\xc0\xfc\xcf\xc4\xfc\xdb\xd8e\xf1\xc2\xc5\xebQ\xeb\xfe\x15\xaf\xe7o\xc4\xe3\xea͟\xfc\x86\xac\u007f\xeb\xe6?\xfd\fUj\x96\t\x047\xf0LAa\x1c\xaa\xe5A\xc6pA\xc7\xe9_\xbd3\xf0\x14ϥ\xa5\xff\x00\x8f\x89\x89!UI,\xccp\x00\xcfR{\n\xe0u\x9f\x1dXٗ\x83HT\xd4\xee\x87\x06fȁ\x0f\xb7w?L\x0fz\xe15\xdf\x10\xea\xba\xf4\xb2\x1b\x89\x95,7em`\xcaƿ\xef\x0e\xac}\xcf\x15\xce\xd7\x1d,2\xb5\xe4v\xd5ŷ\xa4KW\xb7\xb7z\x8e\xa7-\xed\xf4\xc6\xe2\xeaLnr\x00\xe0p\x00\x03\xa0\x1d\x85U\xa2\x8a\xebG\x1bw;\u007f\x00\u007f\xc8\xf37\xfdy?\xf3Z\xf6\x0e\xf5\xe3\xfe\x00\xff\x00\x91\xeao\xfa\xf2\u007f\xe6\xb5\xec\x15\xe7\xe2\u007f\x88zXO\xe1\x87j?:(\xac\x0e\xa0\xa2\x8cU\xfd+M\xbcּM\xa7h\xfa|F{\xfb딷\xb7\x8f?y݂\xa8\xfc\xcd)IE6\xf6C\x8c\\\x9a\x8a\xdc\xfb\xa3\xf6F\xf0W\xd9<'\xad\xf8\xee\xee\x1cO~\xff\x00a\xd3ه>J\x10da\xec\xce\x15~\xb1\x1a\xfb*\xb9\xff\x00\nxv\xcf\xc2_\r\xb4O\rX\x01\xf6]:\xd1 V\v\x8f0\x81\xf39\x1e\xac\xd9c\xeeMt\x15\xfc՞\xe6O\x1f\x8e\xa9_\xa3zz-\x17\xe0\u007fJ\xe4Yj\xc0`i\xd0ꖾ\xafW\xf8\x85U\xbe\xb2\xb4Դ[\xcd:\xfe\b\xee\xacn\xa0x. \x90ed\x8dԫ)\x1e\x84\x12*\xd5\x15\xe5&Ӻ=f\x93Vg\xf3\xcd\xf1O\xc0\xd7_\r\xbfh?\x15\xf8*\xeb\xcca\xa6_2[H\xfde\x81\xb0\xf0\xc8}\xda6B}\t\"\xb8\n\xfd.\xfd\xbe\xbe\x1ce<-\xf1N\xc2\x0eG\xfcJ5r\xa3\xfd\xe9-\xdc\xe3\xfeکc\xff\x00Lǥ~h\xd7\xf5/\f\xe6\xcb1\xcbiW\xbe\xb6\xb3\xf5Z?\xf3\xf9\x9fʼO\x94\xbc\xb72\xabC\xa2w_\xe1z\xaf\xbboT\x14QE{ǀ\x14QE\x00\x15\xf4\xa7\xec\xf5\xf0\xd7\xc5\u007f\x13g\xd7t\xcf\fCb\x16\xde\xee&\xbe\xbc\xbc\xb8\t\x15\xaa\xb2\x1c1Pw\xb9㢃\xeeE|\xd7]\xd7Ý~\xfb\xc3\xdf\x14-.t\xedB\xf3J\xbc\x98yp]\xdaLb\x96)\a(C\x0fS\x90A\xe0\xe7\x90k\x8f0\x85i\xe1\xe6\xa8\xc9)tm]}\xd7_\xd7}\x8eܺ\xa5\x18b`\xeb&\xe3}Rv\u007f}\x9f\xf5\xdbs\xf6\x83\xe1\x9f\xec\xef\xe0\x8f\x87\xd2\xdbj\x97q\x9f\x15x\xa6>F\xa5\u007f\x18\xd9\x03\u007f\xd3\b\xb9X\xfb|ܷ\x1fz\xa8~\xd7\x1f\xf2\x8eo\x8a_\xf6\x0e\x8b\xff\x00Ja\xaf\x19\xf8m\xfbY^ٛ}#\xe2u\x9b_[\x8c\"\xeb\xda|\x18\x91}\xe7\x80u\xff\x00z?O\xb9^\x9f\xfbNk\xda/\x89\xbf\xe0\x98?\x12\xb5\x9f\x0f\xea\x96:Ɨ>\x99\x11\x8a\xe6\xd2a\"\x1f\xf4\x88N2:\x11\x9eA\xe4w\xaf\xc1*\xe03Z9\xe6\x1ax\xeb\xca\xf5#io\x1f\x89m\xd1zi\xe8\u007f@R\xc7\xe55\xb2<L06\x8d\xa9\xce\xf1\xda_\v߫\xf5\xbb\xf5?\x14 \x9e{[Թ\xb5\x9e[k\x94?$\xb16\xd6_\xc7\xfaW\xa4h\xfe>\x04\xac\x1a\xf4X=\x05\xec\t\xc7\xfc\r\a\xf3_ʼ˽\a\xa1\xaf\xe8Iӌ\xf7?\x9d\xa9Ք\x1d\xd1\xf5\x0e\x93\xaaM\xa7\xeb\x1af\xb7\xa5\\\x81=\xbc\xd1\xddY\xdcFr7+\x06F\x1f\x88\x06\xbfe\xbc'\xe2\x1bO\x16|5\xd0\xfcIe\x8f\xb3\xea6i8Ps\xe5\xb1\x1f2\x1ful\xa9\xf7\x15\xf8\x97\xa1\u007fȋ\xa2\xff\x00ג\u007f*\xfd\x14\xfd\x91\xfcc\xf6\xef\x87\xdaׂn\xa5\xcdƗ7\xda앏&\tOΠz,\x9c\x9f\xfa\xeb_\x94\xf8\x89\x96{l\x14q\x11Z\xd3z\xfa=?;~'\xeb>\x1c\xe6\x9e\xc7\x18\xf0\xf2zTZz\xad\u007f+\xfe\a\xd84QE~(~\xda\x14QE\x00\x14QE\x00|s\xfbl\xfcB\xff\x00\x84G\xf6M>\x19\xb3\x9f\xcaռWs\xf65\np\xc2\xd6<<\xec=\x8f\xee\xe3>Қ\xfcm\xaf\xaa\xff\x00l_\x88_\xf0\x9c~\xd8\xfa\xae\x9di?\x9b\xa3\xf8f1\xa5[\x05o\x94ʤ\x9b\x86ǯ\x98J{\x88־T\xaf\xe9^\a\xca~\xa3\x94\xd3M{\xd3\xf7\x9f\xcfo\xb9[\xe6\u007f2\xf1\xd6m\xf5\xfcޣO݇\xba\xbe[\xfe7\xf9\x05\x14Q_^|x\xaaJ\xb8e$0\xe8E9\x9e-\x8cҕ\x87\x03%\xfa(\xf7>\x9fQ]ǀ>\x19\xf8\xe3⇉\x1fM\xf0V\x856\xa6\xb10\x17\x9a\x84\xad\xe4\xd8\xd9{\xcb;|\xaa\u007f\xd9\x19c\xd8W\xe8\x8f\xc2\xcf\xd9O\xc0\xbe\x04{]cŭ\a\xc4/\x16G\x87F\xba\xb7ۦY\xbf_\xdd[\xb7\xfa\xc6\a\xf8\xe5ϲ\n\xf9\x8c\xfb\x8br\xfc\xa98\u05574\xff\x00\x95j\xfe}\x97\xaf\xc93\xea8\u007f\x84s\x1c\xd9ޔm\x0f\xe6{|\xbb\xbfO\x9d\x8f\xcb˻+\xeb\x01do\xec\xaflV\xf2\x01=\x93\\[\xbcb\xea#\xd2H\xcb\x01\xbdx\xea\xb9\x15Z\xbft|Y\xe1o\rx\xf3\xc2Rh\x1e3\xd0\xec<I\xa47+\x05\xdas\x01\xfe\xf4.0\xd10\xecP\x8f\xc4q_\x9f\xff\x00\x14\u007fc\xcf\x10\xe8\x9fh\xd6>\x15\xdd\xdc\xf8\xbfI\x19fЯ\x19WS\x80u\"'\xe1.@\xf4\xf9d\xf6j\xf0\xf2/\x11\xb0\x18\xd9{<J\xf6R{]\xde/\xfe\xde\xd2\xcf\xd5%\xe7\xd0\xf7s\xef\x0e1\xf8\x18\xfbL;\xf6\xb1\xebei/\xfbw[\xfc\x9b~G̾\x00\xff\x00\x91\xeao\xfa\xf2\u007f\xe6\xb5\xec\x06\xbc\x8b\xc0\xb1Mm\xf1*\xfa\xd2\xe6\x19\xed/-\xedd\x8e\xe2\xdex\xda9a`W*\xe8\xc0\x15>\xc4W\xae\xd7\xd9b>3㰊\xd0\x0eƊ\x0f\xad\x1d\xab\x03\xa0+\xea\x9f\xd9K\xc1_\xdb\u007f\x19\xef<Yw\x16\xeb\x1d\x06\x0f\xdc\x128k\x99AU\xfa\xedM\xe7ؕ5\xf2\xb7z\xfdb\xf8\x19\xe0\xaf\xf8A\xff\x00g\r\x0e\xc2x|\x9dR\xf5~ߨ\x820\xc2Y@!O\xba\xa0D>\xeak\xe3x\xe74\xfa\xa6[(E\xfb\xd5=\xd5\xe9\xd7\xf0\xd3\xe6}\x9f\x03e\u007f[̣9/v\x9f\xbc\xfdz~:\xfc\x8f_\xa2\x8a+\xf0S\xf7\xb0\xa2\x8a(\x03\x83\xf8\x9f\xe0k?\x89_\x00\xfcS\xe0\x8b֎5\xd5,Z8&uȂq\x87\x86Lw\xdb\"\xa3c\xbe1_\x86\x1f\x12\xbe\x0f\xfc@\xf8M\xe21a\xe3=\n{8dr\xb6\xba\x8c?\xbc\xb3\xba\xef\xfb\xb9@\xc18\xe7i\xc3\x0e\xe0W\xf4\x17Y\x9aƋ\xa4x\x87\xc3wZ>\xbb\xa6X\xeb\x1aU\xcal\xb8\xb4\xbc\x81e\x8aA\xe8U\x81\x15\xf6<+\xc6\x15\xf2f\xe1\xcb\xcdNN\xedl\xef\xdd?\xeb\xe4|g\x15\xf0m\f\xe5)\xf3rԊ\xb2{\xa6\xbb5\xfd[\xcc\xfen\xa8\xaf\xd0?ړ\xf6V\xf0\xa7\xc3χ:\x87ğ\x03\xea3\xe9\x9aTW1\xc7u\xa1\\\xe6US,\x81\x01\x86Bw\x00\t\xfb\xaf\x9e\xf8n\x82\xbf?+\xf7\xec\x9b9\xc3fxe^\x83\xd3mti\xf6\xff\x00\x86?\x9f\xb3\xac\x97\x13\x95\xe2^\x1f\x10\xb5\xdfGt\xd7\u007fî\xa1E\x14W\xaay!J\xac\xe9\"\xc9\x13\x14\x95\x1820\xec\xc0\xe4\x1fΒ\x8a\x00\xfa/N\xbfMS@\xb2\xd4c\xe0\\D\x1d\x87\xf7[\xa3\x0f\xfb\xe8\x1a\xd03\xdc\xff\x00\xc2-\xad\xe8iy}o\xa3\xeb0y\x1a\xad\xa4\x13\x94\x8e\xe9\x03\x06\x1b\x97\xa6\xe0@!\xb1\x91\x8e\xb8\xaf3\xf8{\xa8\uedff\xd1\xe4nP\xfd\xa6\xdc\x1fC\x80\xe3\xf3\xc1\xfcMzEy5i\xa5&\x9a\xfe\xb7G\xb5B\xa3\x94T\x93\xfe\xb6g\x8fk>\b\xd4l\x03\xdci\xa5\xf5[1\xc9P\xb8\x9e1\xee\xa3\xef\x0fu\xfc\xab\x88\xceA\xf6\xe0\xfbW\xd3 \xe0\xe4dW;\xadxcJ\xd6\xc3I4f\xd2\xfc\x8e.\xe0\x001\xff\x00|t\u007fǟz駉{H䫃\xeb\x12\xee\x85\xff\x00\".\x8b\xff\x00^Q\xff\x00*\xf6\u007f\x83\x1e2\xff\x00\x84\x1f\xf6\x8b\xf0\xf6\xb14\xbeV\x9d,\xdfc\xd42p\xbeL\xbf+1\xf6S\xb5\xff\x00\xe0\x02\xbc\x87O\xb6k/\x0f\xd8YH\xeb+\xdb\xc0\xb1\x17Q\x80\xd8\xee\x01\xab\x9d\xeb\xcd\xc6a\xa1\x89\xa3:S\xdaI\xaf\xbc\xf508\x9a\x98j\xb0\xab\r\xe2\xd3\xfb\x8f\xdc\n+\xc9~\a\xf8\xc7\xfe\x13oٻ\xc3\xfa\x94\xd2\xf9\xba\x95\xac\u007fa\xbf$\xe5\xbc\u0600]\xc7ݗc\xff\x00\xc0\xab֫\xf9\x8f\x19\x85\x9e\x1a\xbc\xe8\xcfx\xb6\xbe\xe3\xfa{\a\x8a\x86&\x84+Ci$\xfe\xf0\xa2\x8a+\x98\xe9\n\xf3ϋ\x1e9\x83\xe1\xb7\xec\xe9\xe2\xdf\x1aLc\xf34\xdb\x06kD~\x92\\6\x12\x14>\xc6F@}\x89\xafC\xafͿ\xdb\xf3\xe2\x17\x97\xa7\xf8Kᅔ\xff\x004\xacu}UT\xf3\xb4n\x8e\x05>\xc4\xf9\xccA\xfe\xea\x1a\xf7xk*\xfe\xd1̩PkF\xee\xfd\x16\xaf\xfc\x8f\a\x89\xb3e\x97e\x95q\x17\xd5+/W\xa2\xff\x003\xf3J\xe6\xe6{\xcdF\xe2\xee\xeai..\xa7\x91\xa4\x9aW9gf9,OrI&\xa0\xa2\x8a\xfe\xa6J\xda\x1f\xcam\xb7\xab\n?#\xcfC\xc8>\xc7\xd4{QE0?C>\x0e~\xd6~\x10\x8f\xc3Z_\x83|{\xa1i?\x0f\xa2\xb5Q\x15\x9e\xa5\xa1\xdayzA\xed\x99`\\\xb5\xbb\x1e\xee7)\xef\x8a\xfbz\t\xed\xee\xf4\xcbk\xeb+\x9bk\xeb\v\x98\xfc\xcb[\xabY\x96Xg_\xef#\xa9*\xc3\xdc\x1a\xfc\x15\xafL\xf8k\xf1\u007f\xc7\xdf\t\xf5S'\x845p4\x99$\xdfw\xa1_)\x9bO\xb9\xf5&<\xfe\xed\xbfی\xab}k\xf2\xde\"\xf0ڎ!ʶ\x06\\\xb2z\xb8\xbf\x85\xfa=\xd3\xfbס\xfa\xa7\r\xf8\x95[\f\xa3G\x1b\x1ex-\x14\x96\xe9zl\xd7\xdc\xfdO\xda3\\\x9f\x8b\xbco\xe1o\x03i+u\xe2}Qm$\x91w[X¾m\xdd\xcf\xfb\x91\x0eq\xfe\xd3a}\xeb\xe5+\xafڛZ\xf1GË\x19\xfc'\xe1\xd8\xfc%\u007f:\xb2^\xdd\xdc\xdc\v\xb6\x86E8al0\x00^\xe1\xdc\x16\x1e\x9d\xeb\xc1\xae\xaen\xaf\xf5k\x8dB\xfe\xea\xebP\xd4.\x1bt\xf7WR\x99%\x94\xfa\xb3\x1eO\xf2\x15\xf2\xd97\x87x\x9a\x92\xe6ƾD\xba+9?\x9e\xa9/\xbd\xf9-Ϭ\xce<F\xc3S\x87.\x05s\xb7զ\x92\xf9h\xdb\xfb\x97\xa9\xe8?\x13\xfe \xc5\xf1'\xc7\x16\xda\xc1\U000363e2\xc9j\x8d\x14\x17\xbeX}Jx\xce\x06\xd9\xe7\x18\u07bc\f&\b^Ƽ\xe3ފ;\xd7\xeb8<\x1d\x1c%\x18Ѣ\xad\x18\xec\xb5\xfdO\xc9q\x98\xcaتҭU\xdeR\xdd\xed\xf9\x05\x14\x1a8\xae\xa3\x98\xf5\xaf\x82\x1e\n\xff\x00\x84\xeb\xf6\x8c\xd0\xf4\xc9\xe2\xf3\xb4\xbbG\xfbv\xa2\bʘb \xed>\xcc\xc5\x13\xfe\x05_\xac\xf5\xf2\x97\xec\xa1\xe0\xaf\xeco\x83\xd7\xde/\xba\x8bm\xf6\xb9>\xdbrÕ\xb6\x88\x95\x18\xf4\xdc\xfbϸU5\xf5m~\r\xc79\xa7\xd6\xf3'\b\xbfv\x9f\xbb\xf3\xeb\xf8\xe9\xf2?z\xe0\\\xaf\xea\x99j\xa9%\xefT\xf7\xbe]?\r~aE\x14W\xc6\x1ff\x14QE\x00\x14QE\x00|\xa5\xfbi\xff\x00Ɂ\xf8\x8f\xfe\xc2\x16_\xfaP\x95\xf8\xa9_\xb5\u007f\xb6\x9f\xfc\x98\x1f\x88\xff\x00\xec!e\xff\x00\xa5\t_\x8a\x95\xfb\xf7\x86\x1f\xf2(\x97\xf8\xdf\xe5\x13\xf9\xf7\xc5/\xf9\x1b\xc7\xfc\v\xf3\x90QE\x15\xfa1\xf9\xb8QE\x14\x01\xa5\xa3\xea'H\xf1M\x8e\xa22R)?z\a\xf1Fxa\xf9\x1c\xfe\x15\xf41\xc6r\xad\xb9\x0f*ã\x03\xd0\xfeU\xf3?Q\xcf\"\xbd\xbb\xc1\xba\x97\xf6\x8f\x81`\x8eF\xddqd\xdfg\x93=H\x1c\xa1\xfcW\x8f¹1P\xd1H\xed\xc1\xcfW\x13\xa9\xa0\xd1Eq\x1e\x80\x0e\x94Q\xfa\xd0h\x03\xeb\x9f\xd9'\xc6_ٿ\x145\u007f\x06]M\x8bm^\xdf\xed\x16jǤ\xf1\x02H\x03\xfd\xa8\xf7\x13\xff\x00\\\xc5~\x84W\xe2׆u\xeb\xcf\v\xfcA\xd1|G`\u007fҴ\xeb\xc8\xee\x10g\x01\xf6\xb6J\x9fb2\x0f\xb15\xfb+\xa4\xeavz߅\xf4\xedcO\x93ΰ\xbe\xb6\x8e\xe2\xdd\xff\x00\xbc\x8e\xa1\x94\xfeDW\xe2\xde\"e\x9e\xc7\x19\x1cLV\x95\x16\xbe\xab\xfe\x05\xbf\x13\xf6\x9f\x0e\xb3?m\x82\x96\x1aOX==\x1f\xfc\x1b\xfe\x06\x85\x14Q_\x9e\x1f\xa2\x05~\x18~\xd6\x17w7_\xb7\xff\x00\xc4?\xb4\xcf$\xfeM\xcc\x11E\xb8\xfd\xc4[h\xb0\xa3\xd0\x0f\xea}k\xf7>\xbf\n\u007fj\x8f\xf9?\xff\x00\x89?\xf5\xfd\x0f\xfe\x93C_\xa6xX\x97\xf6\x9d_\xf0?\xfd*'\xe6\x1e*\xb6\xb2\xca_\xe3_\xfaL\x8f\x9fh\xa2\x8a\xfd\xe0\xfc\f(\xa2\x8a\x06\x14Ws\xe1\xef\bG\xadxB{\xd9\xeef\xb3\x9d\xe6+h\xe1w.\x17\x86,\xbd\xc1<d\x1e\xd5\xce\xea\xda\x16\xa7\xa2N\x16\xfe\xdf\x10\xb1\xc4w1\x9d\xd1?ѻ\x1fc\x83P\xaaE\xbb_SGJJ*V\xd0\xf5_\x04\u007f\xc94\xb4\xff\x00\xaf\x89\xbf\xf4*\xea\xeb\x95\xf0G\xfc\x93KN\xbf\xf1\xf17\xfe\x85]Vkͩ\xf1\xb3գ\xf0/@\xa2\x8a*\v\x0e\xf5\xbd\xe1\x8f\x0f\xdex\xaf\xe2\x1e\x8d\xe1\xcd<\x1f\xb5\xea7i\x02\x1d\xb9\b\x18\xf2\xe7\xd9FX\xfb\x03X=\xab\xeco\xd9\x1f\xc1_m\xf1\xae\xb3㫸\xb3\x06\x9b\x1f\xd8\xec\x18\x8e\f\xf2\f\xc8\xc3\xddc }%\xaf'<̖\x03\x03R\xbb\xdd-=^\x8b\xf1=l\x8f-x\xfcu:\vf\xf5\xf4Z\xbf\xc0\xfb\xabHҬ\xf4?\ni\xba.\x9d\x1f\x93acj\x96\xf6\xe9舡F}\xf0:֍\x14W\xf3\\\xa4\xe4\xdboV\u007fKF*)$\xb4AE\x14T\x94\x14QE\x00\x14QE\x00|\xa7\xfbh\xa3\xb7\xec\x05\xe2R\xa8\xcc\x16\xfaȱ\x03;G\xda\x10d\xfa\f\x90?\x1a\xfcS\xaf\xe8\xcb\xc6~\x16Ӽo\xf0\xa7\xc4>\x11\u0557:~\xada%\xac\xad\xb7&=\xca@q\xfeҜ0\xf7\x02\xbf\x9e_\x10\xe8Z\x8f\x85\xfcy\xac\xf8oW\x8b\xc8\xd54\xbb\xd9m.\x93\xb0\x927*\xd8\xf5\x19\x1c\x1e\xe2\xbfq\xf0\xb3\x1dNX:\xb8o\xb5\x19s|\x9aK\xf4\xfc\x8f¼U\xc0Ԏ6\x96'\xec\xca<\xbf4\xdb\xfco\xf9\x98\xf4R\x12\x15K\x1e\x80d\xd5\xfb\xfd6\xff\x00L\x96$\xbf\xb6\x92\xdcJ\x81\xe1s\xcaH\b\xc8*Ã\xc1\xe9ֿR\xb9\xf9U\x99F\x8e\xd4Qژ\x05v>\a\xd4~\xc5\xe3e\xb4v\xc4\x17\xe9䜞\x03\x8eP\xfey\x1f\x8dqԪΒ,\x911IQ\x83#\x0e\xcc\x0eA\xfc\xeag\x1eh\xb4T%\xcb$ϥ\xa8\xebT\xf4\xeb\xf4\xd54\v=F>\x05\xc4A\xd9G\xf0\xb7F\x1f\x81\x06\xae\u007f*\xf2Z\xb1\xed&\x9a\xba\x0e\xf4v\xa2\x8a\x06\x1f\x8d~\x90\xfeʾ1\xfe\xdd\xf8\x11q\u1ad9w_h\x17>Z\x02y6\xf2\x92\xf1\x9f\xc1\xbc\xc5\xf6\x01k\xf3z\xbd\xcf\xf6w\xf1\x8f\xfc\"?\xb4ΐ\x93\xcb\xe5\xe9\xba\xc0\xfeͺ\xc9\xe0\x19\b\xf2\xdb\xf0\x90 \xcf`Z\xbec\x8b\xf2Ϯ\xe5\x95\"\x97\xbd\x1fy|\xbf\xcd]\x1fO\xc2\x19\x9fԳ:roݗ\xba\xfe\u007f\xe4\xec\xcf\xd4\xea(\xa2\xbf\x9e\x8f\xe8`\xaf\u009fڣ\xfeO\xff\x00\xe2O\xfd\u007fC\xff\x00\xa4\xd0\xd7\xee\x16\xb5\xaeh\xfe\x1c\xf0\xd5ֳ\xaf\xea\x96\x1a6\x95l\x9b综\x9db\x8e1\xee\xc4\xe3\xf0\xef_\x83\x9f\x1f\xfcS\xa1x\xd7\xf6\xc2\xf1ω\xfc5xچ\x87}x\x86\xd2\xe4\xc4\xd1\xf9\xa1a\x8d\v\x05`\b\x1b\x94\xe3 \x120p+\xf5\x1f\v(\xd4\xfa\xfdZ\x9c\xaf\x97\x96\xd7\xe9{\xad/\xdc\xfc\xaf\xc5j\xd4\xfe\xa1J\x9f2\xe6罺\xda\xcf[v<z\x8a(\xaf\xdc\xcf\xc2XS\x927\x96x\xe1\x8f\x1ed\x8e\x113\xd3$\xe0\u007f:mY\xb2\xff\x00\x90\xe5\x8f\xfd}G\xff\x00\xa1\x8a\x06\x8f\xa1\xad,\xe3Ӵ\x9b]>\x11\x88\xad\xa2\x11\x0f|u?\x89\xc9\xfcjvU\x92\a\x8eDIbq\x87\x8d\xd42\xb0\xf7\a\xadK/\xfc}I\xfe\xf9\xfeu\x1fn\x95\xe3\xde\xe7\xb7d\x8a\xb6V6\x9an\x9e-,a\x16\xf6\xc2Fu\x8c\x12B\x969 g\xb6{v\xabTP\x01,\x00\x04\x93\xd0\n\x1b\x04\xad\xa0Q\xfc\xaa\x92\xeaV/\xaf6\x97\x1d\xccr߬fG\x8a3\xbb\xcb\x03\x1fx\x8e\x01\xe7\xa7Z\xbbE\x814\xf6\x1c\xaa\xcf*\xa4j\xce\xecp\xaa\xa3$\x93\xd8W\xeb\xdf\u009f\x06\xaf\x80\xfe\x03x\u007fì\x8a\xb7\xd1ۉ\xaf\xc8\xfe+\x89>i9\xef\x82v\x83袿>\xbfgO\x05\xff\x00\xc2a\xfbI\xe9\x93\xdcE\xe6iZ(\xfeк\xc8\xf9Y\x90\x8f)\u007f\x19\n\x9cw\n\xd5\xfa\x93_\x92x\x8f\x9asT\xa7\x83\x8b\xdb\xde~\xbd?\v\xbf\x9a?\\\xf0\xdf+\xb5:\x98\xc9-\xfd\xd5\xe9\xd7\xf1\xb2\xf90\xa2\x8a+\xf2\xe3\xf5 \xa2\x8a(\x00\xa2\x8a(\x00\xa2\x8a(\x00\xaf\xc9/۳\xe1\xcf\xf6\a\xc7\xdd+\xe2\x05\x8c\x1b4\xef\x12\xdbyW\x85W\x85\xbb\x81B\x92{\r\xf1\xf9dz\x94s_\xad\xb5\xe1_\xb4\x87Ï\xf8Y߲7\x89\xf4;h<\xfdj\xce/\xed- \x05\xcb\x1b\x88A`\x8b\xee\xe8^?\xf8\x1d}G\a\xe6\xff\x00\xd9٥:\x8d\xda2\xf7e\xe8\xff\x00\xc9\xd9\xfc\x8f\x96\xe3,\x9f\xfbG*\xa9M+\xca>\xf4}W\xf9\xab\xaf\x99\xf83'\xfc{\xc9\xfe鯣\xa3\x86\x1b\x9f\rZ\xdb]A\x15ͳ\xdaž)Wr\xb7\xc8;\u007f^\xb5\xf3\x8c\x9f\xf1\xef'\xfb\xa6\xbe\x95\xb0\x8eY\xedt\xab[xf\xb9\xba\x9a\b\x92\x18!\x8c\xbc\x92\xb1E\xe1Td\x93\xf4\xaf\xe9\x1c[\xb2L\xfek\xc1+\xb6\x8f9\xd6|\x02~{\x8d\x06L\xf76S\xbf?\xf0\a?ɿ:\xf3i\xa3\x92\xde\xfeKK\x98\xde\xde\xee?\xf5\x90Ȼ]~\xa3\xfa\xd7\xe9G\x81\xff\x00g]WQ\x11j^>\xb9\x9bA\xb18e\xd2-\\\x1b\xd9G\xa4\x8f\xca\xc2=\x86\xe7\xfaW\xbcx\x83\xe0\xdf\xc2\xdf\x13\xfc8\x87\u009a\xaf\x82\xf4\xa1\xa4ۃ\xf69m\x17ɼ\xb4c\xd6H\xee\x06d\xdez\x9dŁ\xee\r|67\xc4\\\xbf\tYRW\xa9\xdd\xc6\xd6_=\xa5\xf2\xd3ϡ\xf7X/\x0e3\x1c]\x17WJ}\x94\xafw\xf2\xe9\xf3\xd7˩\xf8\xb9E}W\xf1O\xf6N\U00077092\xebY\xf0T\x97\x1f\x10\xbc-\x18.\xf1\xc3\b]R\xcd\aS$\vĪ?\xbf\x16O\x1c\xa8\xaf\x94\x95\x95\x81\xdas\x82U\x87B\xa4u\x04v#\xd0\xf3_g\x96f\xd8L\u0097\xb5\xc3TR_\x8a\xf5[\xaf\x99\xf19\x9eS\x8b˫{,M7\x17\xf8?G\xb3^\x87\xa9|=\xd4w[_\xe8\xf27(~\xd3n=\x8f\x0e\a\xe3\x83\xf8\x9a\xf4z\xf9\xebF\xd4[I\xf1M\x8e\xa23\xb2)?z?\xbd\x19\xe1\xc7\xe4\u007fJ\xfa\x18\xe3#k\x06R2\xac;\x83\xd0\xfeT\xf10\xb4\xafܬ$\xef\vv\x13\xbf\xbd\x1e\xb4Q\u07b9Π\xfaӑ\xda9\xd6DvGR\x19YN\n\x91Ѓ\xebM\xa3\xb5\x00~\xc2\xfc2\xf1rx\xe7\xe0_\x87<K\xbdZ\xe6\xe6\xd4-\xe0\x1f\xc3:|\x92\x8cv\x1b\x94\x91\xecEy'\xed\x13\xfbFi\x9f\x034=2\xce\r$\xf8\x83Ś\xacRIeh\xd2\xf9p\xc0\x8aB\xf9\xb3\x11\xf3`\x93\x85U\x1f6\xd6\xe5q\x9a\xf2\xff\x00\xd9\v\xc6;/\xbcG\xe0K\xa9~Y\x14j6\nO\xf1\r\xa92\x8f\xa8\xf2\xc8\x1f챯\x81\xbfiO\x88_\xf0\xb2\u007fl/\x15\xeb6\xf3\xf9\xfa=\x94\xdfٚS\x06ʘ %w)\xfe뿙 \xff\x00~\xbf\"\xcax6\x9d^ \xabB\xacoJ\x1e\xf7\xaa\u007f\n\xfe\xbb3\xf5\xccߌ\xeaQ\xe1\xfaU\xe9J\xd5g\xee\xfa5\xf1?\xeb\xba9/\x89?\x17\xbc\u007f\xf1gģQ\xf1\xa6\xbb=\xf4Q\xb9k]>/\xdd\xdaZ\xf6\xfd\xdcC\x80q\xc6\xe3\x96=ɯ4\xa2\x8a\xfd\xbf\x0f\x87\xa5B\x9a\xa7J*1[%\xa2?\f\xc4bjר\xeaU\x93\x94\x9e\xed\xbb\xb0\xa2\x8a+c\x10\xab6_\xf2\x1c\xb1\xff\x00\xaf\x98\xff\x00\xf41U\xaa͗\xfc\x87,\u007f\xeb\xea?\xfd\fP\xc1n}%/\xfc}I\xfe\xf9\xfeu\x1f\xe7O\x97\xfe>d\xff\x00|\xff\x00:ex\xe8\xf7L\xddOX\xd34\x8bQ.\xa1t\x91\x12>H\x97\xe6\x95\xfe\x8a9\xfcN\a\xbdyf\xb5\xe3]KRW\xb7\xb1\r\xa5X\xb7\x04#fi\a\xfbO\xdb违z^\xaf\xe1\xfd+[\x8f7\xb0\x14\xb9\v\x84\xbb\x87\xe5\x95\a\xa6\u007f\x88{\x1fҼ\xa7Z𖩣\a\x9c(\xd44\xf1\xff\x00/0)\xca\x0f\xf6ת\xfdy\x1e\xf5ՇT\xfa\xeeqb]^\x9b\x1a\x1f\x0f\x80\x1e9\x98\x00\x00\xfb\x13\x9f\xfcyk\xd8;\u05cf\xfc? \xf8\xe2b\b#\xec/\x82\x0f\xba\xd7\xd2\x1e\x00\xf0\xa5ǎ>2x\u007f\xc2\xf0o\x02\xfa\xe9Vw^\xb1¿4\xaf\xf8 c\xf5\xc5a\x8f\xad\nJU&쒻\xf4F\xf9m\x19\xd5\xe5\xa7\x05vݗ\xab?@\u007ff\x1f\x05\u007f\xc23\xfb=ǭ\xdc\xc3\xe5\xea~!\x90]\xb1#\f \\\xac+\xf4 \xb3\x8f\xfa\xe9_HT\x16\xb6\xd6\xf6Zm\xbd\x9d\xa4Iok\x04K\x141 ¢(¨\xf6\x00\x01S\xd7\xf2\xf6g\x8e\x9e7\x17R\xbc\xb7\x93\xbf\xf9/\x92\xd0\xfe\xa4\xcb0\x10\xc1a)Ў\xd1V\xf9\xf5\u007f7\xa8QE\x15\xc2w\x85\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x1f\x84\xff\x00\xb5\a\xc3\u007f\xf8V\xff\x00\xb5\xe7\x89\xf4\xbbX>Ϣj\xad\xfd\xa9\xa5m\\*\xc39b\xc8\a`\x92\t\x10\x0fE\x1e\xb5\xf4/\xec\xe9\xf1\xc3\xe0\xfe\x9b\xa6X\xe8\x1aΕo\xf0\xfbƍ\x12[\xb6\xbf}9\x9e\xdbQ \x05\x00\\76\xb9\xff\x00\x9el\x02\u007f\xb4k\xdd\u007fno\x87?\xf0\x93~\xce6>9\xb1\x83~\xa9\xe1{\x9c\xdc\x15\x1c\xbd\x9c\xc5Q\xfau\xda\xe26\xf6]\xe7ֿ!\b\x05H \x10F\b#\x83_\xd0YM:<K\x90\u009dy\xb4\xe3\ued9d\xb5]\xfa;\xab;5\xe9g\xa9\xfc\xf3\x9bԭ\xc3Y\xfc\xeaP\x82i\xfb\xca\xea\xfa>\xddU\x9d\xd5\xd3\xff\x00#\xf7\xc7i\x01\x0f\x05]\x03\xc6\xcaAWSє\x8e\b=\x88\xe0\xd3y\xcd~=\xfc,\xf8\xfb\xf1\v\xe1CCa\xa5^\xa6\xbf\xe10\xf9\x93ú\xb3\xb3ۨ\xee`q\xf3۷\xfb\x9f/\xaa\x9a\xfb\x0fS\xfd\xb4~\x1eC\xf0\xc2\x1dOG\xf0\xe7\x89o\xfc[0+\xfd\x81v\x16\x18\xad\\\x0f\xbd-\xd2\xe5^<\xf4\xf2\xd7sz-~o\x9ax}\x9bak\xa8R\x87\xb5\x8bٯ\xfd\xb9?\x87\xd6\xf6\xf3?JʼE\xcaqT\x1c\xebK\xd9I-S\xd7\xff\x00\x01k\u007fK_\xc8\xfa\xf2\xe2\xea\xdfO\xd2\xeeu;\xdb\xcb]6\xc2\xd1|ۋۙ\xd6\x18m\xd4\u007f\x13\xc8\xc4\x05\x1e\xe4\xfd+\xf3\x17\xf6\x95\xf8\x95\xf0_\xc7z\xfb\u007f\xc2\x0f\xe1\u007f\xed?\x16,\x83\xed~5\xb7&\xca\v\x80\x0f)\xe5c7y\xed+\x85\xc7PZ\xbcg\xe2Gſ\x1e\xfcW\xd5\xd6o\x18k&]6)\v\xd9\xe8\x96ja\xd3\xed\u007f\u074b?;\u007f\xb6\xe5\x98\xfa\x8a\xf3j\xfd\a\x84\xf8\t\xe5\xd5c\x89\xc4Tn\xa2\xe9\x16\xd2^M\xe8\xe5\xe9\xa2\xf2g\xe7|[\xc7\xdf\xdaT\xde\x1a\x854\xa9\xf7\x92M\xbfN\x91\xf5\xd5\xf9\xa0\xea9\xe4W\xb7x7R\xfe\xd0\xf0,\x11\xbbn\xb9\xb3o\xb3\xcaI\xe4\x81\xca\x1f\xc5x\xfc+\xc4k\xb1\xf06\xa3\xf6/\x1a\xad\xa3\xb6 \xbfO$\xe4\xf0\x1cr\x87\xf3\xc8\xfck\xf4<D9\xa1\xe8~y\x86\x9f-OS٨\xa2\x8e\xd5枨QG\xb5\x14\f\xbfa\xe2\xbdk\xc12^x\x9b\xc3\xd7\"\xd3W\xb3\xb2\x9cA)]\xc1w\xc6ў>\x8cq\xe8pk\xe5\x91\xd0W\xd0z\xf7\xfc\x88\xba\xd7\xfdy?\xf2\xaf\x9f\aJ\xea\xc1ӊr\x9aZ\xbb+\xf9-\xbf7\xf7\x9c8ړj0oEwo7k\xfeK\xee\n(\xa2\xbb\x8e\x00\xa2\x8a9\xec\x19\x8f`\xaaI?\x80\xa0\x02\xadX\f\xeb\xfax\xf5\xba\x8b\xff\x00C\x15QY^5teea\x90\xc0\xe4\x11Wt\xef\xf9\x18\xb4\xef\xfa\xfb\x8b\xff\x00C\x14\x9e\xc3[\x9fG\xcb\xff\x00\x1f2\x1f\xf6\x8f\xf3\xa8\xe9\xf2\u007f\xc7̇\xfd\xa3L\xaf\x1d\x1e\xe0\x1f^\xf4\xa0\x90r\x0e\x0f\xb1\xa4\xedE1\x19p\xe8\xbam\xb7\x89\x1fU\xb5\xb6[[\xb7\x8d\xa3\x94E\xf2\xc6\xe0\x90rW\xa0n:\x8a\xfb\xdf\xf6D\xf0V\"\xd7\xfc}y\x17-\xff\x00\x12\xed8\xb0\xed\xc3\xcc\xc3\xff\x00!\xa8#\xd1\xc5|Kgis\u007f\xabZ\xd8\xd9\xc2\xf7\x17\x973,0D\x83\x97v!UG\xb9$\n\xfd\x8b\xf0'\x85m\xbc\x13\xf0\x87@\xf0\xbd\xb6\xc2,-\x15%u\xe9$\xa7\xe6\x91\xff\x00\xe0NX\xfe5\xf0~ 殆\x01POީ\xa7\xfd\xba\xb7\xfd\x17\xde}\uf1f9J\xaf\x8fu\xda\xf7i\xeb\xff\x00o=\xbfW\xf7\x1dm\x14Q_\x87\x9f\xb8\x85\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x19zޏ\xa7\xf8\x87\xc1\xba\xb6\x81\xab@.t\xbdJ\xceKK\xb8\x8f\xf1\xc7\"\x14a\xf9\x13_\xcfG\x8f<#\xa8x\v\xe3'\x89|\x1b\xaa\x067\x9aM\xfc\x96\xc5\xca\xe3\xcdP~I\x00\xf4t*\xe3م\u007fEu\xf9q\xfb||8\xfb\x0f\x8d\xfc5\xf1B\xc2\xdfm\xbe\xa5\x1f\xf6f\xacʼy\xf1\xa9h\\\xfa\x96\x8c:\xfd!\x15\xfaW\x86\x99\xbf\xd5\xf1\xf2\xc3I\xfb\xb5\x16\x9f\xe2[}\xea\xff\x00\x81\xf9\x9f\x89\xd9?\xd60\x11\xc5E{\xd4\u07bf\xe1{\xfd\xce߉\xf9\xddE\x14W\xefG\xe06\n(\xa2\x80\nUgI\x16Hؤ\xa8\xc1\x91\xbd\x18\x1c\x83\xf9\xd2Q@\x1fE\xe9\xd7\xe9\xaa\xe8\x16z\x94|\v\x88\x83\xb0\xfe\xebta\xf9\x83W+\xcd\xfe\x1e\xea;\xad\xaf\xf4y\x1b\x94?h\xb7\x1eǇ\x03\xf1\xc1\xfcMzEyU!\xcb&\x8fb\x94\xf9\xe0\x98w\xa2\x8e\xd4T\x1a\x99z\xef\xfc\x88\x9a\xd7\xfdyI\xfc\xab\xe7\xc1\xd0W\xd0z\xef\xfc\x88\xda\xd7\xfdyI\xfc\xab\xe7\xc1\xd0Wn\x13\xe1g\x9d\x8d\xf8\x90QE\x15\xd6q\x85}\xa7\xfb\r|:\xff\x00\x84\xab\xf6\xa6\xbe\xf1\xb5\xec\x1ef\x91\xe1\x1b=Ж\x00\xab_\\+$c\a\xfb\x91y\xad\xecY+\xe2\xa7u\x8e\x17\x91\xce\xd4E,\xc7\xd0\x0eM~\xe4~\xca\x1f\x0e\x1b\xe1\xc7\xec_\xe1\xbb{\xdb\u007f#_\xd6\xc1\xd6u`\xdfyd\x9c\x02\x91\x9e\xff\x00$B$\xc7b\xa6\xbe'\x8f\xf3o\xa9e2\x8c_\xbdS\xdd^\x8f\xe2\xfc4\xf9\xa3\xee<>\xca>\xbb\x9bFr^\xed?y\xfa\xad\xbf\x1d~L\xf3ߌ\xbf\xb1_\x80\xfcxך\uf01e\x0f\x87\x9e.\x90\x99\x1d-\xe1Ιx\xfc\x9f\xde\xc01\xe5\xb18\xf9\xe3\xc7|\xabW\xe6?\x8d>\x16x\xfb\xe1?\xc4\xcd3I\xf1燮t\x86\x96\xfa5\xb3\xbfC\xe6\xd9^\xfc\xeb\xfe\xaap6\x93\x82\x0eÇ\x19\xe5k\xf7W\xc6\xff\x00\x11|\x1f\xf0\xef\xc3\xdf\xda>+\xd6`\xb0\xde\x0f\xd9\xedW\xf7\x977$\u007f\fq\x0f\x99\x8f\xbe0;\x91_\x9f\xbf\x16\u007fh\x9f\x10|Gү|;\xa6閚\a\x83g\xf9e\x82\xe6$\xb8\xbb\xbbPA\x05\u0602\xb1r\x01\x01>a\xfd\xfa\xf9\x1e\x06\xcesږ\x84\xa3\xcfG\xbc\x9d\xad\xe8\xf5o\xd2\xcf\xd5\x1fc\xc7Y.E\v\xce2\xe4\xad\xda:\xdf\xd5h\x97\xadף>z\x97\xfe>\xa4\xff\x00x\xff\x00:goZ?\x1a+\xf4\xc3\xf30\xf5\xa2\x8a(\x03\xe9_\xd9w\xc1_\xf0\x92~\xd0\x1f\xdb\xd7P\xf9\x9ao\x87\xa2\x17$\x91\x957\r\x95\x84}F\x1dǼb\xbfK+\xc3?g\x8f\x05\u007f\xc2\x1d\xfb6iMq\x17\x97\xaak\x1f\xf11\xbb\xc8\xf9\x948\x1eZz\x8cF\x17\x8e\xccZ\xbdο\x9e\xf8\xc34\xfa\xf6g6\x9f\xbb\x1fu|\xb7\xfb\xdd\xcf\xe8^\x0f\xca\xfe\xa3\x96A5\xefK\xde\u007f=\xbe\xe5`\xa2\x8a+\xe5Ϩ\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n\xf2ύ\u007f\x0f\xa2\xf8\xa1\xfb2x\xb3\xc1\xe5\x11\xaf\xaem\f\xbak\xb6\x06˨\xfexN{\x02\xca\x14\x9f\xee\xb3zש\xd1[\xe1\xb1\x13\xc3֍ZnҋMz\xa3\fV\x1a\x9e\"\x8c\xe9TW\x8c\x93Oџ\xcd\\\xb1K\x05̐\xcd\x1b\xc34lVH\xddp\xca\xc0\xe0\x82\x0fB*:\xfa\xa3\xf6\xc1\xf8s\xff\x00\b\x1f탪j\x16p\x18\xb4O\x13'\xf6\xad\xa1\x03\xe5YX\x91p\x99\xf5\xf32\xf8\xec$Z\xf9^\xbf\xab\xf2\xcc|1\xb8Jx\x88m$\x9f\xfc\x0f\x93\xd0\xfeJ\xcd0\x150X\xba\x98y\xef\x06\xd7\xf9?\x9a\xd4(\xa2\x8a\xee8B\x8a(\xa0\r-\x1bQ:O\x8alu\x1eJE'\xefG\xf7\xa3<8\xfc\x8e\u007f\n\xfa\x18\xe39R\x19N\n\xb7\xf7\x81\xe4\x1a\xf9\x9f\xa8\xe7\x91^\xdb\xe0\xddK\xfbC\xc0\xb0#\xb6\xeb\x9b&\xfb<\xa4\x9eH\x03(\u007f\x15\xe3\xf0\xaeLT4R;ps\xb3\xe5:\xaa(\xa2\xb8\x8f@\xcc\xd7?\xe4Gֿ\xeb\xcaO\xe5_=\x8e\x82\xbe\x84\xd7?\xe4Gֿ\xeb\xcaO\xe5_=\x8e\x82\xbbp\x9f\v<\xecgĂ\x8a(\xae\xb3\x8c\xf4\xff\x00\x83^\x10Ӽm\xfbIxgI\u05eeml|+k7\xf6\x9f\x88n\xae\xa4\x11\xc5\x1d\x95\xb9\x12H\xacǦ\xf6\xd9\x18\xf5\xf3+\xf4\x83\xe2O\xedg#\x99\U0010f176\x8a#\x19C\xe2\rB\x0e>\xb0@\xdd}\x9aL\x0e~\xe9\xaf\xce\u007f\x00i\xa2-\x06\xefT\x992\xf7R\b\xe1\xc8\xfe\x049'\xf1n\xff\x00\xecנu5\xf2\x99\xbeI\x86\xc7\xe2\xe3W\x10\xb9\x94\x15\x94z_\xab}\xde˶\x9b3\xeb\xb2|\xeb\x15\x80\xc1ʖ\x1d\U000b9ef9u\xb7D\x9fE\xbf\x9e\xbb\x975-KR\xd6|Cq\xab\xeb:\x8d\uec6b\\\x1f\xdf^^Ld\x95\xfd\xb2z\x0fE\x18\x03\xb0\xaatz\xf6\xa2\xbd(\xc5E$\x95\x92<\xf6ܝ\xde\xec=\xa8\xedE\a\xa1\xa6 \xafH\xf8K\xe0\xc6\xf1\xe7\xc7\xdf\x0f\xf8}\xe3i,\f\xfe~\xa0{\vx\xfeg\x04\xf6݀\x80\xfa\xb0\xaf7\xaf\xd0\x0f\xd9#\xc1_\xd9\xfe\x00\xd6<sw\x0e\u06ddRO\xb2X\xb1\x1c\x88#o\x9d\x81\xf4i8?\xf5\xc8W\x81\xc4\xf9\xa7\xd42\xea\x95S\xf7\x9e\x8b\xd5\xff\x00\x96\xff\x00#\xdfጯ\xeb\xf9\x8d:M{\xabW\xe8\xbf\xcfo\x99\xf6\x02\xaa\xaa\x05P\x15@\xc0\x00`\x01KE\x15\xfc\xe4\u007fG\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x1f#\xfe\xd9\xdf\x0e?\xe16\xfd\x91\xee\xb5\xeb8<\xddk\u0092\x9dF\x12\xab\x96kb6ܯ\xb0ى\x0f\xfdq\x15\xf8\xbf_ҍխ\xbd\xf6\x99sey\fw6\x97\x114S\xc5\"\xe5dF\x042\x91\xdc\x10H\xaf\xe7\xd7\xe2瀮>\x19\xfeѾ,\xf0\\\xc2C\x0e\x9f|\xdfb\x91\xfa\xcbl\xf8x\\\x9fS\x1b.}\x0eGj\xfd\xb3\xc2\xecߞ\x85L\x14\x9e\xb1\xf7\x97\xa3\xdf\xeez\xfc\xcf\xc4<S\xc9\xf9+\xd3\xc6\xc1i/u\xfa\xad\xbe\xf5\xa7\xc8\xf3\x8a(\xa2\xbfX?$\n(\xa2\x80\n\xec|\r\xa8\xfd\x8bƫh\xed\x88/\xd3\xc99<\t\a(\u007f<\x8fƸ\xeaUgI\x16H\x98\xa4\xa8\xc1\x91\xbd\x18\x1c\x83\xf9\xd4\xce<\xd1h\xa8O\x96I\x9fKP*\x9e\x9d~\x9a\xa7\x87\xec\xf5\x18\xf8[\x88\x83\xb0\xfe\xebta\xf8\x10k\xac\xf0υ<G\xe3-}\xb4\xdf\fiS\xea\xb7\t\x8f>@BAl?\xbd,\xa7\xe5A\xf5\xe7\xd0\x1a\xf1kU\x85\x189Ԓ\x8a[\xb7\xa2_3ޡJu\xa6\xa3M6\xde\xc9jߢ8\xddl\xe3\xc1:\xcf\xfdyI\xfc\xab\xe7\xa1\xd0W\xeb7\x85\xbfg?\t\xe9\xfa\r\xc7\xfc'\x0e<c}s\x03E5\xacl\xf0YB\x18`\xec\xc1\x0e펎H\xc1\xe4-|\xe3\xf1?\xf68\xd74\xa3q\xab\xfc'\xbf\x9b\xc5\x1ah˷\x87\xf5\tUu\b\x87\\C)\xc2\\\x0fEm\xae\u007fگ\x03/㼞\xa6!\xd0\xf6\x96\xed&\xad\x17\xf3\xe9\xea\xd2^g\xbb\x99p&qO\x0e\xab\xfb;\xf7\x8aw\x92\xf9u\xf96\xfb\x9f\x13\u0530\xc1-\xd5\xec6\xb6\xeaZy\xa4\x11\xc6\a\xa98\x14\xb7V\xd7V\x1a\xc5֝\xa8Z]\xe9\xfa\x8d\xab\x98\xeem.\xa1h\xa6\x81\x87\xf0\xba0\x05O\xd4Wk\xe0\x1d;\xed>(\x9fRu\xccVQ\xfe\xef\xd0\xca\xe0\x81\xf8\x85\xc9\xfcE}Ħ\x949\x8f\x86\x856\xe7\xca\xcfU\xb5\xb5\x86\xc3K\xb6\xb1\x80\x0f&\xda!\x1ac\xbe\a_\xc4\xe4\xfe5=\x1f\x8d';\xc7Ҽ\xa3\xd8AKފ(\x18{\xe2\x8a(\xa0F\x9e\x8b\xa4^\xeb\xfe0\xd34=:?6\xfe\xfe\xea;h\x17\xb6\xe7`\xa3>\xdc\xf2{\n\xfd\x93\xf0ރe\xe1\u007f\x00\xe8\xfe\x1dӆ,\xf4\xebD\xb7\x88\x91\x82\xdbF\v\x1frrO\xb95\xf0_\xec\x9b\xe0\xbf\xedo\x8b\x1a\x8f\x8cn\xe2\xdde\xa2\xc1\xe5Z\x96\x1c5Ġ\x8c\x8f]\xb1\xefϡu5\xfa\x1f_\x8c\xf8\x89\x9a{\\\\p\xb1zCW\xea\xff\x00\xc9~l\xfd\xa3ì\xaf\xd9a%\x8a\x92\xd6z/E\xfeo\xf2AE\x14W\xe7'\xe8\xc1E\x14P\x01E\x14P\x01E\x14P\x01E\x14P\x01E\x14P\x01E\x14P\x01E\x14P\x01_\x9b\xff\x00\xb7\xd7ß;H\xf0\xb7\xc5+\b2\xf6\xe7\xfb'W*?\x81\x8b<\x0e}\x00o1I?\xdfA_\xa4\x15\xc4|H\xf0U\x97\xc4_\x81^(\xf0U\xf9E\x87V\xb0xc\x91\xc6D2\x8f\x9a)1\xfeĊ\x8d\xff\x00\x01\xafs\x86\xf3g\x97f4\xb1\x1d\x13\xb3\xf4z?\xf3\xf5<.%\xcaVe\x96\xd5\xc3\xf5j\xeb\xd5j\xbf\xcb\xd0\xfew\xaa\xee\x9dc.\xa7\xae[i\xf0<Q\xcf;\x15\x8d\xa48\\\xe0\x9eOn\x94\xba\x9e\x9b{\xa3\xf8\x93P\xd25+w\xb4\xd4lnd\xb6\xba\x81\xfe\xf4R#\x15u>\xe0\x82+O\xc2\u007f\xf2R\xb4o\xfa\xef\xff\x00\xb2\xb5\u007fR\xb9\xaeNd\u007f+({\xfc\xb22\xaf\xb4\xfb\xdd2\xff\x00캅\xb4\xb6\xb3\xf6\x0e8qꧣ\x0f\xa5S\xaf\xa4.\xadmo\xf4\xf6\xb4\xbe\xb7\x86\xeeݿ圫\x90\x0f\xa8\xee\x0f\xb8\xaf4\xd6<\x01q\x1b\xb4\xda\v\xbd\xea\x1e~\xc7)\xfd\xe8\xff\x00q\xba?\xd0\xe0\xfdk\x1ax\x94\xf4\x96\x86\xd5p\xb2\x8e\xb1\xd5\x1euE++$\x8c\x8e\xa5]I\f\xa7\xa8#\xa8\xa4\xae\x93\x95\x9e\xf7\xf0/W\xf8k\x1f\x8b\x9fG\xf8\xab\xab\xea\x9aF\x82҉l\xa6\xb7\x1bṁ\xef%ă/\x1cg\x00\xeeQ\xeb\x92+\xf5\x93G\xb2Ѵ\xff\x00\x06\xe9\xf6\xfe\x1a\x83J\xb7\xf0\xe3&\xeb\x1f콦\xd6A\x8f\xbc\xac\xbc1\xf5$\x96\xf5\xaf\xc2\n\xf5O\x86\x9f\x19\xbc}\xf0\xa7S\xdd\xe1}[~\x90\xee\x1a\xebE\xbe\x06k+\x8ers\x19?#u\xf9\x93\a''5\xf9\xff\x00\x18\xf0}|\xd7\xf7\x94k4\xd7\xd9\u007f\x0f\xaa\xec\xfc\xdd\xfeG\xe8<\x19\xc6T2\x97\xec\xebQM?\xb4\xbe%\xe4\xfb\xaf->g\xec\xcb}\xceƔ\x8f^E|\xf3\xf0\xdf\xf6\x9a\xf8k\xf1\x03N[mV\xf6\x0f\x00\xf8\x95c\xdd-\x8e\xabq\xfe\x8d.\x06X\xc1pxn\x84\xecl6\x05`x\xeb\xf6\x8bH\x9e}3\xe1\xed\xa7\x9b %[\\\xd4!\xf9G\xbc\x10\x1e\xbe\xcf'\x1d\xc2\xd7\xe3\xd4xO6\xa9\x89x\u007fbԖ\xed藝\xf6\u007f+ߡ\xfb-n.\xcai\xe1V#\xdb)'\xb2Z\xc9\xf9[u\xf3\xb2\xeew\xff\x00\x1b|-\xf0\u007f\xc4\x1e\bK\xaf\x8bP\xdb[\xdd\b\x8a麕\xa3l\xd6\a\x1c\b\n\x82\xd2/\xfb.\x1a?\xa5~\u007f\xe9\xba>\x9f\xa1Z\\XiS\xde\xdd\xd8\xfd\xaaI!\xb8\xbc\x89#\xb8\x95\t\xf9L\x8a\x84\xa8`\xa0\f)\"\xb6\xb5\x1dCP\xd65\xeb\x8dSX\xbf\xbc\xd5u9\xcef\xbb\xbb\x94\xc9#\xfbd\xf4\x1e\xc3\x00v\x15S\xbd~\xd1\xc3y\x1dL\xaf\r\xec\xa5Y\xce\xfd>\xca\xff\x00\n\xe9\xe7\xdf{#\xf1n$\xce\xe9星k\x1a*\x16\xeb\xf6\x9f\xf8\x9e\xcf\xee\xd3k\xb0\xf7\xa4\xff\x00\x96\x83\xe9Kިj:\x8d\x96\x93\xa65\xfe\xa31\xb6\xb4B\x15\xa4\xd8[\xe6?ux\xeepq\x9cf\xbe\x85j|\xf3v/\xf7\xf5\xac]O\xc4:^\x93w\x15\xac\xf3\x19\xaf\xa4\x91Qma\xc3:\xee e\xbb(\xe7\xbf>\xd5\xe7z\u05ceo\xefw\xdb\xe9j\xfaU\xa1\xe0ɜ\xcf \xfa\xf4A\xec9\xf7\xae>\xc7\xfeC\x96G\xa97Q\x92O$\x9d\xe3\x93\xeb]P\xc3=\xe4q\xd4ŭ\xa2} \xc0\xac\x8c\xa7\x92\x0e2\x056\xa4\x97\xfe>d\xff\x00x\xff\x00:\xf6\x1f\x80\xde\n\xff\x00\x84\xdb\xf6\x91\xd1m.\"\xf3t\xad=\xbf\xb4/\xc1\x19\x05\" \xaa\x9fP\xceQH\xf4'Ҽ\xbcf.\x18l<\xebOh\xa6\xcf_\x05\x84\x9e+\x11\n0\xdeM/\xbc\xfd\a\xf8-\xe0\xaf\xf8A?gm\aH\x9a/+S\x9e?\xb6j \x8c7\x9f(\x04\xa9\xf7Uڟ\xf0\n\xf5Z(\xaf\xe6\\^&x\x9a\xf3\xad=\xe4\xdb\u007f3\xfao\t\x85\x86\x1a\x84(\xc3h\xa4\x97\xc8(\xa2\x8a\xe7:\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x02\x8a(\xa0\x0f\xc7_\xdbw\xe1\xc7\xfc\"_\xb5\x14^.\xb1\x83\xcb\xd2<Wnn\x18\xa8\u00ad\xdc{Rq\xff\x00\x02\x0693ܻzW\xca^\x13\xff\x00\x92\x95\xa3\u007f\xd7\u007f\xfd\x95\xab\xf6\x97\xf6\xad\xf8s\xff\x00\v\x17\xf6;\xd7㴃\xce\xd6\xf41\xfd\xaf\xa7m\x1f3\x18\x95\xbc\xd4\x1d\xce茀/v\xdb\xe9_\x886\x97w\x16:\x8cWv\x92\x98.\xa29\x8e@\x01*pFF{\xf3_ќ\a\x9b}\u007f(\x8c$\xfd\xea~\xeb\xf4\xe8\xfe\xed>L\xfen\xe3ܣ\xea\x19ħ\x15\xee\xd4\xf7\x97\xaf\xda_~\xbe\x8d\x1e\xf5\xabkzf\x89\x00mB\xe3d\xac3\x1d\xbcct\xb2}\x17\xb0\xf78\x15\xe5:\u05ccuMY^\xde\x02t\xcd=\xb81D\xff\x00\xbcq\xfe\xdb\xf5\xfc\x06\aֹWw\x92w\x96Wyes\x97\x91س1\xf5$\xf5\xa6\xd7\xd8ӡ\x18\xea\xf5g\xc7U\xc4\xca{h\x82\x8a(\xad\xcep\xa2\x8a(\x00 0!\x80`z\x82+\xac\xd1|a\xaai*\x90NN\xa9\xa7\x8e\x043?\u0383\xfd\x87\xea>\x87\"\xb9A֒\xa6QRVeFn.\xe9\x9fAi:ޙ\xad\xc1\xbbO\x9ft\xca3%\xb4\x83l\xa9\xff\x00\x01\xee=\xc6Ek\xa2;\xbe\xd4R\xed\xd4\xe3\xb0\xf5>\x95\xf3B;\xc7:K\x13\xbcR\xa1\xca:1VS\xea\b\xe9_@x~\xfe\xee\xff\x00\xe1\xf6\x935\xe4\xdel\xb2C\xbaV\xda\x01\x91\xb7\x11\xb9\xb1\xd4\xe0\x0e}\xab\x82\xbd\x1eMQ\xe9a\xf1\x1c\xee\xcc\xd7 \x0e\x8c\x18\xf7+\xd3\xf3\xef_E~\xcd֖z\x8f\x8c\xbc}\xa7jVVZ\x9e\x9ds\xa1C\x1d͝\xe4\v43\xaf\xda>\xeb\xa3\x02\x18}\u007f\n\xf9ξ\x93\xfd\x98\xff\x00\xe4\xa5\xf8\xd3\xfe\xc0\xb0\xff\x00\xe9@\xaf\x94\xe2\xf6\xd6M]\xae\xcb\xff\x00J\x89\xf5\xbc!\x15,\xe6\x82kv\xff\x00\xf4\x96b\xfcQ\xfd\x8e4\xadD\\\xeb?\t/a\xd0o\xb9g\xf0ޥ96R\x9e\xb8\xb7\x9c\xe5\xa1>\x89&\xe4\xff\x00ik\xe1\x1dSÞ \xf0\x87\xc4\xc8<=\xe2\xad\x17R\xf0\xee\xb9\x05\xccfK;\xe8LnF\xf1\xf3)\xe8\xe8{2\x92\x0f\xad~\xe1\xdf_X\xe9Z\x1dƧ\xaa_Y\xe9zm\xb8\xcc\xf7wr\x88\xe2O\xab\x1e\xfe\xc3$\xf6\x06\xbe:\xf8\xc9\xf1s\u009e:\xf0\xc3xSK\xf0\xbe\x9f\xe2->)7A\xad\xeb6\xc4=\xb3\x83\x9d\xf6k\xc3\xc6x\x1f;\x10\x0f\xf7\r|\xb7\x05qVqZj\x85H:\xb0[\xc9\xe8\xe3\xeb'\xa4\xbd\x1f\xbc\xfb\x9fO\xc6\xfc'\x93P\x83\xafNj\x95G\xb4V\xaa^\x89k\x1f_\x87\xc8\xf9\xea_\xf8\xf9\x93\xfd\xf3\xfc\xeb\xf4k\xf6U\xf0W\xf6\x0f\xc1\v\x9f\x14]ŷP\xd7\xe7\xdd\x11a\xca\xdbFJ\xa7\xd3s\x17opV\xbe\x05\xf0\x87\x87/<c\xf1GC\xf0Ցo\xb4\xea7\x8b\t\x93n|\xb5'/!\xf6U\f\xc7\xd8\x1a\xfd\x90\xd34\xebM#ö\x1aV\x9f\x10\x82\xc6\xca\xdd-\xed\xe2\x1d\x11\x11B\xa8\xfc\x00\x15\xa7\x88\xb9\xa7\xb2\xc3C\t\x17\xac\xf5~\x8b\xfc\xdf\xe4O\x879_\xb5\xc4\xcf\x17%\xa44^\xaf\u007f\xb9~e\xda(\xa2\xbf\x1c?d\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\n(\xa2\x80\x10\x80\xcaC\x00A\x18 \xf7\xaf\xc0\xff\x00\xda\a\xe1\xd1\xf8]\xfbWx\xab\xc30\xc0a\xd2\x1a\u007f\xb6i\x1caM\xac\xd9tQ\xea\x10\xee\x8f>\xb1\x9a\xfd\xf1\xaf\x81\u007fo/\x87\x1f\xdb?\a\xb4/\x89\x16\x16\xfb\xaf\xb4\t\xfeɩ2\xaf-i3\x00\x8cO\xa2K\x80\a\xfd6c_{\xe1\xdeo\xf5<\xd1R\x93\xf7j\xfb\xbf?\xb3\xfe_3\xe0<F\xc9\xfe\xb9\x95\xba\xb1^\xf5/{\xe5\xf6\xbf\xcf\xe4~P\xd1E\x15\xfd\x0e\u007f:\x05\x14Q@\x05\x15\xd4\xf8?\xc0\xfe0\xf8\x81\xe3\b\xf4\x0f\x04\xf8sS\xf1.\xac\xd8/\x15\xac\u007f$\nN\x03\xcd!\xc2D\x99\xe3s\x90=3_\xa5?\a?ao\x0fh\xa2\xd7]\xf8\xc1w\a\x8buq\x87M\x02љt\xdbs\xd7\x12\xb7\rpÎ\xbbS#\xee\xb0\xe6\xbc\f\uf270\x19T/^~\xf7H\xadd\xfe]=]\x91\xf4\x19\x1f\ff\x19\xac\xadB\x1e\xefY=\"\xbe}}\x15\xd9\xf9\xd7\xe1_\x86>9\xf1\x97\x80<E\xe2\xed\x0fA\x9d\xbc#\xa1\xd8O{\xa8\xebwGɴ\t\n\x17x\xe2v\xff\x00].\a\b\x99䌑\\\x009P}k\xf7\xc3㭝\x9e\x9b\xfb\x04\xfcQ\xb1\xd3\xed-\xac,m\xfc#y\x1c\x16\xf6\xf1\b\xe3\x89D\f\x02\xaa\xae\x00\x03\xd0W\xe0z\xff\x00\xab_\xa5p\xf0\x9f\x11\xd4\xceiի((\xa8\xca\xc9o\xa5\xba\xbe\xff\x00$wqw\r\xd3ɪQ\xa5\x19\xb99F\xed\xed\xad\xed\xa2\xec-{\xbf\x85?\xe4\x9bh\xbe\xf0\x1f\xfd\r\xab\xc2+\xdd\xfc)\xff\x00$\xd7F\xff\x00\xae\a\xff\x00Cj\xfaLW\u008f\x9b\xc1\xfco\xd0\xe81\xcdzG\xc3_\x88m\xf0\xdfT\xf1&\xa5\x06\x92\xba\xc5\xfd\xfe\x9f\x1d\xad\xa4r\xcac\x866Y7\x97\x90\x8f\x98\x8ct\v\xc9=\xc5y\xbfz+\xc7\xc6`\xe8\xe2\xa8ʍex\xbdף\xbfOC\xdc\xc1\xe2\xea\xe1kF\xb5\x17iGg\xf2\xb7S\xa5\xf1W\x8c<M\xe3mqo\xfcO\xaa˨\xbcg6\xf6ʾ]\xb5\xb0\xf4\x8a!\xf2\xafז=\xcdsT\u007f\x93RE\x14\x93\xdc\xc5\x04\x11\xbc\xb3H\xc1#DRY\x98\x9c\x00\arkJ4iѦ\xa1N*1[%\xa2Fu\xabT\xadQΤ\x9c\xa4\xf7oVϲ\xff\x00d_\x05}\xa3\xc4z\xef\x8f.\xe1\x06+4\xfb\x06\x9e\xcc?\xe5\xab\x00Ұ\xf4*\x9bW\xe9#W\xdeU\xc2|3\xf0|~\x04\xf8\x1f\xe1\xef\r\x05Asml\x1a\xf1\x97\x9d\xf3\xbf\xcf!\xcfq\xb8\x90=\x80\xae\uefddx\x934\xfa\xfeaR\xb2~\xee\xcb\xd1m\xf7\xef\xf3?\xa2\xf8o+\xfe\xcf˩\xd1k\u07b5߫\xdf\xee\xdb\xe4\x14QExG\xba\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x14QE\x00\x15\r͵\xbd\xe6\x9f=\xa5\xe5\xbc7V\xb3!I\xa1\x99\x03\xa4\x8aF\n\xb2\x9e\b#\xb1\xa9\xa8\xa6\x9bN\xe0\xd5σ\xbe1\xfe\xc4>\x16\xf18\xba\xd7>\x17Oo\xe0\xfdq\xb2\xed\xa5M\x93\xa7\xdc\x1fD\xc6Z\x02O\xa6\xe5\xec\x15z\xd7\xe6_\x8e>\x1e\xf8\xcb\xe1ǌ_B\xf1\xa6\x83}\xa1ߌ\x98\xbc\xe5\xccs\xa88\xdf\x1c\x83+\"\xfb\xa95\xfd\x12\xd75\xe2\xbf\ax_\xc7>\x11\x9fA\xf1v\x87\xa7\xeb\xfaL\xbfz\v\xb8\xf7m?\xdeV\xea\x8c;2\x90G\xad~\x87\xc3\xfe!\xe3pV\xa7\x89\xfd\xe4?\xf2e\xf3\xeb\xf3\xfb\xd1\xf9\xd7\x10\xf8u\x82\xc6ަ\x1b\xf7s\xff\x00\xc9_\xaa\xe9\xea\xbe\xe6\u007f9\x94W\xe8g\xc6/\xd8gWҾ\u05ee\xfc#\xbc\x93]\xd3\xc6]\xf4\x1b\xe9\x00\xba\x88rH\x8aC\x85\x94z+a\xbd\xdc\xd7\xc0\x1a\x8e\x9b\xa8\xe8\xfa\xe5֙\xabX\xdei\x9a\x95\xb4\x86;\x8b[\xa8Z)ba\xd5YX\x02\x0f\xb1\xaf\xdar\x8c\xf7\x05\x99\xd3\xe7\xc3N\xfd\xd7U꿤~%\x9b\xe48첧&&\x16\xec\xfa?G\xfd3S\xc2\xfe.\xf1W\x82|[\x06\xbd\xe0\xff\x00\x10\xea\xbe\x1b\xd5\xe2#\x17\x163\x15\xde\x01\xce\xd9\x10\xfc\xb2'\xaa\xb8#ڿH>\x0e\xfeݺ]\xf8\xb5о3\xd8à^\xe0\"\xf8\x97N\x89\x8d\x94\xa7\x18\xcc\xf1r\xd0\x13ݗrd\xff\x00\x00\xaf\xcb\xea+\x1c\xeb\x86\xf0\x19\xa4-\x88\x86\xbd$\xb4\x92\xf9\xfe\x8e\xeb\xc8\xdb$\xe2\\~U;\xe1\xe7\xa7X\xbdb\xfe_\xaa\xb3\xf3?z\xfe8\xeaZv\xb1\xfb\x01|P\xd4\xf4\x9b\xfb-SM\xb9\xf0\x95\xeb\xdb\xdd\xdaN\xb2\xc32\x98[\xe6WRC\x0fpk\xf0I\u007fկҽ\x0f\xc0\xbe;\U000471ac\xb5?\ah\x9a\xfd埅|K\x04\x9av\xb1\xa4\xb8\x12\xdbK\x1c˱\xdd#l\x88\xe6\x00\xf1\"`\xe7\xaek#Y\xf0~\xa9\xa3\xa3\xcd\x10:\x96\x9e\xbf\xf2\xde\x15;\xd0\u007f\xb6\x9dG\xd4dW\x9b¼>\xf2XU\xa3)\xf3);\xa7\xb3\xb5\xad\xaf\x9f\xcc\xf4\xb8\xb3\x88Vw:U\xe3\a\x17\x15f\xb7W\xbd\xf4\xf2\xf9\x1c\xad{\xbf\x84\xff\x00\xe4\x9a\xe8\xdf\xf5\xc0\xff\x00\xe8m^\x0e\b \x10A\a\xa1\x06\xbd\xe3\u0083\xfe-\xae\x8d\xff\x00\\\x0f\xfe\x86\xd5\xf4\xb8\xbf\x85\x1f5\x83~\xfb\xf4:\n+\xab\xf0\x97\x82<Q\xe3\xaf\x11\r3\xc3\x1aE֧8ǚ\xe86\xc5\b?\xc4\xee~U\x1fS\xcfl\xd7\xdd_\r\xbfe\xcf\x0ext[\xea\x9e7\x92\x1f\x14k\x03\f,\xc0?b\x84\xfa\x10y\x97\xfe\x05\x85\xff\x00g\xbd|\x96sĘ\x1c\xb6?\xbd\x95\xe5\xfc\xab\u007f\xf8\x1f3\xecrn\x1b\xc7fR\xfd\xd4m\x1f\xe6z/\xf8?#\xe4/\x87\u007f\x05\xfco\xf1\"\xe69\xb4\xbb\x1f\xec\xfd\x13v$\xd5oAH\a\xae\xce\xf2\x1fe\xe3Ԋ\xfb\xfb\xe1\xc7\xc0\x8f\x04|;\x10\xde\xc5m\xfd\xbb\xe2$\xc1:\xa5\xf2\x02\xc8ޱ'\"?\xa8\xcb\u007f\xb4k\xda\"\x8a8-\xa3\x86\x18\xd2\x18cP\xa9\x1a(UP\x06\x00\x00t\x02\x9f_\x8f\xe7\x9cc\x8e\xcco\x04\xf9!\xd9u\xf5}\u007f\x05\xe4~Ñ\xf0n\a.\xb4\xda\xe7\x9fw\xd3\xd1t\xfc_\x98QE\x15\xf2Gօ\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05y/\xc5\x1f\x82?\x0e\xfe.\xe8mo\xe2\xed\x126Ԗ=\xb6ڽ\xa6\"\xbd\xb7\xf4\xdb&>a\xfeˆ_j\xf5\xaa+|6*\xb6\x1e\xa2\xa9JN2]V\x87>+\vG\x13Iӭ\x15(\xbe\x8fT~,|c\xfd\x91~\"|2\x17ZƉ\x1c\x9e8\xf0\x8cd\xb1\xbc\xb0\x84\xfd\xa6\xd9\u007f\xe9\xb4#'\x00uuܽ\xceޕ\xf2u\u007fK\x15\xf2\xbf\xc6?\xd9/\xe1\xd7\xc5!u\xabi\x90\xa7\x82\xbc^\xf9o\xed\x1d>\x11\xe4ܷ\xfd7\x87\x80\xdc\xf5e\xdaޤ\xf4\xafָ\u007f\xc4ͩf\v\xfe\xdf_\xaa\xfdW\xdc~G\xc4>\x18oW/\u007f\xf6\xe3\xfd\x1f\xe8\xfe\xf3\xf1\x97A\xff\x00\x91\xefE\xff\x00\xaf\xd8\xff\x00\x9d}\a\x96\x0f\x95$\x1c\xf0Es\xbe5\xf8\x15\xf1\x1b\xe1\a\xc5M\x1e/\x15h\xcf&\x92ڂ-\xb6\xb3c\x99l\xe7\xf9\xb8\xf9\xf1\xf21\xfe\xeb\x85ob9\xaf\xac>\x1b\xfe\xcd~0\xf1\x9bA\xa9k\xeb'\x84\xfc>\xf8a%\xc4\u007f\xe9S\xaf\xfb\x11\x1e\x80\x8f\xe2lz\x80\xd5\xf7\xf9\x86y\x80\xa7B8\x99U\\\x8dh\xef{\xfaw~G\xc0e\xb9\x16aR\xbc\xb0ʓ\xe7[\xabZ\xdeo\xb2\xf3>Q\xb8\xf8{\x1f\x8bu\xb8\xadtK\x1b\x94\xd7\xee_lIc\x01\x93\xcfoF\x8dz\xfdW\x06\xbe\xdb\xf83\xfb\"\xea0x;H\x9b\xe2\x8d\xd2\xd9\xf9\x11\xf3\xa3\xe9\xf3nw\xf9\x89\xfd\xe4\xa3\xee\x8e~\xea\xe4\xff\x00\xb4\r}\x91\xe0\u007f\x86\x9e\x0e\xf8y\xa3\xfd\x97\xc3:Lv\U000fa15e\xfao\xde\\\xcf\xfe\xfc\x87\x9c\u007f\xb20\xbe\x82\xbb\xca\xfc\xa3=\xf1\x0f\x13\x88N\x96\x13\u070fw\xf1|\xbb~/\xd0\xfdg\"\xf0\xeb\r\x87\x92\xad\x8b\xf7\xe5\xd9|?>\xff\x00\x82\xf2f>\x87\xa0h\xbe\x19\xf0\xec:N\x81\xa6Y\xe9:t_r\vh®{\x93ܱ\xeeNI\xef[\x14Q_\x9cNr\x9c\x9c\xa4\xee\xd9\xfaD!\x18EF*\xc9\x05\x14QRPQE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x00QE\x14\x01\x81\xe2o\xf9\x14d\xff\x00\xaf\x9b\u007f\xfd\x1f\x1do\xd1Em/\xe1/W\xfa\x19/\xe2\xbfE\xfa\x85\x14QX\x9a\x85\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x05\x14Q@\x1f\xff\xd9"),
 	}
+	filec := &embedded.EmbeddedFile{
+		Filename:    `vagrant.pub`,
+		FileModTime: time.Unix(1792422112, 0),
+		Content:     string("ssh-rsa AAAAB3NzaC1yc2EAAAABIwAAAQEA6NF8iallvQVp22WDkTkyrtvp9eWW6A8YVr+kz4TjGYe7gHzIw+niNltGEFHzD8+v1I2YJ6oXevct1YeS0o9HZyN1Q9qgCgzUFtdOKLv6IedplqoPkcmF0aYet2PkEDo3MlTBckFXPITAMzF8dJSIFo9D8HfdOV0IAdx4O7PtixWKn5y2hMNG0zQPyUecp4pzC6kivAIhyfHilFR61RGL+GPXQ2MWZWFYbAGjyiYJnAmCP3NOTd0jMZEnDkbUvxhMmBYSdETk1rRgm+R4LOzFUGaHqHDLKLX+FIPKcF96hrucXzcWyLbIbEgE98OHlnVYCzRdK8jlqm8tehUc9c9WhQ== vagrant insecure public key\n"),
+	}
 
 	// define dirs
 	dir1 := &embedded.EmbeddedDir{
 		Filename:   ``,
-		DirModTime: time.Unix(1479257723, 0),
+		DirModTime: time.Unix(1792422330, 0),
 		ChildFiles: []*embedded.EmbeddedFile{
-			file2, // .DS_Store
-			filef, // usr-password-shadow
-			fileg, // vagrant.jpg
+			filea, // usr-password-shadow
+			fileb, // vagrant.jpg
+			filec, // vagrant.pub
 
 		},
 	}
-	dir3 := &embedded.EmbeddedDir{
+	dir2 := &embedded.EmbeddedDir{
 		Filename:   `packer`,
-		DirModTime: time.Unix(1479257723, 0),
-		ChildFiles: []*embedded.EmbeddedFile{
-			file4, // packer/.DS_Store
-			filee, // packer/template.json
-
-		},
+		DirModTime: time.Unix(1792422330, 0),
+		ChildFiles: []*embedded.EmbeddedFile{},
 	}
-	dir5 := &embedded.EmbeddedDir{
+	dir3 := &embedded.EmbeddedDir{
 		Filename:   `packer/scripts`,
-		DirModTime: time.Unix(1479257723, 0),
-		ChildFiles: []*embedded.EmbeddedFile{
-			file6, // packer/scripts/.DS_Store
-			file7, // packer/scripts/add-network-interface-detection.sh
-			file8, // packer/scripts/autologin.sh
-			file9, // packer/scripts/shrink.sh
-			filec, // packer/scripts/vagrant.sh
-			filed, // packer/scripts/xcode-cli-tools.sh
-
-		},
-	}
-	dira := &embedded.EmbeddedDir{
-		Filename:   `packer/scripts/support`,
-		DirModTime: time.Unix(1479257723, 0),
+		DirModTime: time.Unix(1792422330, 0),
 		ChildFiles: []*embedded.EmbeddedFile{
-			fileb, // packer/scripts/support/set_kcpassword.py
+			file4, // packer/scripts/add-network-interface-detection.sh
+			file5, // packer/scripts/autologin.sh
+			file6, // packer/scripts/shrink.sh
+			file7, // packer/scripts/trust-ca.sh
+			file8, // packer/scripts/vagrant.sh
+			file9, // packer/scripts/xcode-cli-tools.sh
 
 		},
 	}
 
 	// link ChildDirs
 	dir1.ChildDirs = []*embedded.EmbeddedDir{
-		dir3, // packer
-
-	}
-	dir3.ChildDirs = []*embedded.EmbeddedDir{
-		dir5, // packer/scripts
+		dir2, // packer
 
 	}
-	dir5.ChildDirs = []*embedded.EmbeddedDir{
-		dira, // packer/scripts/support
+	dir2.ChildDirs = []*embedded.EmbeddedDir{
+		dir3, // packer/scripts
 
 	}
-	dira.ChildDirs = []*embedded.EmbeddedDir{}
+	dir3.ChildDirs = []*embedded.EmbeddedDir{}
 
 	// register embeddedBox
 	embedded.RegisterEmbeddedBox(`data`, &embedded.EmbeddedBox{
 		Name: `data`,
-		Time: time.Unix(1479257723, 0),
+		Time: time.Unix(1792422330, 0),
 		Dirs: map[string]*embedded.EmbeddedDir{
-			"":                       dir1,
-			"packer":                 dir3,
-			"packer/scripts":         dir5,
-			"packer/scripts/support": dira,
+			"":               dir1,
+			"packer":         dir2,
+			"packer/scripts": dir3,
 		},
 		Files: map[string]*embedded.EmbeddedFile{
-			".DS_Store":                                         file2,
-			"packer/.DS_Store":                                  file4,
-			"packer/scripts/.DS_Store":                          file6,
-			"packer/scripts/add-network-interface-detection.sh": file7,
-			"packer/scripts/autologin.sh":                       file8,
-			"packer/scripts/shrink.sh":                          file9,
-			"packer/scripts/support/set_kcpassword.py":          fileb,
-			"packer/scripts/vagrant.sh":                         filec,
-			"packer/scripts/xcode-cli-tools.sh":                 filed,
-			"packer/template.json":                              filee,
-			"usr-password-shadow":                               filef,
-			"vagrant.jpg":                                       fileg,
+			"packer/scripts/add-network-interface-detection.sh": file4,
+			"packer/scripts/autologin.sh":                       file5,
+			"packer/scripts/shrink.sh":                          file6,
+			"packer/scripts/trust-ca.sh":                        file7,
+			"packer/scripts/vagrant.sh":                         file8,
+			"packer/scripts/xcode-cli-tools.sh":                 file9,
+			"usr-password-shadow":                               filea,
+			"vagrant.jpg":                                       fileb,
+			"vagrant.pub":                                       filec,
 		},
 	})
 }
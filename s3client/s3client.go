@@ -0,0 +1,389 @@
+package s3client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned if the object or the multipart upload does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrPreconditionFailed is returned if the condition of a conditional write does not hold,
+	// e.g. the object was changed since it was read
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// metadataHeaderPrefix is the prefix of the user defined metadata headers
+const metadataHeaderPrefix = "X-Amz-Meta-"
+
+// ConfigModel ...
+type ConfigModel struct {
+	// Endpoint is the URL of the S3 compatible service,
+	// e.g. https://s3.eu-west-1.amazonaws.com or http://localhost:9000 (MinIO)
+	Endpoint string
+	// Region of the bucket, e.g. us-east-1
+	Region string
+	// Bucket is the name of the bucket
+	Bucket string
+	// IsPathStyle if true the bucket is in the path of the URLs (ENDPOINT/BUCKET/KEY),
+	// instead of the host name (BUCKET.ENDPOINT/KEY). Required by MinIO.
+	IsPathStyle bool
+	// Credentials of the requests
+	Credentials CredentialsModel
+}
+
+// Client is a client of an S3 compatible object storage, with the operations
+// required to upload large files (multipart upload) and to update small objects
+type Client struct {
+	config     ConfigModel
+	endpoint   *url.URL
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// ObjectModel is the metadata of an object
+type ObjectModel struct {
+	Key  string
+	Size int64
+	ETag string
+	// Metadata is the user defined metadata of the object, by lowercase name
+	Metadata map[string]string
+}
+
+// PutOptionsModel are the options of an upload
+type PutOptionsModel struct {
+	ContentType string
+	// Metadata is the user defined metadata of the object
+	Metadata map[string]string
+	// IfMatch if set the object is only written if its current ETag matches
+	IfMatch string
+	// IfNoneMatch if set to * the object is only written if it does not exist yet
+	IfNoneMatch string
+}
+
+// PartModel is an uploaded part of a multipart upload
+type PartModel struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+	Size       int64  `xml:"Size,omitempty"`
+}
+
+// New returns the client of the bucket
+func New(config ConfigModel) (*Client, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("No bucket specified")
+	}
+	if config.Region == "" {
+		return nil, errors.New("No region specified")
+	}
+	if config.Credentials.AccessKeyID == "" || config.Credentials.SecretAccessKey == "" {
+		return nil, errors.New("No credentials specified")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://s3." + config.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid endpoint (%s), error: %s", config.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("Invalid endpoint (%s), should be an http or https URL", config.Endpoint)
+	}
+
+	return &Client{
+		config:     config,
+		endpoint:   endpoint,
+		httpClient: &http.Client{},
+		now:        time.Now,
+	}, nil
+}
+
+// ObjectURL returns the URL of the object
+func (client *Client) ObjectURL(key string) string {
+	if client.config.IsPathStyle {
+		return client.endpoint.Scheme + "://" + client.endpoint.Host + client.endpoint.Path + "/" + uriEncode(client.config.Bucket, false) + "/" + uriEncode(key, true)
+	}
+	return client.endpoint.Scheme + "://" + client.config.Bucket + "." + client.endpoint.Host + client.endpoint.Path + "/" + uriEncode(key, true)
+}
+
+// errorResponseModel is the error response of S3
+type errorResponseModel struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// do sends a signed request of the object, and returns the response if its status is 2xx
+func (client *Client) do(method, key string, query map[string]string, header http.Header, body []byte) (*http.Response, error) {
+	rawURL := client.ObjectURL(key)
+	if len(query) > 0 {
+		rawURL += "?" + canonicalQueryString(query)
+	}
+	r, err := http.NewRequest(method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request, error: %s", err)
+	}
+	for name, values := range header {
+		r.Header[name] = values
+	}
+	payloadHash := emptyPayloadHash
+	if len(body) > 0 {
+		payloadHash = sha256Hex(body)
+	}
+	signRequest(r, payloadHash, client.config.Credentials, client.config.Region, client.now())
+
+	resp, err := client.httpClient.Do(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s failed, error: %s", method, key, err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s %s failed with status %d, and the response could not be read, error: %s", method, key, resp.StatusCode, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	case http.StatusPreconditionFailed:
+		return nil, ErrPreconditionFailed
+	}
+	var errorResponse errorResponseModel
+	if err := xml.Unmarshal(content, &errorResponse); err != nil || errorResponse.Code == "" {
+		return nil, fmt.Errorf("%s %s failed with status %d", method, key, resp.StatusCode)
+	}
+	if errorResponse.Code == "PreconditionFailed" || errorResponse.Code == "ConditionalRequestConflict" {
+		return nil, ErrPreconditionFailed
+	}
+	return nil, fmt.Errorf("%s %s failed with status %d: %s: %s", method, key, resp.StatusCode, errorResponse.Code, errorResponse.Message)
+}
+
+// readResponse reads and closes the body of the response
+func readResponse(resp *http.Response) ([]byte, error) {
+	content, err := ioutil.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read response, error: %s", err)
+	}
+	return content, nil
+}
+
+// decodeResponse decodes the XML body of the response
+func decodeResponse(resp *http.Response, v interface{}) error {
+	content, err := readResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(content, v); err != nil {
+		return fmt.Errorf("Failed to parse response, error: %s", err)
+	}
+	return nil
+}
+
+func objectOfResponse(key string, resp *http.Response) ObjectModel {
+	object := ObjectModel{
+		Key:      key,
+		ETag:     resp.Header.Get("ETag"),
+		Size:     resp.ContentLength,
+		Metadata: map[string]string{},
+	}
+	for name, values := range resp.Header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), metadataHeaderPrefix) && len(values) > 0 {
+			object.Metadata[strings.ToLower(strings.TrimPrefix(http.CanonicalHeaderKey(name), metadataHeaderPrefix))] = values[0]
+		}
+	}
+	return object
+}
+
+func headerOfPutOptions(opts PutOptionsModel) http.Header {
+	header := http.Header{}
+	if opts.ContentType != "" {
+		header.Set("Content-Type", opts.ContentType)
+	}
+	names := []string{}
+	for name := range opts.Metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, aName := range names {
+		header.Set(metadataHeaderPrefix+aName, opts.Metadata[aName])
+	}
+	if opts.IfMatch != "" {
+		header.Set("If-Match", opts.IfMatch)
+	}
+	if opts.IfNoneMatch != "" {
+		header.Set("If-None-Match", opts.IfNoneMatch)
+	}
+	return header
+}
+
+// HeadObject returns the metadata of the object, or ErrNotFound
+func (client *Client) HeadObject(key string) (ObjectModel, error) {
+	resp, err := client.do(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return ObjectModel{}, err
+	}
+	if _, err := readResponse(resp); err != nil {
+		return ObjectModel{}, err
+	}
+	return objectOfResponse(key, resp), nil
+}
+
+// GetObject returns the content and the metadata of the object, or ErrNotFound
+func (client *Client) GetObject(key string) ([]byte, ObjectModel, error) {
+	resp, err := client.do(http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, ObjectModel{}, err
+	}
+	content, err := readResponse(resp)
+	if err != nil {
+		return nil, ObjectModel{}, err
+	}
+	object := objectOfResponse(key, resp)
+	object.Size = int64(len(content))
+	return content, object, nil
+}
+
+// PutObject uploads the content as the object, in a single request
+func (client *Client) PutObject(key string, content []byte, opts PutOptionsModel) (ObjectModel, error) {
+	resp, err := client.do(http.MethodPut, key, nil, headerOfPutOptions(opts), content)
+	if err != nil {
+		return ObjectModel{}, err
+	}
+	if _, err := readResponse(resp); err != nil {
+		return ObjectModel{}, err
+	}
+	return ObjectModel{Key: key, Size: int64(len(content)), ETag: resp.Header.Get("ETag"), Metadata: opts.Metadata}, nil
+}
+
+// CreateMultipartUpload starts a multipart upload of the object, and returns its upload ID.
+// The metadata of the object has to be specified here, not when the upload is completed.
+func (client *Client) CreateMultipartUpload(key string, opts PutOptionsModel) (string, error) {
+	resp, err := client.do(http.MethodPost, key, map[string]string{"uploads": ""}, headerOfPutOptions(opts), nil)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := decodeResponse(resp, &result); err != nil {
+		return "", err
+	}
+	if result.UploadID == "" {
+		return "", fmt.Errorf("No upload ID in the response of the multipart upload creation")
+	}
+	return result.UploadID, nil
+}
+
+// UploadPart uploads a part of the multipart upload, and returns its ETag.
+// The part numbers start from 1, every part except the last one has to be at least 5 MB.
+func (client *Client) UploadPart(key, uploadID string, partNumber int, content []byte) (string, error) {
+	resp, err := client.do(http.MethodPut, key, map[string]string{
+		"partNumber": strconv.Itoa(partNumber),
+		"uploadId":   uploadID,
+	}, nil, content)
+	if err != nil {
+		return "", err
+	}
+	if _, err := readResponse(resp); err != nil {
+		return "", err
+	}
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("No ETag in the response of the part (%d) upload", partNumber)
+	}
+	return etag, nil
+}
+
+// ListParts returns the uploaded parts of the multipart upload, or ErrNotFound
+// if the upload does not exist (e.g. it was completed or aborted)
+func (client *Client) ListParts(key, uploadID string) ([]PartModel, error) {
+	parts := []PartModel{}
+	marker := ""
+	for {
+		query := map[string]string{"uploadId": uploadID}
+		if marker != "" {
+			query["part-number-marker"] = marker
+		}
+		resp, err := client.do(http.MethodGet, key, query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Parts                []PartModel `xml:"Part"`
+			IsTruncated          bool        `xml:"IsTruncated"`
+			NextPartNumberMarker string      `xml:"NextPartNumberMarker"`
+		}
+		if err := decodeResponse(resp, &result); err != nil {
+			return nil, err
+		}
+		parts = append(parts, result.Parts...)
+		if !result.IsTruncated || result.NextPartNumberMarker == "" {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// completeMultipartUploadModel is the request body of the multipart upload completion
+type completeMultipartUploadModel struct {
+	XMLName xml.Name    `xml:"CompleteMultipartUpload"`
+	Parts   []PartModel `xml:"Part"`
+}
+
+// CompleteMultipartUpload assembles the object from the parts
+func (client *Client) CompleteMultipartUpload(key, uploadID string, parts []PartModel) error {
+	completeParts := []PartModel{}
+	for _, aPart := range parts {
+		completeParts = append(completeParts, PartModel{PartNumber: aPart.PartNumber, ETag: aPart.ETag})
+	}
+	sort.Slice(completeParts, func(i, j int) bool { return completeParts[i].PartNumber < completeParts[j].PartNumber })
+
+	body, err := xml.Marshal(completeMultipartUploadModel{Parts: completeParts})
+	if err != nil {
+		return fmt.Errorf("Failed to serialize the multipart upload completion, error: %s", err)
+	}
+	resp, err := client.do(http.MethodPost, key, map[string]string{"uploadId": uploadID}, http.Header{"Content-Type": {"application/xml"}}, body)
+	if err != nil {
+		return err
+	}
+
+	// the completion can fail after a 200 response, with an error in the body
+	content, err := readResponse(resp)
+	if err != nil {
+		return err
+	}
+	var errorResponse struct {
+		XMLName xml.Name
+		errorResponseModel
+	}
+	if err := xml.Unmarshal(content, &errorResponse); err == nil && errorResponse.XMLName.Local == "Error" {
+		return fmt.Errorf("Failed to complete multipart upload: %s: %s", errorResponse.Code, errorResponse.Message)
+	}
+	return nil
+}
+
+// AbortMultipartUpload aborts the multipart upload, and removes its uploaded parts
+func (client *Client) AbortMultipartUpload(key, uploadID string) error {
+	resp, err := client.do(http.MethodDelete, key, map[string]string{"uploadId": uploadID}, nil, nil)
+	if err != nil {
+		return err
+	}
+	_, err = readResponse(resp)
+	return err
+}
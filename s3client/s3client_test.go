@@ -0,0 +1,187 @@
+package s3client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/replica/s3client/s3test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, server *s3test.Server) *Client {
+	client, err := New(ConfigModel{
+		Endpoint:    server.URL,
+		Region:      "us-east-1",
+		Bucket:      server.Bucket,
+		IsPathStyle: true,
+		Credentials: CredentialsModel{AccessKeyID: "access-key", SecretAccessKey: "secret-key"},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNew(t *testing.T) {
+	credentials := CredentialsModel{AccessKeyID: "access-key", SecretAccessKey: "secret-key"}
+
+	client, err := New(ConfigModel{Region: "eu-west-1", Bucket: "boxes", Credentials: credentials})
+	require.NoError(t, err)
+	require.Equal(t, "https://boxes.s3.eu-west-1.amazonaws.com/macos-sierra/catalog.json", client.ObjectURL("macos-sierra/catalog.json"))
+
+	client, err = New(ConfigModel{Endpoint: "http://localhost:9000/", Region: "us-east-1", Bucket: "boxes", IsPathStyle: true, Credentials: credentials})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9000/boxes/macos%20sierra/catalog.json", client.ObjectURL("macos sierra/catalog.json"))
+
+	for _, aConfig := range []ConfigModel{
+		{Region: "us-east-1", Credentials: credentials},
+		{Bucket: "boxes", Credentials: credentials},
+		{Region: "us-east-1", Bucket: "boxes"},
+		{Endpoint: "localhost:9000", Region: "us-east-1", Bucket: "boxes", Credentials: credentials},
+	} {
+		_, err := New(aConfig)
+		require.Error(t, err, "%#v", aConfig)
+	}
+}
+
+func TestClient_objects(t *testing.T) {
+	server := s3test.NewServer("boxes")
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	t.Log("missing object")
+	{
+		_, err := client.HeadObject("macos-sierra/catalog.json")
+		require.Equal(t, ErrNotFound, err)
+		_, _, err = client.GetObject("macos-sierra/catalog.json")
+		require.Equal(t, ErrNotFound, err)
+	}
+
+	t.Log("put, head and get")
+	{
+		object, err := client.PutObject("macos-sierra/catalog.json", []byte("{}"), PutOptionsModel{
+			ContentType: "application/json",
+			Metadata:    map[string]string{"sha256": "abc"},
+			IfNoneMatch: "*",
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, "", object.ETag)
+
+		head, err := client.HeadObject("macos-sierra/catalog.json")
+		require.NoError(t, err)
+		require.Equal(t, ObjectModel{Key: "macos-sierra/catalog.json", Size: 2, ETag: object.ETag, Metadata: map[string]string{"sha256": "abc"}}, head)
+
+		content, got, err := client.GetObject("macos-sierra/catalog.json")
+		require.NoError(t, err)
+		require.Equal(t, "{}", string(content))
+		require.Equal(t, head, got)
+	}
+
+	t.Log("conditional writes")
+	{
+		_, err := client.PutObject("macos-sierra/catalog.json", []byte("{}"), PutOptionsModel{IfNoneMatch: "*"})
+		require.Equal(t, ErrPreconditionFailed, err)
+
+		_, err = client.PutObject("macos-sierra/catalog.json", []byte(`{"name": "a"}`), PutOptionsModel{IfMatch: `"outdated"`})
+		require.Equal(t, ErrPreconditionFailed, err)
+
+		_, current, err := client.GetObject("macos-sierra/catalog.json")
+		require.NoError(t, err)
+		_, err = client.PutObject("macos-sierra/catalog.json", []byte(`{"name": "a"}`), PutOptionsModel{IfMatch: current.ETag})
+		require.NoError(t, err)
+	}
+}
+
+func TestClient_UploadFile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	// 3 parts of the minimum part size
+	content := bytes.Repeat([]byte("0123456789abcdef"), (2*minPartSize+1024)/16)
+	boxPath := filepath.Join(tmpDir, "virtualbox.box")
+	require.NoError(t, ioutil.WriteFile(boxPath, content, 0600))
+	statePath := filepath.Join(tmpDir, "virtualbox.box.upload.json")
+
+	server := s3test.NewServer("boxes")
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	opts := UploadFileOptionsModel{
+		PartSize:    minPartSize,
+		ContentType: "application/octet-stream",
+		Metadata:    map[string]string{"sha256": "abc"},
+		StatePath:   statePath,
+		Fingerprint: "abc",
+	}
+
+	t.Log("interrupted upload")
+	{
+		server.FailPartUploadsAfter = 1
+		require.Error(t, client.UploadFile("macos-sierra/10.12.4/virtualbox.box", boxPath, opts))
+		require.Equal(t, 2, server.Requests("PUT part"))
+		_, isFound := server.Object("macos-sierra/10.12.4/virtualbox.box")
+		require.False(t, isFound)
+
+		_, err := os.Stat(statePath)
+		require.NoError(t, err)
+	}
+
+	t.Log("resumed upload, the uploaded part is skipped")
+	{
+		server.FailPartUploadsAfter = 0
+		progress := []int64{}
+		opts.Progress = func(uploadedBytes, totalBytes int64) {
+			require.Equal(t, int64(len(content)), totalBytes)
+			progress = append(progress, uploadedBytes)
+		}
+		require.NoError(t, client.UploadFile("macos-sierra/10.12.4/virtualbox.box", boxPath, opts))
+		require.Equal(t, 2+2, server.Requests("PUT part"))
+		require.Equal(t, 1, server.Requests("POST uploads"))
+		require.Equal(t, []int64{minPartSize, 2 * minPartSize, int64(len(content))}, progress)
+
+		object, isFound := server.Object("macos-sierra/10.12.4/virtualbox.box")
+		require.True(t, isFound)
+		require.Equal(t, content, object.Content)
+		require.Equal(t, map[string]string{"sha256": "abc"}, object.Metadata)
+		require.Equal(t, "application/octet-stream", object.ContentType)
+
+		_, err := os.Stat(statePath)
+		require.True(t, os.IsNotExist(err))
+		require.Equal(t, 0, server.UploadsCount())
+	}
+
+	t.Log("a changed file is not resumed")
+	{
+		server.FailPartUploadsAfter = server.Requests("PUT part") + 1
+		require.Error(t, client.UploadFile("macos-sierra/10.12.4/virtualbox.box", boxPath, opts))
+
+		server.FailPartUploadsAfter = 0
+		opts.Fingerprint = "def"
+		opts.Progress = nil
+		require.NoError(t, client.UploadFile("macos-sierra/10.12.4/virtualbox.box", boxPath, opts))
+		require.Equal(t, 3, server.Requests("POST uploads"))
+		require.Equal(t, 1, server.Requests("DELETE upload"))
+		require.Equal(t, 0, server.UploadsCount())
+	}
+
+	t.Log("empty file")
+	{
+		emptyPath := filepath.Join(tmpDir, "empty.box")
+		require.NoError(t, ioutil.WriteFile(emptyPath, []byte{}, 0600))
+		require.NoError(t, client.UploadFile("empty.box", emptyPath, UploadFileOptionsModel{}))
+
+		object, isFound := server.Object("empty.box")
+		require.True(t, isFound)
+		require.Equal(t, 0, len(object.Content))
+	}
+}
+
+func Test_partSizeOf(t *testing.T) {
+	require.Equal(t, int64(DefaultPartSize), partSizeOf(1024, 0))
+	require.Equal(t, int64(minPartSize), partSizeOf(1024, 1024))
+	require.Equal(t, int64(2*minPartSize), partSizeOf(10001*minPartSize, minPartSize))
+}
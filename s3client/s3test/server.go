@@ -0,0 +1,305 @@
+// Package s3test provides an in-process fake of an S3 compatible object storage, for tests.
+// It implements the operations used by the s3client package, with path-style URLs.
+package s3test
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ObjectModel is a stored object
+type ObjectModel struct {
+	Content     []byte
+	ContentType string
+	ETag        string
+	Metadata    map[string]string
+}
+
+type uploadModel struct {
+	key         string
+	contentType string
+	metadata    map[string]string
+	parts       map[int][]byte
+}
+
+// Server is a fake S3 server of a single bucket
+type Server struct {
+	*httptest.Server
+	Bucket string
+
+	mutex   sync.Mutex
+	objects map[string]ObjectModel
+	uploads map[string]*uploadModel
+	nextID  int
+	// requests counts the requests, by method and operation, e.g. "PUT part"
+	requests map[string]int
+	// FailPartUploadsAfter if positive, the part uploads fail after this number of successful part uploads
+	FailPartUploadsAfter int
+}
+
+// NewServer starts a fake S3 server of the bucket
+func NewServer(bucket string) *Server {
+	server := &Server{
+		Bucket:   bucket,
+		objects:  map[string]ObjectModel{},
+		uploads:  map[string]*uploadModel{},
+		requests: map[string]int{},
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// Object returns the stored object of the key
+func (server *Server) Object(key string) (ObjectModel, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	object, isFound := server.objects[key]
+	return object, isFound
+}
+
+// PutObject stores an object, without a request
+func (server *Server) PutObject(key string, object ObjectModel) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if object.ETag == "" {
+		object.ETag = md5ETag(object.Content)
+	}
+	server.objects[key] = object
+}
+
+// Requests returns the number of requests of the operation, e.g. "PUT part"
+func (server *Server) Requests(operation string) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.requests[operation]
+}
+
+// UploadsCount returns the number of multipart uploads in progress
+func (server *Server) UploadsCount() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return len(server.uploads)
+}
+
+func md5ETag(content []byte) string {
+	sum := md5.Sum(content)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message); err != nil {
+		panic(err)
+	}
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	content, err := xml.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	if _, err := w.Write(content); err != nil {
+		panic(err)
+	}
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=") {
+		writeError(w, http.StatusForbidden, "AccessDenied", "Missing signature")
+		return
+	}
+	bodyHash := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(bodyHash[:]) {
+		writeError(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch", "The payload hash does not match")
+		return
+	}
+
+	prefix := "/" + server.Bucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The bucket does not exist")
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	_, isCreateUpload := query["uploads"]
+
+	switch {
+	case r.Method == http.MethodPost && isCreateUpload:
+		server.requests["POST uploads"]++
+		server.nextID++
+		id := strconv.Itoa(server.nextID)
+		server.uploads[id] = &uploadModel{key: key, contentType: r.Header.Get("Content-Type"), metadata: metadataOfRequest(r), parts: map[int][]byte{}}
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string   `xml:"Bucket"`
+			Key      string   `xml:"Key"`
+			UploadID string   `xml:"UploadId"`
+		}{Bucket: server.Bucket, Key: key, UploadID: id})
+	case r.Method == http.MethodPut && uploadID != "":
+		server.requests["PUT part"]++
+		upload, isFound := server.uploads[uploadID]
+		if !isFound || upload.key != key {
+			writeError(w, http.StatusNotFound, "NoSuchUpload", "The upload does not exist")
+			return
+		}
+		if server.FailPartUploadsAfter > 0 && server.requests["PUT part"] > server.FailPartUploadsAfter {
+			writeError(w, http.StatusInternalServerError, "InternalError", "Simulated failure")
+			return
+		}
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil || partNumber < 1 {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", "Invalid part number")
+			return
+		}
+		upload.parts[partNumber] = body
+		w.Header().Set("ETag", md5ETag(body))
+	case r.Method == http.MethodGet && uploadID != "":
+		server.requests["GET parts"]++
+		upload, isFound := server.uploads[uploadID]
+		if !isFound || upload.key != key {
+			writeError(w, http.StatusNotFound, "NoSuchUpload", "The upload does not exist")
+			return
+		}
+		type partModel struct {
+			PartNumber int    `xml:"PartNumber"`
+			ETag       string `xml:"ETag"`
+			Size       int    `xml:"Size"`
+		}
+		result := struct {
+			XMLName     xml.Name    `xml:"ListPartsResult"`
+			Parts       []partModel `xml:"Part"`
+			IsTruncated bool        `xml:"IsTruncated"`
+		}{}
+		numbers := []int{}
+		for number := range upload.parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		for _, aNumber := range numbers {
+			result.Parts = append(result.Parts, partModel{PartNumber: aNumber, ETag: md5ETag(upload.parts[aNumber]), Size: len(upload.parts[aNumber])})
+		}
+		writeXML(w, result)
+	case r.Method == http.MethodPost && uploadID != "":
+		server.requests["POST complete"]++
+		server.completeUpload(w, key, uploadID, body)
+	case r.Method == http.MethodDelete && uploadID != "":
+		server.requests["DELETE upload"]++
+		delete(server.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		server.requests["PUT object"]++
+		existing, isExist := server.objects[key]
+		if ifMatch := r.Header.Get("If-Match"); ifMatch != "" && (!isExist || existing.ETag != ifMatch) {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", "If-Match does not match")
+			return
+		}
+		if r.Header.Get("If-None-Match") == "*" && isExist {
+			writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", "The object already exists")
+			return
+		}
+		object := ObjectModel{Content: body, ContentType: r.Header.Get("Content-Type"), ETag: md5ETag(body), Metadata: metadataOfRequest(r)}
+		server.objects[key] = object
+		w.Header().Set("ETag", object.ETag)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		server.requests[r.Method+" object"]++
+		object, isFound := server.objects[key]
+		if !isFound {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeError(w, http.StatusNotFound, "NoSuchKey", "The object does not exist")
+			return
+		}
+		w.Header().Set("ETag", object.ETag)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.Content)))
+		if object.ContentType != "" {
+			w.Header().Set("Content-Type", object.ContentType)
+		}
+		for name, value := range object.Metadata {
+			w.Header().Set("X-Amz-Meta-"+name, value)
+		}
+		if r.Method == http.MethodGet {
+			if _, err := w.Write(object.Content); err != nil {
+				panic(err)
+			}
+		}
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "The operation is not implemented by the fake server")
+	}
+}
+
+func (server *Server) completeUpload(w http.ResponseWriter, key, uploadID string, body []byte) {
+	upload, isFound := server.uploads[uploadID]
+	if !isFound || upload.key != key {
+		writeError(w, http.StatusNotFound, "NoSuchUpload", "The upload does not exist")
+		return
+	}
+
+	var request struct {
+		Parts []struct {
+			PartNumber int    `xml:"PartNumber"`
+			ETag       string `xml:"ETag"`
+		} `xml:"Part"`
+	}
+	if err := xml.Unmarshal(body, &request); err != nil || len(request.Parts) == 0 {
+		writeError(w, http.StatusBadRequest, "MalformedXML", "Invalid request")
+		return
+	}
+
+	content := []byte{}
+	partETags := ""
+	for idx, aPart := range request.Parts {
+		partContent, isFound := upload.parts[aPart.PartNumber]
+		if !isFound || md5ETag(partContent) != aPart.ETag || aPart.PartNumber != idx+1 {
+			writeError(w, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("Invalid part: %d", aPart.PartNumber))
+			return
+		}
+		content = append(content, partContent...)
+		partETags += strings.Trim(aPart.ETag, `"`)
+	}
+
+	// the ETag of a multipart object is the MD5 of the part MD5s, with the number of parts
+	partsSum := md5.Sum([]byte(partETags))
+	etag := fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(partsSum[:]), len(request.Parts))
+	server.objects[key] = ObjectModel{Content: content, ContentType: upload.contentType, ETag: etag, Metadata: upload.metadata}
+	delete(server.uploads, uploadID)
+
+	writeXML(w, struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Key     string   `xml:"Key"`
+		ETag    string   `xml:"ETag"`
+	}{Key: key, ETag: etag})
+}
+
+func metadataOfRequest(r *http.Request) map[string]string {
+	metadata := map[string]string{}
+	for name, values := range r.Header {
+		canonicalName := http.CanonicalHeaderKey(name)
+		if strings.HasPrefix(canonicalName, "X-Amz-Meta-") && len(values) > 0 {
+			metadata[strings.ToLower(strings.TrimPrefix(canonicalName, "X-Amz-Meta-"))] = values[0]
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,154 @@
+package s3client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateLayout    = "20060102T150405Z"
+	dateLayout       = "20060102"
+	serviceName      = "s3"
+
+	// emptyPayloadHash is the SHA-256 of an empty payload
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// unsignedHeaders are not part of the signature, as they can be changed by the HTTP client
+var unsignedHeaders = map[string]bool{
+	"authorization":   true,
+	"user-agent":      true,
+	"content-length":  true,
+	"accept-encoding": true,
+}
+
+// CredentialsModel are the credentials of the requests
+type CredentialsModel struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is the token of temporary credentials, if any
+	SessionToken string
+}
+
+// sha256Hex returns the hex encoded SHA-256 of the content
+func sha256Hex(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	if _, err := mac.Write([]byte(data)); err != nil {
+		// writing into a hash never fails
+		panic(err)
+	}
+	return mac.Sum(nil)
+}
+
+// uriEncode encodes the string as AWS Signature Version 4 requires:
+// every byte except the unreserved characters is percent encoded, '/' is kept if isPath is true
+func uriEncode(s string, isPath bool) string {
+	var encoded strings.Builder
+	for _, aByte := range []byte(s) {
+		isUnreserved := (aByte >= 'A' && aByte <= 'Z') || (aByte >= 'a' && aByte <= 'z') || (aByte >= '0' && aByte <= '9') ||
+			aByte == '-' || aByte == '_' || aByte == '.' || aByte == '~'
+		if isUnreserved || (isPath && aByte == '/') {
+			encoded.WriteByte(aByte)
+		} else {
+			encoded.WriteString(fmt.Sprintf("%%%02X", aByte))
+		}
+	}
+	return encoded.String()
+}
+
+// canonicalQueryString returns the query parameters sorted by name, in the encoding of the signature
+func canonicalQueryString(query map[string]string) string {
+	names := []string{}
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := []string{}
+	for _, aName := range names {
+		params = append(params, uriEncode(aName, false)+"="+uriEncode(query[aName], false))
+	}
+	return strings.Join(params, "&")
+}
+
+// signRequest adds the AWS Signature Version 4 Authorization header to the request.
+// The URL of the request has to be in the canonical encoding: the path encoded with uriEncode,
+// and the query created with canonicalQueryString.
+func signRequest(r *http.Request, payloadHash string, credentials CredentialsModel, region string, now time.Time) {
+	now = now.UTC()
+	r.Header.Set("X-Amz-Date", now.Format(amzDateLayout))
+	r.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if credentials.SessionToken != "" {
+		r.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	host := r.Host
+	if host == "" {
+		host = r.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range r.Header {
+		lowerName := strings.ToLower(name)
+		if unsignedHeaders[lowerName] {
+			continue
+		}
+		trimmedValues := []string{}
+		for _, aValue := range values {
+			trimmedValues = append(trimmedValues, strings.Join(strings.Fields(aValue), " "))
+		}
+		headers[lowerName] = strings.Join(trimmedValues, ",")
+	}
+	headerNames := []string{}
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	canonicalHeaders := ""
+	for _, aName := range headerNames {
+		canonicalHeaders += aName + ":" + headers[aName] + "\n"
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalURI := r.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		canonicalURI,
+		r.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(dateLayout), region, serviceName, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		now.Format(amzDateLayout),
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), now.Format(dateLayout))
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, serviceName)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	r.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, credentials.AccessKeyID, scope, signedHeaders, signature))
+}
@@ -0,0 +1,215 @@
+package s3client
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+const (
+	// DefaultPartSize is the default part size of the multipart uploads
+	DefaultPartSize = 64 * 1024 * 1024
+	// minPartSize is the minimum size of a part (except the last one)
+	minPartSize = 5 * 1024 * 1024
+	// maxPartsCount is the maximum number of parts of a multipart upload
+	maxPartsCount = 10000
+)
+
+// UploadFileOptionsModel ...
+type UploadFileOptionsModel struct {
+	// PartSize is the size of the parts, default: DefaultPartSize
+	PartSize int64
+	// ContentType and Metadata of the object
+	ContentType string
+	Metadata    map[string]string
+	// StatePath if set, the state of the upload is saved into this file,
+	// so that an interrupted upload can be resumed
+	StatePath string
+	// Fingerprint identifies the content of the file (e.g. its checksum),
+	// an interrupted upload is only resumed if the fingerprint of the file matches
+	Fingerprint string
+	// Progress if set, is called after every part
+	Progress func(uploadedBytes, totalBytes int64)
+}
+
+// uploadStateModel is the saved state of a multipart upload
+type uploadStateModel struct {
+	Bucket      string `json:"bucket"`
+	Key         string `json:"key"`
+	UploadID    string `json:"upload_id"`
+	Fingerprint string `json:"fingerprint"`
+	PartSize    int64  `json:"part_size"`
+}
+
+// partSizeOf returns the part size of the file: the requested size,
+// increased if required, as the number of parts is limited
+func partSizeOf(fileSize, requestedPartSize int64) int64 {
+	partSize := requestedPartSize
+	if partSize == 0 {
+		partSize = DefaultPartSize
+	}
+	if partSize < minPartSize {
+		partSize = minPartSize
+	}
+	for (fileSize+partSize-1)/partSize > maxPartsCount {
+		partSize *= 2
+	}
+	return partSize
+}
+
+func readUploadState(pth string) (uploadStateModel, bool) {
+	if pth == "" {
+		return uploadStateModel{}, false
+	}
+	content, err := fileutil.ReadBytesFromFile(pth)
+	if err != nil {
+		return uploadStateModel{}, false
+	}
+	var state uploadStateModel
+	if err := json.Unmarshal(content, &state); err != nil {
+		log.Printf(" [!] Ignoring invalid upload state (%s), error: %s", pth, err)
+		return uploadStateModel{}, false
+	}
+	return state, true
+}
+
+func writeUploadState(pth string, state uploadStateModel) error {
+	if pth == "" {
+		return nil
+	}
+	content, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("Failed to serialize upload state, error: %s", err)
+	}
+	if err := fileutil.WriteBytesToFile(pth, content); err != nil {
+		return fmt.Errorf("Failed to write upload state (%s), error: %s", pth, err)
+	}
+	return nil
+}
+
+// resumableUpload returns the ID and the uploaded parts of the interrupted upload of the file, if any
+func (client *Client) resumableUpload(key string, partSize int64, opts UploadFileOptionsModel) (string, map[int]PartModel, error) {
+	state, isFound := readUploadState(opts.StatePath)
+	if !isFound {
+		return "", nil, nil
+	}
+	isSameUpload := state.Bucket == client.config.Bucket && state.Key == key &&
+		state.Fingerprint == opts.Fingerprint && state.PartSize == partSize
+	if !isSameUpload {
+		// the upload of another version of the file was interrupted, its parts are not needed anymore
+		if state.Bucket == client.config.Bucket && state.UploadID != "" {
+			if err := client.AbortMultipartUpload(state.Key, state.UploadID); err != nil && err != ErrNotFound {
+				log.Printf(" [!] Failed to abort the previous upload (%s), error: %s", state.UploadID, err)
+			}
+		}
+		return "", nil, nil
+	}
+
+	parts, err := client.ListParts(key, state.UploadID)
+	if err == ErrNotFound {
+		return "", nil, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+	partsByNumber := map[int]PartModel{}
+	for _, aPart := range parts {
+		partsByNumber[aPart.PartNumber] = aPart
+	}
+	return state.UploadID, partsByNumber, nil
+}
+
+// UploadFile uploads the file as the object, with a multipart upload.
+// If the state path is set, and a previous upload of the file was interrupted,
+// the upload is resumed: the parts which were already uploaded are skipped.
+func (client *Client) UploadFile(key, pth string, opts UploadFileOptionsModel) error {
+	file, err := os.Open(pth)
+	if err != nil {
+		return fmt.Errorf("Failed to open file (%s), error: %s", pth, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf(" [!] Failed to close file (%s), error: %s", pth, err)
+		}
+	}()
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Failed to get file info (%s), error: %s", pth, err)
+	}
+
+	putOpts := PutOptionsModel{ContentType: opts.ContentType, Metadata: opts.Metadata}
+	if info.Size() == 0 {
+		// a multipart upload requires at least one part
+		_, err := client.PutObject(key, []byte{}, putOpts)
+		return err
+	}
+
+	partSize := partSizeOf(info.Size(), opts.PartSize)
+	uploadID, uploadedParts, err := client.resumableUpload(key, partSize, opts)
+	if err != nil {
+		return err
+	}
+	if uploadID != "" {
+		log.Printf(" => Resuming upload, %d part(s) already uploaded", len(uploadedParts))
+	} else {
+		uploadID, err = client.CreateMultipartUpload(key, putOpts)
+		if err != nil {
+			return err
+		}
+		uploadedParts = map[int]PartModel{}
+		if err := writeUploadState(opts.StatePath, uploadStateModel{
+			Bucket:      client.config.Bucket,
+			Key:         key,
+			UploadID:    uploadID,
+			Fingerprint: opts.Fingerprint,
+			PartSize:    partSize,
+		}); err != nil {
+			return err
+		}
+	}
+
+	parts := []PartModel{}
+	uploadedBytes := int64(0)
+	for partNumber := 1; uploadedBytes < info.Size(); partNumber++ {
+		var buf bytes.Buffer
+		if _, err := io.CopyN(&buf, file, partSize); err != nil && err != io.EOF {
+			return fmt.Errorf("Failed to read file (%s), error: %s", pth, err)
+		}
+		content := buf.Bytes()
+		if len(content) == 0 {
+			return fmt.Errorf("The file (%s) was changed during the upload", pth)
+		}
+		md5Sum := md5.Sum(content)
+
+		// the ETag of a part is the MD5 of its content
+		etag := `"` + hex.EncodeToString(md5Sum[:]) + `"`
+		if uploadedPart, isUploaded := uploadedParts[partNumber]; !isUploaded || uploadedPart.ETag != etag {
+			etag, err = client.UploadPart(key, uploadID, partNumber, content)
+			if err != nil {
+				return fmt.Errorf("Failed to upload part %d, error: %s", partNumber, err)
+			}
+		}
+		parts = append(parts, PartModel{PartNumber: partNumber, ETag: etag})
+
+		uploadedBytes += int64(len(content))
+		if opts.Progress != nil {
+			opts.Progress(uploadedBytes, info.Size())
+		}
+	}
+
+	if err := client.CompleteMultipartUpload(key, uploadID, parts); err != nil {
+		return err
+	}
+	if opts.StatePath != "" {
+		if err := os.Remove(opts.StatePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Failed to remove upload state (%s), error: %s", opts.StatePath, err)
+		}
+	}
+	return nil
+}
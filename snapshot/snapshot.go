@@ -0,0 +1,120 @@
+package snapshot
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// InitialName is the name of the snapshot taken right after the VM is created
+	InitialName = "bitrise-replica-initial"
+
+	namePrefix = "replica"
+	timeFormat = "20060102-150405"
+)
+
+var (
+	labelRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	nameRegexp  = regexp.MustCompile(`^` + namePrefix + `(-[a-z0-9-]+)?-([0-9]{8}-[0-9]{6})$`)
+)
+
+// SnapshotModel is a snapshot of a VM
+type SnapshotModel struct {
+	Name string `json:"name"`
+	// Label is the label of a timestamped snapshot, e.g. xcode-sync, empty if it has no label
+	Label string `json:"label,omitempty"`
+	// CreatedAt is the time of a timestamped snapshot, zero for the other snapshots
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// IsTimestamped returns true if the snapshot was named by replica, with a timestamp
+func (snapshot SnapshotModel) IsTimestamped() bool {
+	return !snapshot.CreatedAt.IsZero()
+}
+
+// ValidateLabel ...
+func ValidateLabel(label string) error {
+	if label != "" && !labelRegexp.MatchString(label) {
+		return fmt.Errorf("Invalid snapshot label (%s), only lower case letters, numbers and '-' are allowed", label)
+	}
+	return nil
+}
+
+// NewName returns the timestamped name of a new snapshot, e.g. replica-xcode-sync-20170102-150405
+func NewName(label string, createdAt time.Time) string {
+	segments := []string{namePrefix}
+	if label != "" {
+		segments = append(segments, label)
+	}
+	return strings.Join(append(segments, createdAt.UTC().Format(timeFormat)), "-")
+}
+
+// Parse returns the snapshot of the name, with the label and the time of the timestamped names
+func Parse(name string) SnapshotModel {
+	matches := nameRegexp.FindStringSubmatch(name)
+	if matches == nil {
+		return SnapshotModel{Name: name}
+	}
+	createdAt, err := time.Parse(timeFormat, matches[2])
+	if err != nil {
+		return SnapshotModel{Name: name}
+	}
+	return SnapshotModel{
+		Name:      name,
+		Label:     strings.TrimPrefix(matches[1], "-"),
+		CreatedAt: createdAt,
+	}
+}
+
+// ParseAll returns the snapshots of the names: the initial snapshot first,
+// then the timestamped snapshots, oldest first, then the other snapshots by name
+func ParseAll(names []string) []SnapshotModel {
+	snapshots := []SnapshotModel{}
+	for _, aName := range names {
+		snapshots = append(snapshots, Parse(aName))
+	}
+	rank := func(snapshot SnapshotModel) int {
+		switch {
+		case snapshot.Name == InitialName:
+			return 0
+		case snapshot.IsTimestamped():
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if rank(snapshots[i]) != rank(snapshots[j]) {
+			return rank(snapshots[i]) < rank(snapshots[j])
+		}
+		if !snapshots[i].CreatedAt.Equal(snapshots[j].CreatedAt) {
+			return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots
+}
+
+// SelectExpired returns the timestamped snapshots with the label, which are over the retention limit:
+// all but the newest keep ones, oldest first. The initial and the not timestamped snapshots are never selected.
+func SelectExpired(snapshots []SnapshotModel, label string, keep int) []SnapshotModel {
+	if keep < 1 {
+		return []SnapshotModel{}
+	}
+	labeled := []SnapshotModel{}
+	for _, aSnapshot := range snapshots {
+		if aSnapshot.IsTimestamped() && aSnapshot.Label == label {
+			labeled = append(labeled, aSnapshot)
+		}
+	}
+	sort.SliceStable(labeled, func(i, j int) bool {
+		return labeled[i].CreatedAt.Before(labeled[j].CreatedAt)
+	})
+	if len(labeled) <= keep {
+		return []SnapshotModel{}
+	}
+	return labeled[:len(labeled)-keep]
+}
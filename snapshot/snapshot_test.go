@@ -0,0 +1,64 @@
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewName(t *testing.T) {
+	createdAt := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	require.Equal(t, "replica-20170102-150405", NewName("", createdAt))
+	require.Equal(t, "replica-xcode-sync-20170102-150405", NewName("xcode-sync", createdAt))
+	require.Equal(t, "replica-xcode-sync-20170102-150405", NewName("xcode-sync", createdAt.In(time.FixedZone("CET", 3600))))
+}
+
+func TestParse(t *testing.T) {
+	createdAt := time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+	require.Equal(t, SnapshotModel{Name: "replica-20170102-150405", CreatedAt: createdAt}, Parse("replica-20170102-150405"))
+	require.Equal(t, SnapshotModel{Name: "replica-xcode-sync-20170102-150405", Label: "xcode-sync", CreatedAt: createdAt}, Parse("replica-xcode-sync-20170102-150405"))
+
+	for _, aName := range []string{InitialName, "before-upgrade", "replica-xcode", "replica-20171302-150405"} {
+		snapshot := Parse(aName)
+		require.Equal(t, SnapshotModel{Name: aName}, snapshot)
+		require.False(t, snapshot.IsTimestamped())
+	}
+}
+
+func TestValidateLabel(t *testing.T) {
+	require.NoError(t, ValidateLabel(""))
+	require.NoError(t, ValidateLabel("xcode-sync"))
+	require.Error(t, ValidateLabel("Xcode"))
+	require.Error(t, ValidateLabel("xcode sync"))
+	require.Error(t, ValidateLabel("-xcode"))
+}
+
+func TestParseAll(t *testing.T) {
+	snapshots := ParseAll([]string{"manual", "replica-20170103-000000", InitialName, "replica-xcode-20170102-000000", "before-upgrade"})
+	require.Equal(t, []string{InitialName, "replica-xcode-20170102-000000", "replica-20170103-000000", "before-upgrade", "manual"}, namesOf(snapshots))
+}
+
+func TestSelectExpired(t *testing.T) {
+	snapshots := ParseAll([]string{
+		InitialName,
+		"manual",
+		"replica-xcode-20170101-000000",
+		"replica-xcode-20170103-000000",
+		"replica-xcode-20170102-000000",
+		"replica-20170101-000000",
+	})
+
+	require.Equal(t, []string{"replica-xcode-20170101-000000", "replica-xcode-20170102-000000"}, namesOf(SelectExpired(snapshots, "xcode", 1)))
+	require.Equal(t, []string{}, namesOf(SelectExpired(snapshots, "xcode", 3)))
+	require.Equal(t, []string{}, namesOf(SelectExpired(snapshots, "", 1)))
+	require.Equal(t, []string{}, namesOf(SelectExpired(snapshots, "xcode", 0)))
+}
+
+func namesOf(snapshots []SnapshotModel) []string {
+	names := []string{}
+	for _, aSnapshot := range snapshots {
+		names = append(names, aSnapshot.Name)
+	}
+	return names
+}
@@ -0,0 +1,150 @@
+package sshkey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// PrivateKeyFileExtension is the extension of the private key file
+	// which replica stores next to an artifact (DMG, vagrant box) the key belongs to.
+	PrivateKeyFileExtension = ".id_ed25519"
+
+	keyTypeED25519 = "ssh-ed25519"
+)
+
+// supportedAuthorizedKeyTypes are the key types accepted in an authorized_keys file
+var supportedAuthorizedKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-dss",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+}
+
+// KeyPairModel ...
+type KeyPairModel struct {
+	// PrivateKeyPEM is the private key, in OpenSSH format
+	PrivateKeyPEM []byte
+	// AuthorizedKey is the public key, in authorized_keys format
+	AuthorizedKey string
+}
+
+// PrivateKeyPathFor returns the path of the private key file
+// which belongs to the artifact (DMG, vagrant box) at artifactPath
+func PrivateKeyPathFor(artifactPath string) string {
+	return strings.TrimSuffix(artifactPath, filepath.Ext(artifactPath)) + PrivateKeyFileExtension
+}
+
+// GenerateED25519KeyPair ...
+func GenerateED25519KeyPair(comment string) (KeyPairModel, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyPairModel{}, fmt.Errorf("Failed to generate ed25519 key, error: %s", err)
+	}
+
+	var checkBytes [4]byte
+	if _, err := rand.Read(checkBytes[:]); err != nil {
+		return KeyPairModel{}, fmt.Errorf("Failed to generate random check bytes, error: %s", err)
+	}
+
+	publicKeyBlob := marshalPublicKey(publicKey)
+
+	// openssh-key-v1 format, see: PROTOCOL.key in the OpenSSH sources
+	privateSection := bytes.Buffer{}
+	privateSection.Write(checkBytes[:])
+	privateSection.Write(checkBytes[:])
+	writeString(&privateSection, []byte(keyTypeED25519))
+	writeString(&privateSection, publicKey)
+	writeString(&privateSection, privateKey)
+	writeString(&privateSection, []byte(comment))
+	for i := byte(1); privateSection.Len()%8 != 0; i++ {
+		privateSection.WriteByte(i)
+	}
+
+	keyData := bytes.Buffer{}
+	keyData.WriteString("openssh-key-v1\x00")
+	writeString(&keyData, []byte("none"))
+	writeString(&keyData, []byte("none"))
+	writeString(&keyData, []byte{})
+	writeUint32(&keyData, 1)
+	writeString(&keyData, publicKeyBlob)
+	writeString(&keyData, privateSection.Bytes())
+
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: keyData.Bytes(),
+	})
+
+	authorizedKey := keyTypeED25519 + " " + base64.StdEncoding.EncodeToString(publicKeyBlob)
+	if comment != "" {
+		authorizedKey += " " + comment
+	}
+
+	return KeyPairModel{
+		PrivateKeyPEM: privateKeyPEM,
+		AuthorizedKey: authorizedKey,
+	}, nil
+}
+
+// ParseAuthorizedKeys returns the public keys defined in an authorized_keys (or .pub) file content.
+// Empty and comment lines are skipped, every other line has to be a public key
+// of a supported type.
+func ParseAuthorizedKeys(content string) ([]string, error) {
+	keys := []string{}
+	for idx, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return []string{}, fmt.Errorf("Invalid public key in line %d: %s", idx+1, line)
+		}
+		if !isSupportedKeyType(fields[0]) {
+			return []string{}, fmt.Errorf("Unsupported public key type (%s) in line %d", fields[0], idx+1)
+		}
+		if _, err := base64.StdEncoding.DecodeString(fields[1]); err != nil {
+			return []string{}, fmt.Errorf("Invalid public key data in line %d, error: %s", idx+1, err)
+		}
+
+		keys = append(keys, line)
+	}
+	return keys, nil
+}
+
+func isSupportedKeyType(keyType string) bool {
+	for _, aType := range supportedAuthorizedKeyTypes {
+		if aType == keyType {
+			return true
+		}
+	}
+	return false
+}
+
+func marshalPublicKey(publicKey ed25519.PublicKey) []byte {
+	blob := bytes.Buffer{}
+	writeString(&blob, []byte(keyTypeED25519))
+	writeString(&blob, publicKey)
+	return blob.Bytes()
+}
+
+func writeUint32(buf *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], value)
+	buf.Write(b[:])
+}
+
+func writeString(buf *bytes.Buffer, value []byte) {
+	writeUint32(buf, uint32(len(value)))
+	buf.Write(value)
+}
@@ -2,41 +2,313 @@ package vagrantbox
 
 import (
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
+	"time"
 
 	"path/filepath"
 
-	"github.com/bitrise-io/go-utils/cmdex"
+	"github.com/bitrise-io/go-utils/colorstring"
 	"github.com/bitrise-io/go-utils/pathutil"
+	"github.com/bitrise-io/replica/artifacts"
+	"github.com/bitrise-io/replica/hypervisor"
 	"github.com/bitrise-io/replica/resources"
+	"github.com/bitrise-io/replica/sshkey"
 )
 
-// CreateVirtualboxVagrantBoxFromPreparedMacOSInstallDMG ...
-func CreateVirtualboxVagrantBoxFromPreparedMacOSInstallDMG(macOSInstallDMGPath string) (string, error) {
+const (
+	// cltPackageDirName is the directory (relative to the packer dir)
+	// which is uploaded into the VM, with the Xcode CLI tools package in it (if any)
+	cltPackageDirName = "clt"
+)
+
+// Options ...
+type Options struct {
+	// Provider is the hypervisor the box is built with, and for.
+	// Default: the default provider (VirtualBox)
+	Provider hypervisor.Provider
+	// AppleSMCOSK is the Apple SMC key of the QEMU VM, required by the qemu provider
+	AppleSMCOSK string
+	// CLTPackagePath is a local Xcode Command Line Tools installer (.dmg or .pkg).
+	// If empty the CLI tools are installed with softwareupdate.
+	CLTPackagePath string
+	// IsAutologin if true the automatic GUI login of the user is kept enabled in the box
+	IsAutologin bool
+	// TrustedCAPaths are PEM encoded root CA certificates,
+	// which are added to the system trust store of the box
+	TrustedCAPaths []string
+	// ProvisionSteps are the user defined provisioning steps
+	ProvisionSteps ProvisionStepsModel
+	// Hardware overrides the default hardware configuration of the VM
+	Hardware hypervisor.HardwareModel
+	// PackerVars are additional values of the variables declared by the packer template
+	PackerVars map[string]string
+	// PackerLogPath if set, packer's debug log (PACKER_LOG) is written into this file
+	PackerLogPath string
+}
+
+// boxBuildModel is the build of the box, resolved from (and validated against) the options
+type boxBuildModel struct {
+	provider              hypervisor.Provider
+	isAppleSMCOSKRequired bool
+	trustedCAs            []TrustedCAModel
+	hardware              hypervisor.HardwareModel
+	template              packerTemplateModel
+}
+
+// newBoxBuild validates the options, and resolves the provider, the hardware and the packer template of the box
+func newBoxBuild(opts Options) (boxBuildModel, error) {
+	provider := opts.Provider
+	if provider == nil {
+		defaultProvider, err := hypervisor.NewProvider(hypervisor.DefaultProviderName, hypervisor.Options{})
+		if err != nil {
+			return boxBuildModel{}, err
+		}
+		provider = defaultProvider
+	}
+	_, isAppleSMCOSKRequired := provider.PackerVariables()[hypervisor.AppleSMCOSKVariable]
+	if isAppleSMCOSKRequired && opts.AppleSMCOSK == "" {
+		return boxBuildModel{}, fmt.Errorf("The Apple SMC key (OSK) is required by the %s provider", provider.Name())
+	}
+	if err := validateCLTPackagePath(opts.CLTPackagePath); err != nil {
+		return boxBuildModel{}, err
+	}
+	trustedCAs, err := readTrustedCACertificates(opts.TrustedCAPaths, time.Now())
+	if err != nil {
+		return boxBuildModel{}, err
+	}
+	hardware := hypervisor.DefaultHardware(provider).Merge(opts.Hardware)
+	if err := hardware.Validate(provider); err != nil {
+		return boxBuildModel{}, fmt.Errorf("Invalid hardware configuration, error: %s", err)
+	}
+	template, err := newPackerTemplate(provider, hardware, opts.ProvisionSteps)
+	if err != nil {
+		return boxBuildModel{}, err
+	}
+	if err := validatePackerVars(opts.PackerVars, template.Variables); err != nil {
+		return boxBuildModel{}, err
+	}
+
+	return boxBuildModel{
+		provider:              provider,
+		isAppleSMCOSKRequired: isAppleSMCOSKRequired,
+		trustedCAs:            trustedCAs,
+		hardware:              hardware,
+		template:              template,
+	}, nil
+}
+
+// ValidateOptions checks the options of the box (the Xcode CLI tools package, the trusted root CA certificates,
+// the hardware and the packer variables), so that an invalid option can fail before the install DMG is created
+func ValidateOptions(opts Options) error {
+	_, err := newBoxBuild(opts)
+	return err
+}
+
+// CreateVagrantBoxFromPreparedMacOSInstallDMG ...
+func CreateVagrantBoxFromPreparedMacOSInstallDMG(macOSInstallDMGPath string, opts Options) (string, error) {
+	build, err := newBoxBuild(opts)
+	if err != nil {
+		return "", err
+	}
+	provider, trustedCAs, hardware, template := build.provider, build.trustedCAs, build.hardware, build.template
+
 	outputDir, err := pathutil.AbsPath("./_out/packer")
 	if err != nil {
 		return "", fmt.Errorf("Failed to determin absolute output dir path, error: %s", err)
 	}
 
+	vagrantBoxPath := ""
 	{
 		if err := resources.UncompressDirectory("packer", outputDir); err != nil {
 			return "", fmt.Errorf("Failed to uncompress packer directory, error: %s", err)
 		}
 
-		cmd := cmdex.NewCommandWithStandardOuts("packer",
-			"build",
-			"--only", "virtualbox-iso",
-			"--var", "iso_url="+macOSInstallDMGPath,
-			"--var", "autologin=true",
-			"./template.json",
-		).SetDir(outputDir)
+		packerVersion, err := detectPackerVersion()
+		if err != nil {
+			return "", err
+		}
+		templateFileName, err := writePackerTemplateOfFormat(outputDir, template, packerTemplateFormatForVersion(packerVersion))
+		if err != nil {
+			return "", err
+		}
+		log.Printf(" => packer %s, using template: %s", packerVersion, templateFileName)
+
+		cltPackageFileName, err := prepareCLTPackageDir(opts.CLTPackagePath, filepath.Join(outputDir, cltPackageDirName))
+		if err != nil {
+			return "", fmt.Errorf("Failed to prepare the Xcode CLI tools package, error: %s", err)
+		}
+
+		if err := prepareTrustedCADir(trustedCAs, filepath.Join(outputDir, trustedCADirName)); err != nil {
+			return "", fmt.Errorf("Failed to prepare the trusted root CA certificates, error: %s", err)
+		}
+		for _, aTrustedCA := range trustedCAs {
+			log.Println(" => Trusted root CA:", aTrustedCA.Certificate.Subject.CommonName, "("+aTrustedCA.Path+")")
+		}
+
+		vars := map[string]string{
+			"iso_url":     macOSInstallDMGPath,
+			"autologin":   fmt.Sprintf("%t", opts.IsAutologin),
+			"clt_package": cltPackageFileName,
+		}
+		if build.isAppleSMCOSKRequired {
+			vars[hypervisor.AppleSMCOSKVariable] = opts.AppleSMCOSK
+		}
+		for name, value := range opts.PackerVars {
+			vars[name] = value
+		}
+		args, printableArgs := packerBuildArgs(vars, templateFileName)
 
 		fmt.Println()
-		log.Printf("$ %s", cmd.PrintableCommandArgs())
+		log.Printf("$ packer build -machine-readable %s", strings.Join(printableArgs, " "))
 		fmt.Println()
-		if err := cmd.Run(); err != nil {
+		result, err := runPackerBuild(outputDir, args, provisioningStepsCount(template.Provisioners), opts.PackerLogPath)
+		if err != nil {
 			return "", fmt.Errorf("Failed to run packer command, error: %s", err)
 		}
+
+		boxFile, isFound := result.VagrantBoxFile()
+		if !isFound {
+			return "", fmt.Errorf("packer finished without creating a vagrant box")
+		}
+		vagrantBoxPath = boxFile
+		if !filepath.IsAbs(vagrantBoxPath) {
+			vagrantBoxPath = filepath.Join(outputDir, vagrantBoxPath)
+		}
+	}
+
+	// the box trusts the same SSH key(s) as the DMG it was created from
+	if isExist, err := pathutil.IsPathExists(sshkey.PrivateKeyPathFor(macOSInstallDMGPath)); err != nil {
+		return vagrantBoxPath, fmt.Errorf("Failed to check whether the DMG has an SSH private key, error: %s", err)
+	} else if isExist {
+		boxPrivateKeyPath := sshkey.PrivateKeyPathFor(vagrantBoxPath)
+		if err := copyFile(sshkey.PrivateKeyPathFor(macOSInstallDMGPath), boxPrivateKeyPath, 0600); err != nil {
+			return vagrantBoxPath, fmt.Errorf("Failed to copy SSH private key next to the vagrant box, error: %s", err)
+		}
+		log.Println(colorstring.Green(" => SSH private key of the box saved to:"), boxPrivateKeyPath)
 	}
-	return filepath.Join(outputDir, "packer_virtualbox-iso_virtualbox.box"), nil
+
+	manifest := artifacts.ManifestModel{
+		Kind:       artifacts.KindBox,
+		CreatedAt:  time.Now(),
+		SourcePath: macOSInstallDMGPath,
+		Settings:   manifestSettings(provider, hardware, trustedCAs, opts),
+	}
+	if dmgManifest, isFound, err := artifacts.ReadManifest(macOSInstallDMGPath); err != nil {
+		return vagrantBoxPath, err
+	} else if isFound {
+		manifest.MacOSVersion, manifest.MacOSBuild = dmgManifest.MacOSVersion, dmgManifest.MacOSBuild
+	} else {
+		manifest.MacOSVersion, manifest.MacOSBuild, _ = artifacts.ParseDMGFileName(filepath.Base(macOSInstallDMGPath))
+	}
+	if err := artifacts.WriteManifest(vagrantBoxPath, manifest); err != nil {
+		return vagrantBoxPath, err
+	}
+
+	return vagrantBoxPath, nil
+}
+
+// manifestSettings returns the customization settings of the box, recorded in its manifest
+func manifestSettings(provider hypervisor.Provider, hardware hypervisor.HardwareModel, trustedCAs []TrustedCAModel, opts Options) map[string]string {
+	settings := map[string]string{
+		"provider":  provider.Name(),
+		"autologin": fmt.Sprintf("%t", opts.IsAutologin),
+		"cpus":      fmt.Sprintf("%d", hardware.CPUs),
+		"memory":    fmt.Sprintf("%d MB", hardware.MemoryMB),
+		"disk_size": fmt.Sprintf("%d MB", hardware.DiskSizeMB),
+	}
+	if opts.CLTPackagePath != "" {
+		settings["clt_package"] = filepath.Base(opts.CLTPackagePath)
+	}
+	if len(trustedCAs) > 0 {
+		names := []string{}
+		for _, aTrustedCA := range trustedCAs {
+			names = append(names, aTrustedCA.Certificate.Subject.CommonName)
+		}
+		settings["trusted_cas"] = strings.Join(names, ", ")
+	}
+	if len(opts.ProvisionSteps.Steps) > 0 {
+		names := []string{}
+		for _, aStep := range opts.ProvisionSteps.Steps {
+			names = append(names, aStep.Name)
+		}
+		settings["provision_steps"] = strings.Join(names, ", ")
+	}
+	for name, value := range opts.PackerVars {
+		if secretPackerVariables[name] {
+			value = "[REDACTED]"
+		}
+		settings["packer_var."+name] = value
+	}
+	return settings
+}
+
+func validateCLTPackagePath(cltPackagePath string) error {
+	if cltPackagePath == "" {
+		return nil
+	}
+
+	ext := strings.ToLower(filepath.Ext(cltPackagePath))
+	if ext != ".dmg" && ext != ".pkg" {
+		return fmt.Errorf("Xcode CLI tools package has to be a .dmg or a .pkg file (path: %s)", cltPackagePath)
+	}
+
+	info, isExist, err := pathutil.PathCheckAndInfos(cltPackagePath)
+	if err != nil {
+		return fmt.Errorf("Failed to check Xcode CLI tools package (path: %s), error: %s", cltPackagePath, err)
+	} else if !isExist {
+		return fmt.Errorf("Xcode CLI tools package does not exist (path: %s)", cltPackagePath)
+	} else if info.IsDir() {
+		return fmt.Errorf("Xcode CLI tools package is a directory, not a file (path: %s)", cltPackagePath)
+	}
+	return nil
+}
+
+// prepareCLTPackageDir (re)creates the directory which is uploaded into the VM,
+// and copies the Xcode CLI tools package into it.
+// Returns the file name of the package in the directory, or an empty string if no package is provided.
+func prepareCLTPackageDir(cltPackagePath, cltPackageDirPath string) (string, error) {
+	if err := os.RemoveAll(cltPackageDirPath); err != nil {
+		return "", fmt.Errorf("Failed to remove the previous package directory (path: %s), error: %s", cltPackageDirPath, err)
+	}
+	if err := pathutil.EnsureDirExist(cltPackageDirPath); err != nil {
+		return "", fmt.Errorf("Failed to create package directory (path: %s), error: %s", cltPackageDirPath, err)
+	}
+
+	if cltPackagePath == "" {
+		return "", nil
+	}
+
+	fileName := "clt" + strings.ToLower(filepath.Ext(cltPackagePath))
+	if err := copyFile(cltPackagePath, filepath.Join(cltPackageDirPath, fileName), 0644); err != nil {
+		return "", fmt.Errorf("Failed to copy package (path: %s), error: %s", cltPackagePath, err)
+	}
+	return fileName, nil
+}
+
+func copyFile(srcPath, dstPath string, perm os.FileMode) (err error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := src.Close(); cerr != nil {
+			log.Printf(" [!] Failed to close file (%s), error: %s", srcPath, cerr)
+		}
+	}()
+
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := dst.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	_, err = io.Copy(dst, src)
+	return err
 }
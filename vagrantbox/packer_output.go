@@ -0,0 +1,321 @@
+package vagrantbox
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+	"github.com/bitrise-io/go-utils/colorstring"
+)
+
+// vagrantPostProcessorID is the builder-id of the artifacts created by the vagrant post-processor
+const vagrantPostProcessorID = "mitchellh.post-processor.vagrant"
+
+// packerEventModel is a line of packer's machine-readable output:
+// timestamp,target,type,data...
+type packerEventModel struct {
+	Timestamp time.Time
+	Target    string
+	Type      string
+	Data      []string
+}
+
+// packerArtifactModel is an artifact reported by packer, at the end of the build
+type packerArtifactModel struct {
+	Target    string
+	BuilderID string
+	ID        string
+	Files     []string
+}
+
+// packerBuildResultModel is the result of a packer build, collected from the machine-readable output
+type packerBuildResultModel struct {
+	// StartedBuilds are the builds (e.g. virtualbox-iso) which started
+	StartedBuilds []string
+	// FinishedBuilds are the builds which finished successfully
+	FinishedBuilds []string
+	// Errors are the build errors, by build
+	Errors map[string][]string
+	// Artifacts are the artifacts of the successful builds
+	Artifacts []packerArtifactModel
+}
+
+// VagrantBoxFile returns the box file created by the vagrant post-processor, if any
+func (result packerBuildResultModel) VagrantBoxFile() (string, bool) {
+	for _, anArtifact := range result.Artifacts {
+		if anArtifact.BuilderID != vagrantPostProcessorID {
+			continue
+		}
+		for _, aFile := range anArtifact.Files {
+			if strings.HasSuffix(aFile, ".box") {
+				return aFile, true
+			}
+		}
+	}
+	return "", false
+}
+
+// FailureReason returns the errors of the build, in a single message
+func (result packerBuildResultModel) FailureReason() string {
+	targets := []string{}
+	for aTarget := range result.Errors {
+		targets = append(targets, aTarget)
+	}
+	sort.Strings(targets)
+
+	reasons := []string{}
+	for _, aTarget := range targets {
+		for _, anError := range result.Errors[aTarget] {
+			if aTarget == "" {
+				reasons = append(reasons, anError)
+			} else {
+				reasons = append(reasons, aTarget+": "+anError)
+			}
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// unescapePackerData reverts the escaping of packer's machine-readable output
+func unescapePackerData(data string) string {
+	return strings.NewReplacer(
+		"%!(PACKER_COMMA)", ",",
+		`\n`, "\n",
+		`\r`, "\r",
+	).Replace(data)
+}
+
+// parsePackerEvent parses a line of packer's machine-readable output
+func parsePackerEvent(line string) (packerEventModel, error) {
+	fields := strings.Split(strings.TrimRight(line, "\r\n"), ",")
+	if len(fields) < 3 {
+		return packerEventModel{}, fmt.Errorf("not a machine-readable line: %s", line)
+	}
+
+	timestamp, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return packerEventModel{}, fmt.Errorf("invalid timestamp (%s), error: %s", fields[0], err)
+	}
+
+	data := []string{}
+	for _, aField := range fields[3:] {
+		data = append(data, unescapePackerData(aField))
+	}
+	return packerEventModel{
+		Timestamp: time.Unix(timestamp, 0),
+		Target:    fields[1],
+		Type:      fields[2],
+		Data:      data,
+	}, nil
+}
+
+var (
+	packerBuildStartRegexp    = regexp.MustCompile(`^(\S+?):? output will be in this color\.$`)
+	packerBuildFinishedRegexp = regexp.MustCompile(`^Build '([^']+)' finished`)
+	packerBuildErroredRegexp  = regexp.MustCompile(`^Build '([^']+)' errored(?: after [^:]+)?: (.*)$`)
+	// the shell, the shell-local and the file provisioners announce themselves with different messages
+	packerProvisioningRegexp = regexp.MustCompile(`^==> (\S+): (Provisioning with .*|Running local shell script: .*|Uploading .+ => .+)$`)
+)
+
+// announcingProvisionerTypes are the types of the provisioners, which announce themselves when they start
+// (see: packerProvisioningRegexp), the progress of the provisioning is counted by these messages
+var announcingProvisionerTypes = map[string]bool{
+	"shell":       true,
+	"shell-local": true,
+	"file":        true,
+}
+
+// provisioningStepsCount returns the number of the provisioners which announce themselves, to show the progress
+func provisioningStepsCount(provisioners []map[string]interface{}) int {
+	count := 0
+	for _, aProvisioner := range provisioners {
+		if provisionerType, isString := aProvisioner["type"].(string); isString && announcingProvisionerTypes[provisionerType] {
+			count++
+		}
+	}
+	return count
+}
+
+// packerOutputParser collects the result of the build from the machine-readable events,
+// and prints the UI messages and the progress of the build
+type packerOutputParser struct {
+	out io.Writer
+	// provisionersCount is the number of provisioners which announce themselves, to show the progress
+	provisionersCount int
+	provisionedCount  int
+	result            packerBuildResultModel
+}
+
+func newPackerOutputParser(out io.Writer, provisionersCount int) *packerOutputParser {
+	return &packerOutputParser{
+		out:               out,
+		provisionersCount: provisionersCount,
+		result:            packerBuildResultModel{Errors: map[string][]string{}},
+	}
+}
+
+func (parser *packerOutputParser) printf(format string, args ...interface{}) {
+	if _, err := fmt.Fprintf(parser.out, format, args...); err != nil {
+		log.Printf(" [!] Failed to print packer output, error: %s", err)
+	}
+}
+
+func (parser *packerOutputParser) handleUIMessage(level, message string) {
+	trimmed := strings.TrimSpace(message)
+
+	if match := packerBuildStartRegexp.FindStringSubmatch(trimmed); match != nil {
+		parser.result.StartedBuilds = append(parser.result.StartedBuilds, match[1])
+		parser.printf("%s\n", colorstring.Green(" => Build started: "+match[1]))
+		return
+	}
+	if match := packerBuildFinishedRegexp.FindStringSubmatch(trimmed); match != nil {
+		parser.result.FinishedBuilds = append(parser.result.FinishedBuilds, match[1])
+		parser.printf("%s\n", colorstring.Green(" => Build finished: "+match[1]))
+		return
+	}
+	if match := packerBuildErroredRegexp.FindStringSubmatch(trimmed); match != nil {
+		parser.printf("%s\n", colorstring.Red(" => Build failed: "+match[1]+": "+match[2]))
+		return
+	}
+	if match := packerProvisioningRegexp.FindStringSubmatch(trimmed); match != nil {
+		parser.provisionedCount++
+		parser.printf("%s\n", colorstring.Blue(fmt.Sprintf(" => [%d/%d] %s", parser.provisionedCount, parser.provisionersCount, match[2])))
+		return
+	}
+
+	if level == "error" {
+		parser.printf("%s\n", colorstring.Red(message))
+		return
+	}
+	parser.printf("%s\n", message)
+}
+
+// handleEvent processes a machine-readable event
+func (parser *packerOutputParser) handleEvent(event packerEventModel) {
+	switch event.Type {
+	case "ui":
+		if len(event.Data) >= 2 {
+			parser.handleUIMessage(event.Data[0], event.Data[1])
+		}
+	case "error":
+		if len(event.Data) >= 1 {
+			parser.result.Errors[event.Target] = append(parser.result.Errors[event.Target], event.Data[0])
+		}
+	case "artifact":
+		parser.handleArtifactEvent(event)
+	}
+}
+
+// handleArtifactEvent processes an artifact event:
+// target,artifact,INDEX,SUBTYPE,DATA...
+func (parser *packerOutputParser) handleArtifactEvent(event packerEventModel) {
+	if len(event.Data) < 2 {
+		return
+	}
+	index, err := strconv.Atoi(event.Data[0])
+	if err != nil {
+		return
+	}
+
+	// the artifacts are reported one by one, the index is relative to the target
+	var artifact *packerArtifactModel
+	artifactIdx := -1
+	for idx, anArtifact := range parser.result.Artifacts {
+		if anArtifact.Target == event.Target {
+			artifactIdx++
+			if artifactIdx == index {
+				artifact = &parser.result.Artifacts[idx]
+				break
+			}
+		}
+	}
+	if artifact == nil {
+		parser.result.Artifacts = append(parser.result.Artifacts, packerArtifactModel{Target: event.Target})
+		artifact = &parser.result.Artifacts[len(parser.result.Artifacts)-1]
+	}
+
+	switch event.Data[1] {
+	case "builder-id":
+		if len(event.Data) >= 3 {
+			artifact.BuilderID = event.Data[2]
+		}
+	case "id":
+		if len(event.Data) >= 3 {
+			artifact.ID = event.Data[2]
+		}
+	case "file":
+		if len(event.Data) >= 4 {
+			artifact.Files = append(artifact.Files, event.Data[3])
+		}
+	}
+}
+
+// parse processes the machine-readable output of packer, until the end of the stream
+func (parser *packerOutputParser) parse(reader io.Reader) (packerBuildResultModel, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		event, err := parsePackerEvent(line)
+		if err != nil {
+			// not a machine-readable line (e.g. the output of a local command), print it as it is
+			parser.printf("%s\n", line)
+			continue
+		}
+		parser.handleEvent(event)
+	}
+	return parser.result, scanner.Err()
+}
+
+// runPackerBuild runs packer build with machine-readable output, in the packer dir,
+// and prints the UI messages and the progress of the build.
+// If logPath is set, packer's debug log is written into it.
+func runPackerBuild(packerDir string, args []string, provisionersCount int, logPath string) (packerBuildResultModel, error) {
+	command := cmdex.NewCommand("packer", append([]string{"build", "-machine-readable"}, args...)...).
+		SetDir(packerDir).
+		SetStdin(os.Stdin).
+		SetStderr(os.Stderr)
+	if logPath != "" {
+		command.AppendEnvs([]string{"PACKER_LOG=1", "PACKER_LOG_PATH=" + logPath})
+		log.Println(" => packer log:", logPath)
+	}
+	cmd := command.GetCmd()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return packerBuildResultModel{}, fmt.Errorf("Failed to get the output of packer, error: %s", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return packerBuildResultModel{}, fmt.Errorf("Failed to start packer, error: %s", err)
+	}
+
+	result, parseErr := newPackerOutputParser(os.Stdout, provisionersCount).parse(stdout)
+	if parseErr != nil {
+		// keep reading, so that packer does not block on a full pipe
+		if _, err := io.Copy(ioutil.Discard, stdout); err != nil {
+			log.Printf(" [!] Failed to read the output of packer, error: %s", err)
+		}
+	}
+	if err := cmd.Wait(); err != nil {
+		if reason := result.FailureReason(); reason != "" {
+			return result, fmt.Errorf("packer build failed: %s", reason)
+		}
+		return result, fmt.Errorf("packer build failed, error: %s", err)
+	}
+	if parseErr != nil {
+		return result, fmt.Errorf("Failed to read the output of packer, error: %s", parseErr)
+	}
+	return result, nil
+}
@@ -0,0 +1,141 @@
+package vagrantbox
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// parsePackerOutputFixture parses a testdata/synthetic-packer-build-*.txt fixture.
+// The fixtures are not captured from real builds: they are hand-written in packer's machine-readable format,
+// abridged, with the messages of the built-in provisioners and scripts.
+func parsePackerOutputFixture(t *testing.T, fixtureName string, provisionersCount int) (packerBuildResultModel, string) {
+	fixture, err := os.Open(filepath.Join("testdata", fixtureName))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, fixture.Close())
+	}()
+
+	var out bytes.Buffer
+	result, err := newPackerOutputParser(&out, provisionersCount).parse(fixture)
+	require.NoError(t, err)
+	return result, out.String()
+}
+
+func builtInProvisioningStepsCount(t *testing.T) int {
+	provisioners, err := insertProvisionSteps(builtInProvisioners(), ProvisionStepsModel{})
+	require.NoError(t, err)
+	return provisioningStepsCount(provisioners)
+}
+
+func Test_parsePackerEvent(t *testing.T) {
+	t.Log("ui message, with escaped comma and newline")
+	{
+		event, err := parsePackerEvent(`1487171205,,ui,message,    virtualbox-iso: a%!(PACKER_COMMA) b\nc`)
+		require.NoError(t, err)
+		require.Equal(t, packerEventModel{
+			Timestamp: time.Unix(1487171205, 0),
+			Target:    "",
+			Type:      "ui",
+			Data:      []string{"message", "    virtualbox-iso: a, b\nc"},
+		}, event)
+	}
+
+	t.Log("artifact")
+	{
+		event, err := parsePackerEvent("1487173300,virtualbox-iso,artifact,0,file,0,packer_virtualbox-iso_virtualbox.box")
+		require.NoError(t, err)
+		require.Equal(t, "virtualbox-iso", event.Target)
+		require.Equal(t, "artifact", event.Type)
+		require.Equal(t, []string{"0", "file", "0", "packer_virtualbox-iso_virtualbox.box"}, event.Data)
+	}
+
+	t.Log("not machine-readable")
+	{
+		_, err := parsePackerEvent("Build 'virtualbox-iso' finished.")
+		require.Error(t, err)
+	}
+}
+
+func Test_packerOutputParser(t *testing.T) {
+	t.Log("successful build")
+	{
+		result, out := parsePackerOutputFixture(t, "synthetic-packer-build-success.txt", builtInProvisioningStepsCount(t))
+		require.Equal(t, []string{"virtualbox-iso"}, result.StartedBuilds)
+		require.Equal(t, []string{"virtualbox-iso"}, result.FinishedBuilds)
+		require.Equal(t, 0, len(result.Errors))
+		require.Equal(t, []packerArtifactModel{
+			{
+				Target:    "virtualbox-iso",
+				BuilderID: vagrantPostProcessorID,
+				ID:        "virtualbox",
+				Files:     []string{"packer_virtualbox-iso_virtualbox.box"},
+			},
+		}, result.Artifacts)
+
+		boxFile, isFound := result.VagrantBoxFile()
+		require.True(t, isFound)
+		require.Equal(t, "packer_virtualbox-iso_virtualbox.box", boxFile)
+
+		require.Contains(t, out, " => [1/10] Running local shell script: /var/folders/x7/packer-shell163211447")
+		require.Contains(t, out, " => [2/10] Provisioning with shell script: /var/folders/x7/packer-shell472312312")
+		require.Contains(t, out, " => [3/10] Uploading ./clt/ => /private/tmp/clt")
+		require.Contains(t, out, " => [10/10] Provisioning with shell script: ./scripts/shrink.sh")
+		require.Contains(t, out, "    virtualbox-iso: Checking the authorized keys of the vagrant user\n")
+		require.Contains(t, out, " => Build finished: virtualbox-iso")
+	}
+
+	t.Log("failed build")
+	{
+		result, out := parsePackerOutputFixture(t, "synthetic-packer-build-failure.txt", builtInProvisioningStepsCount(t))
+		require.Equal(t, []string{"virtualbox-iso"}, result.StartedBuilds)
+		require.Equal(t, 0, len(result.FinishedBuilds))
+		require.Equal(t, 0, len(result.Artifacts))
+		require.Equal(t, "virtualbox-iso: Script exited with non-zero exit status: 1", result.FailureReason())
+
+		_, isFound := result.VagrantBoxFile()
+		require.False(t, isFound)
+
+		require.Contains(t, out, " => [7/10] Provisioning with shell script: ./scripts/xcode-cli-tools.sh")
+		require.Contains(t, out, " => Build failed: virtualbox-iso: Script exited with non-zero exit status: 1")
+	}
+
+	t.Log("successful build, HCL2 template, with the builder artifact kept")
+	{
+		result, out := parsePackerOutputFixture(t, "synthetic-packer-build-success-hcl.txt", builtInProvisioningStepsCount(t))
+		require.Equal(t, []string{"virtualbox-iso.replica"}, result.StartedBuilds)
+		require.Equal(t, []string{"virtualbox-iso.replica"}, result.FinishedBuilds)
+		require.Equal(t, 2, len(result.Artifacts))
+		require.Equal(t, "mitchellh.virtualbox", result.Artifacts[0].BuilderID)
+		require.Equal(t, 2, len(result.Artifacts[0].Files))
+
+		boxFile, isFound := result.VagrantBoxFile()
+		require.True(t, isFound)
+		require.Equal(t, "packer_virtualbox-iso_virtualbox.box", boxFile)
+
+		require.Contains(t, out, " => [1/10] Running local shell script: /var/folders/x7/packer-shell163211447")
+	}
+}
+
+func Test_provisioningStepsCount(t *testing.T) {
+	t.Log("every built-in provisioner announces itself")
+	{
+		require.Equal(t, 10, builtInProvisioningStepsCount(t))
+	}
+
+	t.Log("only the announcing provisioners are counted")
+	{
+		provisioners := []map[string]interface{}{
+			{"type": "shell", "script": "./scripts/shrink.sh"},
+			{"type": "file", "source": "./clt/", "destination": "/private/tmp/clt"},
+			{"type": "shell-local", "inline": []string{"sleep 30"}},
+			{"type": "breakpoint"},
+			{"type": "ansible"},
+		}
+		require.Equal(t, 3, provisioningStepsCount(provisioners))
+	}
+}
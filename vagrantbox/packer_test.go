@@ -1 +1,149 @@
 package vagrantbox
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateCLTPackagePath(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	pkgPath := filepath.Join(tmpDir, "Command Line Tools.pkg")
+	require.NoError(t, ioutil.WriteFile(pkgPath, []byte("pkg"), 0600))
+	dmgPath := filepath.Join(tmpDir, "Command_Line_Tools_macOS_10.12.DMG")
+	require.NoError(t, ioutil.WriteFile(dmgPath, []byte("dmg"), 0600))
+	zipPath := filepath.Join(tmpDir, "clt.zip")
+	require.NoError(t, ioutil.WriteFile(zipPath, []byte("zip"), 0600))
+	dirPath := filepath.Join(tmpDir, "dir.pkg")
+	require.NoError(t, os.Mkdir(dirPath, 0700))
+
+	require.NoError(t, validateCLTPackagePath(""))
+	require.NoError(t, validateCLTPackagePath(pkgPath))
+	require.NoError(t, validateCLTPackagePath(dmgPath))
+	require.Error(t, validateCLTPackagePath(zipPath))
+	require.Error(t, validateCLTPackagePath(dirPath))
+	require.Error(t, validateCLTPackagePath(filepath.Join(tmpDir, "missing.pkg")))
+}
+
+func TestValidateOptions(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	now := time.Now()
+	caPath := filepath.Join(tmpDir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caPath, createTestCertificatePEM(t, "Test CA", true, now.AddDate(0, 0, -1), now.AddDate(1, 0, 0)), 0600))
+	expiredPath := filepath.Join(tmpDir, "expired.pem")
+	require.NoError(t, ioutil.WriteFile(expiredPath, createTestCertificatePEM(t, "Expired CA", true, now.AddDate(-2, 0, 0), now.AddDate(-1, 0, 0)), 0600))
+	leafPath := filepath.Join(tmpDir, "leaf.pem")
+	require.NoError(t, ioutil.WriteFile(leafPath, createTestCertificatePEM(t, "Leaf", false, now.AddDate(0, 0, -1), now.AddDate(1, 0, 0)), 0600))
+	pkgPath := filepath.Join(tmpDir, "clt.pkg")
+	require.NoError(t, ioutil.WriteFile(pkgPath, []byte("pkg"), 0600))
+
+	t.Log("valid options")
+	{
+		require.NoError(t, ValidateOptions(Options{}))
+		require.NoError(t, ValidateOptions(Options{
+			Provider:       hypervisor.VirtualBox{},
+			CLTPackagePath: pkgPath,
+			TrustedCAPaths: []string{caPath},
+			Hardware:       hypervisor.HardwareModel{CPUs: 4, Chipset: "piix3"},
+			PackerVars:     map[string]string{"username": "admin"},
+		}))
+	}
+
+	t.Log("invalid options")
+	{
+		for _, anOpts := range []Options{
+			{CLTPackagePath: filepath.Join(tmpDir, "missing.pkg")},
+			{CLTPackagePath: caPath},
+			{TrustedCAPaths: []string{expiredPath}},
+			{TrustedCAPaths: []string{leafPath}},
+			{Hardware: hypervisor.HardwareModel{Chipset: "unknown"}},
+			{PackerVars: map[string]string{"unknown": "value"}},
+			{PackerVars: map[string]string{"clt_package": "clt.pkg"}},
+		} {
+			require.Error(t, ValidateOptions(anOpts), "%+v", anOpts)
+		}
+	}
+}
+
+func Test_prepareCLTPackageDir(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	cltDirPath := filepath.Join(tmpDir, "packer", "clt")
+
+	t.Log("without package: empty directory")
+	{
+		fileName, err := prepareCLTPackageDir("", cltDirPath)
+		require.NoError(t, err)
+		require.Equal(t, "", fileName)
+
+		files, err := ioutil.ReadDir(cltDirPath)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(files))
+	}
+
+	t.Log("with package")
+	{
+		pkgPath := filepath.Join(tmpDir, "Command Line Tools.PKG")
+		require.NoError(t, ioutil.WriteFile(pkgPath, []byte("pkg"), 0600))
+
+		fileName, err := prepareCLTPackageDir(pkgPath, cltDirPath)
+		require.NoError(t, err)
+		require.Equal(t, "clt.pkg", fileName)
+
+		cont, err := ioutil.ReadFile(filepath.Join(cltDirPath, "clt.pkg"))
+		require.NoError(t, err)
+		require.Equal(t, "pkg", string(cont))
+	}
+
+	t.Log("previous package is removed")
+	{
+		fileName, err := prepareCLTPackageDir("", cltDirPath)
+		require.NoError(t, err)
+		require.Equal(t, "", fileName)
+
+		files, err := ioutil.ReadDir(cltDirPath)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(files))
+	}
+}
+
+func Test_manifestSettings(t *testing.T) {
+	provider, err := hypervisor.NewProvider("virtualbox", hypervisor.Options{})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"provider":            "virtualbox",
+		"autologin":           "true",
+		"cpus":                "4",
+		"memory":              "8192 MB",
+		"disk_size":           "102400 MB",
+		"clt_package":         "Command_Line_Tools.dmg",
+		"provision_steps":     "brew, xcode-select",
+		"packer_var.headless": "true",
+		"packer_var.password": "[REDACTED]",
+	}, manifestSettings(provider, hypervisor.HardwareModel{CPUs: 4, MemoryMB: 8192, DiskSizeMB: 102400}, nil, Options{
+		IsAutologin:    true,
+		CLTPackagePath: "/tmp/Command_Line_Tools.dmg",
+		ProvisionSteps: ProvisionStepsModel{Steps: []ProvisionStepModel{{Name: "brew"}, {Name: "xcode-select"}}},
+		PackerVars:     map[string]string{"headless": "true", "password": "secret"},
+	}))
+}
@@ -0,0 +1,144 @@
+package vagrantbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/bitrise-io/replica/hypervisor"
+)
+
+// managedPackerVariables are the variables which are set by replica,
+// with the way they can be set
+var managedPackerVariables = map[string]string{
+	"iso_url":                      "the installer DMG argument",
+	"autologin":                    "--autologin",
+	"clt_package":                  "--clt-package",
+	hypervisor.AppleSMCOSKVariable: "--apple-smc-osk",
+}
+
+// secretPackerVariables are the variables whose value is not printed
+var secretPackerVariables = map[string]bool{
+	"password":                     true,
+	hypervisor.AppleSMCOSKVariable: true,
+}
+
+// PackerVariableModel is a variable declared by the packer template
+type PackerVariableModel struct {
+	Name    string
+	Default string
+	// ManagedBy is the way to set the variable, if it's set by replica,
+	// instead of --packer-var
+	ManagedBy string
+}
+
+// PackerVariables returns the variables of the packer template of the provider, sorted by name
+func PackerVariables(provider hypervisor.Provider) []PackerVariableModel {
+	variables := []PackerVariableModel{}
+	for name, defaultValue := range packerVariables(provider) {
+		variables = append(variables, PackerVariableModel{
+			Name:      name,
+			Default:   defaultValue,
+			ManagedBy: managedPackerVariables[name],
+		})
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Name < variables[j].Name })
+	return variables
+}
+
+// ParsePackerVars parses key=value pairs (e.g. the values of --packer-var)
+func ParsePackerVars(keyValues []string) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, aKeyValue := range keyValues {
+		split := strings.SplitN(aKeyValue, "=", 2)
+		if len(split) != 2 || strings.TrimSpace(split[0]) == "" {
+			return nil, fmt.Errorf("Invalid packer variable (%s), should be in the format: key=value", aKeyValue)
+		}
+		vars[strings.TrimSpace(split[0])] = split[1]
+	}
+	return vars, nil
+}
+
+// ReadPackerVarFile reads a JSON packer variable file: an object of variable names and values.
+// Numbers and booleans are converted to strings, as every variable of the template is a string.
+func ReadPackerVarFile(pth string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read packer variable file (%s), error: %s", pth, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	raw := map[string]interface{}{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("Failed to parse packer variable file (%s), error: %s", pth, err)
+	}
+
+	vars := map[string]string{}
+	for name, value := range raw {
+		switch typedValue := value.(type) {
+		case string:
+			vars[name] = typedValue
+		case json.Number:
+			vars[name] = typedValue.String()
+		case bool:
+			vars[name] = fmt.Sprintf("%t", typedValue)
+		default:
+			return nil, fmt.Errorf("Invalid value of packer variable (%s) in file (%s), should be a string, number or boolean", name, pth)
+		}
+	}
+	return vars, nil
+}
+
+// validatePackerVars checks whether the variables are declared by the template,
+// and are not set by replica
+func validatePackerVars(vars map[string]string, declared map[string]string) error {
+	names := []string{}
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, aName := range names {
+		if managedBy, isManaged := managedPackerVariables[aName]; isManaged {
+			return fmt.Errorf("The packer variable (%s) is set by replica, use %s instead", aName, managedBy)
+		}
+		if _, isDeclared := declared[aName]; !isDeclared {
+			declaredNames := []string{}
+			for name := range declared {
+				if _, isManaged := managedPackerVariables[name]; !isManaged {
+					declaredNames = append(declaredNames, name)
+				}
+			}
+			sort.Strings(declaredNames)
+			return fmt.Errorf("Unknown packer variable (%s), available variables: %s", aName, strings.Join(declaredNames, ", "))
+		}
+	}
+	return nil
+}
+
+// packerBuildArgs returns the arguments of packer build, with the variables sorted by name,
+// and the printable version of the arguments, without the values of the secret variables
+func packerBuildArgs(vars map[string]string, templateFileName string) ([]string, []string) {
+	names := []string{}
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args, printableArgs := []string{}, []string{}
+	for _, aName := range names {
+		args = append(args, "--var", aName+"="+vars[aName])
+		if secretPackerVariables[aName] {
+			printableArgs = append(printableArgs, "--var", aName+"=[REDACTED]")
+		} else {
+			printableArgs = append(printableArgs, "--var", aName+"="+vars[aName])
+		}
+	}
+	args = append(args, "./"+templateFileName)
+	printableArgs = append(printableArgs, "./"+templateFileName)
+	return args, printableArgs
+}
@@ -0,0 +1,130 @@
+package vagrantbox
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackerVariables(t *testing.T) {
+	t.Log("virtualbox")
+	{
+		variables := PackerVariables(hypervisor.VirtualBox{})
+		names := []string{}
+		for _, aVariable := range variables {
+			names = append(names, aVariable.Name)
+		}
+		require.Equal(t, []string{
+			"autologin",
+			"clt_package",
+			"install_vagrant_keys",
+			"install_xcode_cli_tools",
+			"iso_url",
+			"password",
+			"provisioning_delay",
+			"username",
+		}, names)
+		require.Equal(t, PackerVariableModel{Name: "autologin", Default: "true", ManagedBy: "--autologin"}, variables[0])
+		require.Equal(t, PackerVariableModel{Name: "username", Default: "vagrant"}, variables[7])
+	}
+
+	t.Log("qemu")
+	{
+		variables := PackerVariables(hypervisor.QEMU{})
+		require.Equal(t, hypervisor.AppleSMCOSKVariable, variables[0].Name)
+		require.Equal(t, "--apple-smc-osk", variables[0].ManagedBy)
+	}
+}
+
+func TestParsePackerVars(t *testing.T) {
+	vars, err := ParsePackerVars([]string{"username=admin", "password=p=ss", "provisioning_delay= 30", "install_vagrant_keys="})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"username":             "admin",
+		"password":             "p=ss",
+		"provisioning_delay":   " 30",
+		"install_vagrant_keys": "",
+	}, vars)
+
+	for _, aKeyValue := range []string{"username", "=admin", " =admin"} {
+		_, err := ParsePackerVars([]string{aKeyValue})
+		require.Error(t, err, aKeyValue)
+	}
+}
+
+func TestReadPackerVarFile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	t.Log("strings, numbers and booleans")
+	{
+		pth := filepath.Join(tmpDir, "vars.json")
+		require.NoError(t, ioutil.WriteFile(pth, []byte(`{"username": "admin", "provisioning_delay": 30, "install_xcode_cli_tools": false}`), 0600))
+
+		vars, err := ReadPackerVarFile(pth)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"username":                "admin",
+			"provisioning_delay":      "30",
+			"install_xcode_cli_tools": "false",
+		}, vars)
+	}
+
+	t.Log("invalid files")
+	{
+		for _, aContent := range []string{`{"username": ["admin"]}`, `{"username": null}`, `username=admin`} {
+			pth := filepath.Join(tmpDir, "invalid.json")
+			require.NoError(t, ioutil.WriteFile(pth, []byte(aContent), 0600))
+
+			_, err := ReadPackerVarFile(pth)
+			require.Error(t, err, aContent)
+		}
+
+		_, err := ReadPackerVarFile(filepath.Join(tmpDir, "missing.json"))
+		require.Error(t, err)
+	}
+}
+
+func Test_validatePackerVars(t *testing.T) {
+	declared := packerVariables(hypervisor.VirtualBox{})
+
+	require.NoError(t, validatePackerVars(nil, declared))
+	require.NoError(t, validatePackerVars(map[string]string{"username": "admin", "install_xcode_cli_tools": "false"}, declared))
+
+	err := validatePackerVars(map[string]string{"user": "admin"}, declared)
+	require.EqualError(t, err, "Unknown packer variable (user), available variables: install_vagrant_keys, install_xcode_cli_tools, password, provisioning_delay, username")
+
+	err = validatePackerVars(map[string]string{"clt_package": "clt.pkg"}, declared)
+	require.EqualError(t, err, "The packer variable (clt_package) is set by replica, use --clt-package instead")
+}
+
+func Test_packerBuildArgs(t *testing.T) {
+	args, printableArgs := packerBuildArgs(map[string]string{
+		"username":                     "admin",
+		"password":                     "secret",
+		"iso_url":                      "/path/to/installer.dmg",
+		hypervisor.AppleSMCOSKVariable: "osk",
+	}, packerHCLTemplateFileName)
+
+	require.Equal(t, []string{
+		"--var", "apple_smc_osk=osk",
+		"--var", "iso_url=/path/to/installer.dmg",
+		"--var", "password=secret",
+		"--var", "username=admin",
+		"./template.pkr.hcl",
+	}, args)
+	require.Equal(t, []string{
+		"--var", "apple_smc_osk=[REDACTED]",
+		"--var", "iso_url=/path/to/installer.dmg",
+		"--var", "password=[REDACTED]",
+		"--var", "username=admin",
+		"./template.pkr.hcl",
+	}, printableArgs)
+}
@@ -0,0 +1,79 @@
+package vagrantbox
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+const (
+	// packerTemplateFormatJSON is the legacy JSON template format
+	packerTemplateFormatJSON = "json"
+	// packerTemplateFormatHCL is the HCL2 template format, supported since packer 1.7.0
+	packerTemplateFormatHCL = "hcl"
+)
+
+// packerVersionModel ...
+type packerVersionModel struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+func (version packerVersionModel) String() string {
+	return fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch)
+}
+
+// isAtLeast returns true if the version is the same or newer than major.minor.patch
+func (version packerVersionModel) isAtLeast(major, minor, patch int) bool {
+	if version.Major != major {
+		return version.Major > major
+	}
+	if version.Minor != minor {
+		return version.Minor > minor
+	}
+	return version.Patch >= patch
+}
+
+var packerVersionRegexp = regexp.MustCompile(`(?m)^(?:Packer )?v?([0-9]+)\.([0-9]+)\.([0-9]+)`)
+
+// parsePackerVersion parses the output of the `packer version` command
+func parsePackerVersion(versionOutput string) (packerVersionModel, error) {
+	match := packerVersionRegexp.FindStringSubmatch(versionOutput)
+	if match == nil {
+		return packerVersionModel{}, fmt.Errorf("no version found in: %s", versionOutput)
+	}
+
+	numbers := []int{}
+	for _, aPart := range match[1:] {
+		number, err := strconv.Atoi(aPart)
+		if err != nil {
+			return packerVersionModel{}, fmt.Errorf("invalid version (%s), error: %s", match[0], err)
+		}
+		numbers = append(numbers, number)
+	}
+	return packerVersionModel{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// packerTemplateFormatForVersion returns the template format to use with the given packer version
+func packerTemplateFormatForVersion(version packerVersionModel) string {
+	if version.isAtLeast(1, 7, 0) {
+		return packerTemplateFormatHCL
+	}
+	return packerTemplateFormatJSON
+}
+
+// detectPackerVersion returns the version of the installed packer
+func detectPackerVersion() (packerVersionModel, error) {
+	out, err := cmdex.NewCommand("packer", "version").RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return packerVersionModel{}, fmt.Errorf("Failed to get packer version, output: %s, error: %s", out, err)
+	}
+	version, err := parsePackerVersion(out)
+	if err != nil {
+		return packerVersionModel{}, fmt.Errorf("Failed to parse packer version, error: %s", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,47 @@
+package vagrantbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePackerVersion(t *testing.T) {
+	t.Log("legacy output")
+	{
+		version, err := parsePackerVersion("Packer v0.12.3")
+		require.NoError(t, err)
+		require.Equal(t, packerVersionModel{Major: 0, Minor: 12, Patch: 3}, version)
+	}
+
+	t.Log("plain version")
+	{
+		version, err := parsePackerVersion("1.7.10")
+		require.NoError(t, err)
+		require.Equal(t, packerVersionModel{Major: 1, Minor: 7, Patch: 10}, version)
+	}
+
+	t.Log("output with an outdated version warning")
+	{
+		version, err := parsePackerVersion(`Packer v1.8.3
+
+Your version of Packer is out of date! The latest version
+is 1.9.4. You can update by downloading from www.packer.io/downloads`)
+		require.NoError(t, err)
+		require.Equal(t, packerVersionModel{Major: 1, Minor: 8, Patch: 3}, version)
+	}
+
+	t.Log("invalid")
+	{
+		_, err := parsePackerVersion("packer: command not found")
+		require.Error(t, err)
+	}
+}
+
+func Test_packerTemplateFormatForVersion(t *testing.T) {
+	require.Equal(t, packerTemplateFormatJSON, packerTemplateFormatForVersion(packerVersionModel{Major: 0, Minor: 12, Patch: 3}))
+	require.Equal(t, packerTemplateFormatJSON, packerTemplateFormatForVersion(packerVersionModel{Major: 1, Minor: 6, Patch: 6}))
+	require.Equal(t, packerTemplateFormatHCL, packerTemplateFormatForVersion(packerVersionModel{Major: 1, Minor: 7, Patch: 0}))
+	require.Equal(t, packerTemplateFormatHCL, packerTemplateFormatForVersion(packerVersionModel{Major: 1, Minor: 10, Patch: 1}))
+	require.Equal(t, packerTemplateFormatHCL, packerTemplateFormatForVersion(packerVersionModel{Major: 2, Minor: 0, Patch: 0}))
+}
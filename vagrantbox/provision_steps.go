@@ -0,0 +1,274 @@
+package vagrantbox
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+const (
+	// ProvisionStepTypeShell runs a local shell script in the VM
+	ProvisionStepTypeShell = "shell"
+	// ProvisionStepTypeFile uploads a local file or directory into the VM
+	ProvisionStepTypeFile = "file"
+
+	// ProvisionStepRunAsRoot runs the step as root
+	ProvisionStepRunAsRoot = "root"
+	// ProvisionStepRunAsUser runs the step as the user of the box (vagrant)
+	ProvisionStepRunAsUser = "user"
+
+	// shrinkStepName is the name of the built-in step which has to be the last one
+	shrinkStepName = "shrink"
+
+	rootExecuteCommand = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+	userExecuteCommand = "chmod +x {{ .Path }}; {{ .Vars }} {{ .Path }}"
+)
+
+// ProvisionStepModel is a user defined provisioning step of the box creation
+type ProvisionStepModel struct {
+	// Name identifies the step, other steps can refer to it in After / Before
+	Name string `json:"name"`
+	// Type is either shell or file
+	Type string `json:"type"`
+	// Script is the path of the shell script to run (shell step)
+	Script string `json:"script,omitempty"`
+	// Source is the path of the file or directory to upload (file step)
+	Source string `json:"source,omitempty"`
+	// Destination is the path in the VM to upload the Source to (file step)
+	Destination string `json:"destination,omitempty"`
+	// Env are the environment variables of the script (shell step)
+	Env map[string]string `json:"env,omitempty"`
+	// RunAs is either root or user, default: root
+	RunAs string `json:"run_as,omitempty"`
+	// After is the name of the step this step has to run after
+	After string `json:"after,omitempty"`
+	// Before is the name of the step this step has to run before
+	Before string `json:"before,omitempty"`
+}
+
+// ProvisionStepsModel ...
+type ProvisionStepsModel struct {
+	// Env are environment variables, available for every user defined shell step
+	Env map[string]string `json:"env,omitempty"`
+	// Steps are the user defined steps. By default a step runs after the
+	// built-in steps, but the shrink step always stays the last one.
+	Steps []ProvisionStepModel `json:"steps,omitempty"`
+}
+
+// NormalizePaths makes the relative local paths of the steps relative to baseDir
+func (steps *ProvisionStepsModel) NormalizePaths(baseDir string) {
+	for idx, aStep := range steps.Steps {
+		if aStep.Script != "" && !filepath.IsAbs(aStep.Script) {
+			steps.Steps[idx].Script = filepath.Join(baseDir, aStep.Script)
+		}
+		if aStep.Source != "" && !filepath.IsAbs(aStep.Source) {
+			steps.Steps[idx].Source = filepath.Join(baseDir, aStep.Source)
+		}
+	}
+}
+
+// Validate checks the steps against each other and against the built-in steps,
+// the same way as the box creation does, to fail before the time consuming install DMG creation
+func (steps ProvisionStepsModel) Validate() error {
+	_, err := insertProvisionSteps(builtInProvisioners(), steps)
+	return err
+}
+
+var provisionStepNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+type provisionerEntry struct {
+	stepName      string
+	afterStepName string
+	provisioners  []map[string]interface{}
+}
+
+func validateProvisionStep(step ProvisionStepModel, knownStepNames map[string]bool) error {
+	if step.Name == "" {
+		return fmt.Errorf("no name defined")
+	}
+	if !provisionStepNameRegexp.MatchString(step.Name) {
+		return fmt.Errorf("invalid name, only letters, numbers, '.', '_' and '-' are allowed")
+	}
+	if knownStepNames[step.Name] {
+		return fmt.Errorf("a step with the name (%s) already exists", step.Name)
+	}
+
+	switch step.Type {
+	case ProvisionStepTypeShell:
+		if step.Script == "" {
+			return fmt.Errorf("no script defined")
+		}
+		if step.Source != "" || step.Destination != "" {
+			return fmt.Errorf("source and destination are only supported by file steps")
+		}
+	case ProvisionStepTypeFile:
+		if step.Source == "" || step.Destination == "" {
+			return fmt.Errorf("source and destination have to be defined")
+		}
+		if step.Script != "" || len(step.Env) > 0 {
+			return fmt.Errorf("script and env are only supported by shell steps")
+		}
+		if strings.Contains(step.Destination, "'") {
+			return fmt.Errorf("destination can't include a ' character")
+		}
+	default:
+		return fmt.Errorf("invalid type (%s), should be one of: %s, %s", step.Type, ProvisionStepTypeShell, ProvisionStepTypeFile)
+	}
+
+	for _, aLocalPath := range []string{step.Script, step.Source} {
+		if aLocalPath == "" {
+			continue
+		}
+		if isExist, err := pathutil.IsPathExists(aLocalPath); err != nil {
+			return fmt.Errorf("failed to check whether the path (%s) exists, error: %s", aLocalPath, err)
+		} else if !isExist {
+			return fmt.Errorf("path (%s) does not exist", aLocalPath)
+		}
+	}
+
+	if step.RunAs != "" && step.RunAs != ProvisionStepRunAsRoot && step.RunAs != ProvisionStepRunAsUser {
+		return fmt.Errorf("invalid run_as (%s), should be one of: %s, %s", step.RunAs, ProvisionStepRunAsRoot, ProvisionStepRunAsUser)
+	}
+
+	if step.After != "" && step.Before != "" {
+		return fmt.Errorf("only one of after and before can be defined")
+	}
+	if step.After == shrinkStepName {
+		return fmt.Errorf("the %s step has to be the last one, no step can run after it", shrinkStepName)
+	}
+	for _, aRef := range []string{step.After, step.Before} {
+		if aRef != "" && !knownStepNames[aRef] {
+			return fmt.Errorf("unknown step (%s) referenced, only built-in steps and previously defined steps can be referenced", aRef)
+		}
+	}
+	return nil
+}
+
+func environmentVars(envs ...map[string]string) []string {
+	merged := map[string]string{}
+	for _, anEnv := range envs {
+		for key, value := range anEnv {
+			merged[key] = value
+		}
+	}
+
+	vars := []string{}
+	for key, value := range merged {
+		vars = append(vars, key+"="+value)
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+func provisionersOfStep(step ProvisionStepModel, globalEnv map[string]string) []map[string]interface{} {
+	isRunAsRoot := step.RunAs != ProvisionStepRunAsUser
+
+	if step.Type == ProvisionStepTypeShell {
+		executeCommand := userExecuteCommand
+		if isRunAsRoot {
+			executeCommand = rootExecuteCommand
+		}
+		provisioner := map[string]interface{}{
+			"type":            "shell",
+			"script":          step.Script,
+			"execute_command": executeCommand,
+		}
+		if vars := environmentVars(globalEnv, step.Env); len(vars) > 0 {
+			provisioner["environment_vars"] = vars
+		}
+		return []map[string]interface{}{provisioner}
+	}
+
+	if !isRunAsRoot {
+		return []map[string]interface{}{
+			{
+				"type":        "file",
+				"source":      step.Source,
+				"destination": step.Destination,
+			},
+		}
+	}
+
+	// the file provisioner uploads as the SSH user, move the upload into place as root
+	tmpDestination := "/private/tmp/replica-upload-" + step.Name
+	return []map[string]interface{}{
+		{
+			"type":   "shell",
+			"inline": []string{"rm -rf '" + tmpDestination + "'"},
+		},
+		{
+			"type":        "file",
+			"source":      step.Source,
+			"destination": tmpDestination,
+		},
+		{
+			"type": "shell",
+			"inline": []string{
+				"sudo mkdir -p \"$(dirname '" + step.Destination + "')\"",
+				"sudo rm -rf '" + step.Destination + "'",
+				"sudo mv '" + tmpDestination + "' '" + step.Destination + "'",
+			},
+		},
+	}
+}
+
+// insertProvisionSteps inserts the user defined steps between the built-in steps,
+// and returns the provisioners of the template
+func insertProvisionSteps(builtInEntries []provisionerEntry, steps ProvisionStepsModel) ([]map[string]interface{}, error) {
+	entries := append([]provisionerEntry{}, builtInEntries...)
+
+	knownStepNames := map[string]bool{}
+	for _, anEntry := range entries {
+		if anEntry.stepName != "" {
+			knownStepNames[anEntry.stepName] = true
+		}
+	}
+	if !knownStepNames[shrinkStepName] {
+		return nil, fmt.Errorf("built-in step %s not found in the template", shrinkStepName)
+	}
+
+	indexOfStep := func(name string) int {
+		for idx, anEntry := range entries {
+			if anEntry.stepName == name {
+				return idx
+			}
+		}
+		return -1
+	}
+
+	for _, aStep := range steps.Steps {
+		if err := validateProvisionStep(aStep, knownStepNames); err != nil {
+			return nil, fmt.Errorf("Invalid provisioning step (%s), error: %s", aStep.Name, err)
+		}
+		knownStepNames[aStep.Name] = true
+
+		entry := provisionerEntry{
+			stepName:      aStep.Name,
+			afterStepName: aStep.After,
+			provisioners:  provisionersOfStep(aStep, steps.Env),
+		}
+
+		insertIdx := indexOfStep(shrinkStepName)
+		if aStep.Before != "" {
+			insertIdx = indexOfStep(aStep.Before)
+		} else if aStep.After != "" {
+			// keep the order of the steps which run after the same step
+			insertIdx = indexOfStep(aStep.After) + 1
+			for insertIdx < len(entries) && entries[insertIdx].afterStepName == aStep.After {
+				insertIdx++
+			}
+		}
+
+		entries = append(entries[:insertIdx], append([]provisionerEntry{entry}, entries[insertIdx:]...)...)
+	}
+
+	result := []map[string]interface{}{}
+	for _, anEntry := range entries {
+		result = append(result, anEntry.provisioners...)
+	}
+	return result, nil
+}
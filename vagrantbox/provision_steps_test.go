@@ -0,0 +1,181 @@
+package vagrantbox
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testBuiltInProvisioners() []provisionerEntry {
+	entries := []provisionerEntry{
+		{
+			provisioners: []map[string]interface{}{
+				{
+					"type":    "shell-local",
+					"command": "sleep 0",
+				},
+			},
+		},
+	}
+	for _, aStepName := range []string{"vagrant", "xcode-cli-tools", "shrink"} {
+		entries = append(entries, provisionerEntry{
+			stepName: aStepName,
+			provisioners: []map[string]interface{}{
+				{
+					"type":             "shell",
+					"script":           "./scripts/" + aStepName + ".sh",
+					"execute_command":  rootExecuteCommand,
+					"environment_vars": []string{"USERNAME=vagrant"},
+				},
+			},
+		})
+	}
+	return entries
+}
+
+func provisionerSummaries(provisioners []map[string]interface{}) []string {
+	summaries := []string{}
+	for _, aProvisioner := range provisioners {
+		summary := aProvisioner["type"].(string)
+		if script, ok := aProvisioner["script"].(string); ok {
+			summary += ":" + filepath.Base(script)
+		}
+		if destination, ok := aProvisioner["destination"].(string); ok {
+			summary += ":" + destination
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func Test_insertProvisionSteps(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	homebrewScriptPath := filepath.Join(tmpDir, "homebrew.sh")
+	require.NoError(t, ioutil.WriteFile(homebrewScriptPath, []byte("#!/bin/bash\n"), 0600))
+	rubyScriptPath := filepath.Join(tmpDir, "ruby.sh")
+	require.NoError(t, ioutil.WriteFile(rubyScriptPath, []byte("#!/bin/bash\n"), 0600))
+	gemrcPath := filepath.Join(tmpDir, "gemrc")
+	require.NoError(t, ioutil.WriteFile(gemrcPath, []byte("gem: --no-document\n"), 0600))
+
+	t.Log("no user defined steps")
+	{
+		provisioners, err := insertProvisionSteps(testBuiltInProvisioners(), ProvisionStepsModel{})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"shell-local",
+			"shell:vagrant.sh",
+			"shell:xcode-cli-tools.sh",
+			"shell:shrink.sh",
+		}, provisionerSummaries(provisioners))
+	}
+
+	t.Log("user defined steps")
+	{
+		provisioners, err := insertProvisionSteps(testBuiltInProvisioners(), ProvisionStepsModel{
+			Env: map[string]string{"HOMEBREW_NO_ANALYTICS": "1"},
+			Steps: []ProvisionStepModel{
+				{Name: "gemrc", Type: ProvisionStepTypeFile, Source: gemrcPath, Destination: "/Users/vagrant/.gemrc", RunAs: ProvisionStepRunAsUser},
+				{Name: "homebrew", Type: ProvisionStepTypeShell, Script: homebrewScriptPath, RunAs: ProvisionStepRunAsUser, After: "xcode-cli-tools", Env: map[string]string{"BREW_PACKAGES": "git-lfs"}},
+				{Name: "ruby", Type: ProvisionStepTypeShell, Script: rubyScriptPath, After: "xcode-cli-tools"},
+				{Name: "gemrc-global", Type: ProvisionStepTypeFile, Source: gemrcPath, Destination: "/etc/gemrc", Before: "vagrant"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"shell-local",
+			"shell",
+			"file:/private/tmp/replica-upload-gemrc-global",
+			"shell",
+			"shell:vagrant.sh",
+			"shell:xcode-cli-tools.sh",
+			"shell:homebrew.sh",
+			"shell:ruby.sh",
+			"file:/Users/vagrant/.gemrc",
+			"shell:shrink.sh",
+		}, provisionerSummaries(provisioners))
+
+		homebrew := provisioners[6]
+		require.Equal(t, userExecuteCommand, homebrew["execute_command"])
+		require.Equal(t, []string{"BREW_PACKAGES=git-lfs", "HOMEBREW_NO_ANALYTICS=1"}, homebrew["environment_vars"])
+
+		ruby := provisioners[7]
+		require.Equal(t, rootExecuteCommand, ruby["execute_command"])
+		require.Equal(t, []string{"HOMEBREW_NO_ANALYTICS=1"}, ruby["environment_vars"])
+
+		require.Equal(t, []string{
+			`sudo mkdir -p "$(dirname '/etc/gemrc')"`,
+			`sudo rm -rf '/etc/gemrc'`,
+			`sudo mv '/private/tmp/replica-upload-gemrc-global' '/etc/gemrc'`,
+		}, provisioners[3]["inline"])
+	}
+
+	t.Log("invalid steps")
+	{
+		for _, aStep := range []ProvisionStepModel{
+			{Type: ProvisionStepTypeShell, Script: rubyScriptPath},
+			{Name: "ruby gems", Type: ProvisionStepTypeShell, Script: rubyScriptPath},
+			{Name: "vagrant", Type: ProvisionStepTypeShell, Script: rubyScriptPath},
+			{Name: "ruby", Type: "ansible", Script: rubyScriptPath},
+			{Name: "ruby", Type: ProvisionStepTypeShell},
+			{Name: "ruby", Type: ProvisionStepTypeShell, Script: filepath.Join(tmpDir, "missing.sh")},
+			{Name: "ruby", Type: ProvisionStepTypeShell, Script: rubyScriptPath, RunAs: "admin"},
+			{Name: "ruby", Type: ProvisionStepTypeShell, Script: rubyScriptPath, After: "shrink"},
+			{Name: "ruby", Type: ProvisionStepTypeShell, Script: rubyScriptPath, After: "homebrew"},
+			{Name: "ruby", Type: ProvisionStepTypeShell, Script: rubyScriptPath, After: "vagrant", Before: "shrink"},
+			{Name: "gemrc", Type: ProvisionStepTypeFile, Source: gemrcPath},
+			{Name: "gemrc", Type: ProvisionStepTypeFile, Source: gemrcPath, Destination: "/etc/'gemrc"},
+		} {
+			_, err := insertProvisionSteps(testBuiltInProvisioners(), ProvisionStepsModel{Steps: []ProvisionStepModel{aStep}})
+			require.Error(t, err, "%#v", aStep)
+		}
+	}
+}
+
+func TestProvisionStepsModel_Validate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	homebrewScriptPath := filepath.Join(tmpDir, "homebrew.sh")
+	require.NoError(t, ioutil.WriteFile(homebrewScriptPath, []byte("#!/bin/bash\n"), 0600))
+
+	require.NoError(t, ProvisionStepsModel{}.Validate())
+	require.NoError(t, ProvisionStepsModel{Steps: []ProvisionStepModel{
+		{Name: "homebrew", Type: ProvisionStepTypeShell, Script: homebrewScriptPath, After: "xcode-cli-tools"},
+	}}.Validate())
+
+	t.Log("invalid steps")
+	{
+		require.Error(t, ProvisionStepsModel{Steps: []ProvisionStepModel{
+			{Name: "homebrew", Type: ProvisionStepTypeShell, Script: filepath.Join(tmpDir, "missing.sh")},
+		}}.Validate())
+		require.Error(t, ProvisionStepsModel{Steps: []ProvisionStepModel{
+			{Name: "homebrew", Type: ProvisionStepTypeShell, Script: homebrewScriptPath, After: "no-such-step"},
+		}}.Validate())
+		require.Error(t, ProvisionStepsModel{Steps: []ProvisionStepModel{
+			{Name: "vagrant", Type: ProvisionStepTypeShell, Script: homebrewScriptPath},
+		}}.Validate())
+	}
+}
+
+func TestProvisionStepsModel_NormalizePaths(t *testing.T) {
+	steps := ProvisionStepsModel{
+		Steps: []ProvisionStepModel{
+			{Name: "homebrew", Type: ProvisionStepTypeShell, Script: "scripts/homebrew.sh"},
+			{Name: "gemrc", Type: ProvisionStepTypeFile, Source: "/etc/gemrc", Destination: "/etc/gemrc"},
+		},
+	}
+	steps.NormalizePaths("/config")
+	require.Equal(t, "/config/scripts/homebrew.sh", steps.Steps[0].Script)
+	require.Equal(t, "/etc/gemrc", steps.Steps[1].Source)
+}
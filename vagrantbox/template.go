@@ -0,0 +1,177 @@
+package vagrantbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/replica/hypervisor"
+)
+
+const (
+	// packerTemplateFileName is the file name of the generated packer template,
+	// in the packer dir
+	packerTemplateFileName = "template.json"
+
+	// builtInScriptsDir is the directory (relative to the packer dir) of the built-in provisioning scripts
+	builtInScriptsDir = "./scripts"
+)
+
+// builtInStepNames are the names of the built-in provisioning steps, in order.
+// Every step runs the script with the same name, from the built-in scripts dir.
+var builtInStepNames = []string{
+	"trust-ca",
+	"vagrant",
+	"xcode-cli-tools",
+	"add-network-interface-detection",
+	"autologin",
+	shrinkStepName,
+}
+
+// packerTemplateModel is the JSON packer template
+type packerTemplateModel struct {
+	Builders         []hypervisor.PackerBuilder `json:"builders"`
+	MinPackerVersion string                     `json:"min_packer_version"`
+	PostProcessors   []postProcessorModel       `json:"post-processors"`
+	Provisioners     []map[string]interface{}   `json:"provisioners"`
+	Variables        map[string]string          `json:"variables"`
+}
+
+// postProcessorModel is a packer post-processor
+type postProcessorModel struct {
+	Type   string `json:"type"`
+	Output string `json:"output,omitempty"`
+}
+
+// userVar returns the reference of a packer user variable
+func userVar(name string) string {
+	return hypervisor.PackerUserVar(name)
+}
+
+// packerVariables are the user variables of the template, with their default values
+func packerVariables(provider hypervisor.Provider) map[string]string {
+	variables := map[string]string{
+		"autologin":               "true",
+		"clt_package":             "",
+		"install_vagrant_keys":    "true",
+		"install_xcode_cli_tools": "true",
+		"iso_url":                 "OSX_InstallESD_10.X.X_XXXXX.dmg",
+		"password":                "vagrant",
+		"provisioning_delay":      "0",
+		"username":                "vagrant",
+	}
+	for name, value := range provider.PackerVariables() {
+		variables[name] = value
+	}
+	return variables
+}
+
+// builtInProvisioners returns the built-in provisioners of the box creation,
+// one entry per built-in step, so that user defined steps can be inserted between them
+func builtInProvisioners() []provisionerEntry {
+	entries := []provisionerEntry{
+		{
+			provisioners: []map[string]interface{}{
+				{
+					"type":    "shell-local",
+					"command": "sleep " + userVar("provisioning_delay"),
+				},
+				{
+					"type":   "shell",
+					"inline": []string{"mkdir -p /private/tmp/" + cltPackageDirName + " /private/tmp/" + trustedCADirName},
+				},
+				{
+					"type":        "file",
+					"source":      "./" + cltPackageDirName + "/",
+					"destination": "/private/tmp/" + cltPackageDirName,
+				},
+				{
+					"type":        "file",
+					"source":      "./" + trustedCADirName + "/",
+					"destination": "/private/tmp/" + trustedCADirName,
+				},
+			},
+		},
+	}
+
+	environmentVars := []string{
+		"AUTOLOGIN=" + userVar("autologin"),
+		"CLT_PACKAGE=" + userVar("clt_package"),
+		"INSTALL_VAGRANT_KEYS=" + userVar("install_vagrant_keys"),
+		"INSTALL_XCODE_CLI_TOOLS=" + userVar("install_xcode_cli_tools"),
+		"PASSWORD=" + userVar("password"),
+		"USERNAME=" + userVar("username"),
+	}
+	for _, aStepName := range builtInStepNames {
+		entries = append(entries, provisionerEntry{
+			stepName: aStepName,
+			provisioners: []map[string]interface{}{
+				{
+					"type":             "shell",
+					"script":           builtInScriptsDir + "/" + aStepName + ".sh",
+					"execute_command":  rootExecuteCommand,
+					"environment_vars": environmentVars,
+				},
+			},
+		})
+	}
+	return entries
+}
+
+// newPackerTemplate generates the packer template of the box creation, with the builder of the provider
+func newPackerTemplate(provider hypervisor.Provider, hardware hypervisor.HardwareModel, steps ProvisionStepsModel) (packerTemplateModel, error) {
+	builder := provider.PackerBuilder(hardware)
+
+	provisioners, err := insertProvisionSteps(builtInProvisioners(), steps)
+	if err != nil {
+		return packerTemplateModel{}, err
+	}
+
+	return packerTemplateModel{
+		Builders:         []hypervisor.PackerBuilder{builder},
+		MinPackerVersion: "0.7.0",
+		PostProcessors: []postProcessorModel{
+			{Type: "vagrant", Output: hypervisor.VagrantBoxFileName(provider, builder)},
+		},
+		Provisioners: provisioners,
+		Variables:    packerVariables(provider),
+	}, nil
+}
+
+func writePackerTemplate(templatePath string, template packerTemplateModel) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(template); err != nil {
+		return fmt.Errorf("Failed to serialize packer template, error: %s", err)
+	}
+
+	if err := fileutil.WriteBytesToFile(templatePath, buf.Bytes()); err != nil {
+		return fmt.Errorf("Failed to write packer template (path: %s), error: %s", templatePath, err)
+	}
+	return nil
+}
+
+// writePackerTemplateOfFormat writes the template into the packer dir, in the given format,
+// and removes the template of the other format (from a previous run), if any.
+// Returns the file name of the template.
+func writePackerTemplateOfFormat(packerDir string, template packerTemplateModel, format string) (string, error) {
+	templateFileName, otherTemplateFileName := packerTemplateFileName, packerHCLTemplateFileName
+	write := writePackerTemplate
+	if format == packerTemplateFormatHCL {
+		templateFileName, otherTemplateFileName = packerHCLTemplateFileName, packerTemplateFileName
+		write = writePackerTemplateHCL
+	}
+
+	if err := os.RemoveAll(filepath.Join(packerDir, otherTemplateFileName)); err != nil {
+		return "", fmt.Errorf("Failed to remove previous packer template, error: %s", err)
+	}
+	if err := write(filepath.Join(packerDir, templateFileName), template); err != nil {
+		return "", err
+	}
+	return templateFileName, nil
+}
@@ -0,0 +1,242 @@
+package vagrantbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+)
+
+const (
+	// packerHCLTemplateFileName is the file name of the generated HCL2 packer template,
+	// in the packer dir
+	packerHCLTemplateFileName = "template.pkr.hcl"
+
+	// hclSourceName is the name of the source block(s) in the HCL2 template
+	hclSourceName = "replica"
+)
+
+// hclAttributeNames are the attributes which were renamed in the packer versions which support HCL2
+var hclAttributeNames = map[string]string{
+	"iso_checksum_type": "iso_checksum",
+	"ssh_wait_timeout":  "ssh_timeout",
+}
+
+var hclUserVarRegexp = regexp.MustCompile("{{user `([A-Za-z0-9_-]+)`}}")
+
+// hclString returns the value as an HCL string literal,
+// with the JSON template user variable references converted to HCL variable references
+func hclString(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + hclUserVarRegexp.ReplaceAllString(replacer.Replace(value), "${var.$1}") + `"`
+}
+
+// hclValue renders a JSON decoded value (decoded with UseNumber) as an HCL expression.
+// Lists with multiple items are rendered one item per line, the items themselves inline.
+// Maps are rendered as objects, one attribute per line.
+func hclValue(value interface{}, indent string) (string, error) {
+	if object, isObject := value.(map[string]interface{}); isObject {
+		return hclObject(object, indent)
+	}
+
+	list, isList := value.([]interface{})
+	if !isList || len(list) < 2 {
+		return hclInlineValue(value)
+	}
+
+	items := []string{}
+	for _, anItem := range list {
+		item, err := hclInlineValue(anItem)
+		if err != nil {
+			return "", err
+		}
+		items = append(items, indent+"  "+item+",\n")
+	}
+	return "[\n" + strings.Join(items, "") + indent + "]", nil
+}
+
+func hclObject(object map[string]interface{}, indent string) (string, error) {
+	keys := []string{}
+	maxKeyLength := 0
+	for key := range object {
+		keys = append(keys, key)
+		if len(hclString(key)) > maxKeyLength {
+			maxKeyLength = len(hclString(key))
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) == 0 {
+		return "{}", nil
+	}
+
+	lines := []string{}
+	for _, aKey := range keys {
+		value, err := hclInlineValue(object[aKey])
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, fmt.Sprintf("%s  %-*s = %s\n", indent, maxKeyLength, hclString(aKey), value))
+	}
+	return "{\n" + strings.Join(lines, "") + indent + "}", nil
+}
+
+func hclInlineValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return hclString(v), nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case []interface{}:
+		items := []string{}
+		for _, anItem := range v {
+			item, err := hclInlineValue(anItem)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, item)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported value type: %T", value)
+	}
+}
+
+// toGeneric converts the value to its generic JSON form (map / slice / string / json.Number / bool)
+func toGeneric(value interface{}) (interface{}, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// writeHCLBlock writes a block, with the attributes of the JSON object (except the type),
+// sorted by name and with aligned equal signs
+func writeHCLBlock(buf *bytes.Buffer, indent, header string, object interface{}) error {
+	generic, err := toGeneric(object)
+	if err != nil {
+		return err
+	}
+	attributes, ok := generic.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s is not an object", header)
+	}
+
+	hclAttributes := map[string]interface{}{}
+	names := []string{}
+	maxNameLength := 0
+	for name, value := range attributes {
+		if name == "type" {
+			continue
+		}
+		if hclName, isRenamed := hclAttributeNames[name]; isRenamed {
+			name = hclName
+		}
+		hclAttributes[name] = value
+		names = append(names, name)
+		if len(name) > maxNameLength {
+			maxNameLength = len(name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		buf.WriteString(indent + header + " {}\n")
+		return nil
+	}
+
+	buf.WriteString(indent + header + " {\n")
+	for _, aName := range names {
+		value, err := hclValue(hclAttributes[aName], indent+"  ")
+		if err != nil {
+			return fmt.Errorf("invalid attribute (%s) of %s, error: %s", aName, header, err)
+		}
+		buf.WriteString(fmt.Sprintf("%s  %-*s = %s\n", indent, maxNameLength, aName, value))
+	}
+	buf.WriteString(indent + "}\n")
+	return nil
+}
+
+// renderPackerTemplateHCL renders the template in the packer HCL2 format,
+// describing the same build as the JSON template
+func renderPackerTemplateHCL(template packerTemplateModel) (string, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("packer {\n")
+	buf.WriteString("  required_version = \">= 1.7.0\"\n")
+	buf.WriteString("}\n")
+
+	variableNames := []string{}
+	for name := range template.Variables {
+		variableNames = append(variableNames, name)
+	}
+	sort.Strings(variableNames)
+	for _, aName := range variableNames {
+		buf.WriteString("\n")
+		buf.WriteString(fmt.Sprintf("variable %s {\n", hclString(aName)))
+		buf.WriteString("  type    = string\n")
+		buf.WriteString(fmt.Sprintf("  default = %s\n", hclString(template.Variables[aName])))
+		buf.WriteString("}\n")
+	}
+
+	sources := []string{}
+	for _, aBuilder := range template.Builders {
+		buf.WriteString("\n")
+		builderType := aBuilder.BuilderType()
+		if err := writeHCLBlock(&buf, "", fmt.Sprintf("source %s %s", hclString(builderType), hclString(hclSourceName)), aBuilder); err != nil {
+			return "", err
+		}
+		sources = append(sources, hclString("source."+builderType+"."+hclSourceName))
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString("build {\n")
+	buf.WriteString("  sources = [" + strings.Join(sources, ", ") + "]\n")
+	for _, aProvisioner := range template.Provisioners {
+		buf.WriteString("\n")
+		if err := writeHCLBlock(&buf, "  ", fmt.Sprintf("provisioner %s", hclString(fmt.Sprintf("%s", aProvisioner["type"]))), aProvisioner); err != nil {
+			return "", err
+		}
+	}
+	for _, aPostProcessor := range template.PostProcessors {
+		buf.WriteString("\n")
+		if err := writeHCLBlock(&buf, "  ", fmt.Sprintf("post-processor %s", hclString(aPostProcessor.Type)), aPostProcessor); err != nil {
+			return "", err
+		}
+	}
+	buf.WriteString("}\n")
+
+	return buf.String(), nil
+}
+
+func writePackerTemplateHCL(templatePath string, template packerTemplateModel) error {
+	content, err := renderPackerTemplateHCL(template)
+	if err != nil {
+		return fmt.Errorf("Failed to render HCL2 packer template, error: %s", err)
+	}
+	if err := fileutil.WriteStringToFile(templatePath, content); err != nil {
+		return fmt.Errorf("Failed to write packer template (path: %s), error: %s", templatePath, err)
+	}
+	return nil
+}
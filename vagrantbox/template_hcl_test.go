@@ -0,0 +1,144 @@
+package vagrantbox
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/stretchr/testify/require"
+)
+
+var isUpdateGoldenFiles = flag.Bool("update", false, "update the golden files in testdata")
+
+// requireGoldenFile compares the content with the golden file in testdata
+func requireGoldenFile(t *testing.T, goldenFileName, content string) {
+	goldenFilePath := filepath.Join("testdata", goldenFileName)
+	if *isUpdateGoldenFiles {
+		require.NoError(t, ioutil.WriteFile(goldenFilePath, []byte(content), 0644))
+	}
+
+	expected, err := ioutil.ReadFile(goldenFilePath)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), content)
+}
+
+func Test_hclString(t *testing.T) {
+	require.Equal(t, `"vagrant"`, hclString("vagrant"))
+	require.Equal(t, `"sleep ${var.provisioning_delay}"`, hclString("sleep {{user `provisioning_delay`}}"))
+	require.Equal(t, `"echo \"$${HOME}\" %%{x}\n"`, hclString("echo \"${HOME}\" %{x}\n"))
+	require.Equal(t, `"chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"`, hclString(rootExecuteCommand))
+}
+
+func Test_renderPackerTemplateHCL_golden(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	for _, aProvider := range hypervisor.ProviderNames {
+		t.Log("provider:", aProvider)
+
+		provider, err := hypervisor.NewProvider(aProvider, hypervisor.Options{})
+		require.NoError(t, err)
+		template, err := newPackerTemplate(provider, hypervisor.DefaultHardware(provider), ProvisionStepsModel{})
+		require.NoError(t, err)
+
+		fileName, err := writePackerTemplateOfFormat(tmpDir, template, packerTemplateFormatJSON)
+		require.NoError(t, err)
+		require.Equal(t, packerTemplateFileName, fileName)
+
+		content, err := ioutil.ReadFile(filepath.Join(tmpDir, fileName))
+		require.NoError(t, err)
+		requireGoldenFile(t, aProvider+".json", string(content))
+
+		// the HCL2 template replaces the JSON template
+		fileName, err = writePackerTemplateOfFormat(tmpDir, template, packerTemplateFormatHCL)
+		require.NoError(t, err)
+		require.Equal(t, packerHCLTemplateFileName, fileName)
+
+		content, err = ioutil.ReadFile(filepath.Join(tmpDir, fileName))
+		require.NoError(t, err)
+		requireGoldenFile(t, aProvider+".pkr.hcl", string(content))
+
+		_, err = os.Stat(filepath.Join(tmpDir, packerTemplateFileName))
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+// The golden files have to describe the same build:
+// the same variables, builders, provisioners and post-processors, in the same order.
+func Test_renderPackerTemplateHCL_sameBuildAsJSON(t *testing.T) {
+	for _, aProvider := range hypervisor.ProviderNames {
+		t.Log("provider:", aProvider)
+		requireSameBuild(t, filepath.Join("testdata", aProvider+".json"), filepath.Join("testdata", aProvider+".pkr.hcl"))
+	}
+}
+
+func requireSameBuild(t *testing.T, jsonTemplatePath, hclTemplatePath string) {
+	jsonContent, err := ioutil.ReadFile(jsonTemplatePath)
+	require.NoError(t, err)
+	hclContent, err := ioutil.ReadFile(hclTemplatePath)
+	require.NoError(t, err)
+
+	var jsonTemplate struct {
+		Builders       []map[string]interface{} `json:"builders"`
+		PostProcessors []map[string]interface{} `json:"post-processors"`
+		Provisioners   []map[string]interface{} `json:"provisioners"`
+		Variables      map[string]string        `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(jsonContent, &jsonTemplate))
+
+	blocksOf := func(blockType string) []string {
+		blocks := []string{}
+		blockRegexp := regexp.MustCompile(`(?m)^\s*` + blockType + ` "([^"]+)"`)
+		for _, aMatch := range blockRegexp.FindAllStringSubmatch(string(hclContent), -1) {
+			blocks = append(blocks, aMatch[1])
+		}
+		return blocks
+	}
+	typesOf := func(objects []map[string]interface{}) []string {
+		types := []string{}
+		for _, anObject := range objects {
+			types = append(types, anObject["type"].(string))
+		}
+		return types
+	}
+
+	require.Equal(t, typesOf(jsonTemplate.Builders), blocksOf("source"))
+	require.Equal(t, typesOf(jsonTemplate.Provisioners), blocksOf("provisioner"))
+	require.Equal(t, typesOf(jsonTemplate.PostProcessors), blocksOf("post-processor"))
+
+	variableNames := []string{}
+	for name := range jsonTemplate.Variables {
+		variableNames = append(variableNames, name)
+	}
+	sort.Strings(variableNames)
+	require.Equal(t, variableNames, blocksOf("variable"))
+
+	// every attribute of the builders and provisioners is present
+	requireAttributes := func(indent, blockType string, jsonObjects []map[string]interface{}) {
+		blockRegexp := regexp.MustCompile(`(?ms)^` + indent + blockType + ` "[^"]+" (?:"[^"]+" )?\{\n(.*?)\n` + indent + `\}\n`)
+		hclBlocks := blockRegexp.FindAllStringSubmatch(string(hclContent), -1)
+		require.Equal(t, len(jsonObjects), len(hclBlocks))
+		for idx, anObject := range jsonObjects {
+			for name := range anObject {
+				if name == "type" {
+					continue
+				}
+				if hclName, isRenamed := hclAttributeNames[name]; isRenamed {
+					name = hclName
+				}
+				require.Regexp(t, `(?m)^`+indent+`  `+regexp.QuoteMeta(name)+` +=`, hclBlocks[idx][1])
+			}
+		}
+	}
+	requireAttributes("", "source", jsonTemplate.Builders)
+	requireAttributes("  ", "provisioner", jsonTemplate.Provisioners)
+}
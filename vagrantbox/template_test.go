@@ -0,0 +1,136 @@
+package vagrantbox
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/stretchr/testify/require"
+)
+
+var userVarRegexp = regexp.MustCompile("{{user `([a-z_]+)`}}")
+
+// generatedTemplateJSON writes the template and parses it back, as a generic JSON object
+func generatedTemplateJSON(t *testing.T, template packerTemplateModel) map[string]interface{} {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	templatePath := filepath.Join(tmpDir, packerTemplateFileName)
+	require.NoError(t, writePackerTemplate(templatePath, template))
+
+	content, err := ioutil.ReadFile(templatePath)
+	require.NoError(t, err)
+
+	var templateJSON map[string]interface{}
+	require.NoError(t, json.Unmarshal(content, &templateJSON))
+	return templateJSON
+}
+
+func Test_newPackerTemplate(t *testing.T) {
+	t.Log("default hardware")
+	{
+		template, err := newPackerTemplate(hypervisor.VirtualBox{}, hypervisor.DefaultHardware(hypervisor.VirtualBox{}), ProvisionStepsModel{})
+		require.NoError(t, err)
+		templateJSON := generatedTemplateJSON(t, template)
+
+		require.Equal(t, "0.7.0", templateJSON["min_packer_version"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"type": "vagrant", "output": "packer_virtualbox-iso_virtualbox.box"},
+		}, templateJSON["post-processors"])
+
+		builders := templateJSON["builders"].([]interface{})
+		require.Equal(t, 1, len(builders))
+		builder := builders[0].(map[string]interface{})
+		require.Equal(t, "virtualbox-iso", builder["type"])
+		require.Equal(t, "2s", builder["boot_wait"])
+		require.Equal(t, float64(40960), builder["disk_size"])
+		require.Equal(t, "10000s", builder["ssh_wait_timeout"])
+		require.Equal(t, "{{user `iso_url`}}", builder["iso_url"])
+		require.Equal(t, "echo '{{user `username`}}'|sudo -S shutdown -h now", builder["shutdown_command"])
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--cpus", "1"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--memory", "2048"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--vram", "128"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--chipset", "ich9"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--firmware", "efi"})
+
+		scripts := []string{}
+		for _, aProvisioner := range templateJSON["provisioners"].([]interface{}) {
+			if script, ok := aProvisioner.(map[string]interface{})["script"].(string); ok {
+				scripts = append(scripts, script)
+			}
+		}
+		require.Equal(t, []string{
+			"./scripts/trust-ca.sh",
+			"./scripts/vagrant.sh",
+			"./scripts/xcode-cli-tools.sh",
+			"./scripts/add-network-interface-detection.sh",
+			"./scripts/autologin.sh",
+			"./scripts/shrink.sh",
+		}, scripts)
+
+		variables := templateJSON["variables"].(map[string]interface{})
+		require.Equal(t, "vagrant", variables["username"])
+		require.Equal(t, "true", variables["autologin"])
+	}
+
+	t.Log("custom hardware")
+	{
+		hardware := hypervisor.DefaultHardware(hypervisor.VirtualBox{}).Merge(hypervisor.HardwareModel{
+			CPUs:           4,
+			MemoryMB:       8192,
+			DiskSizeMB:     81920,
+			VRAMMB:         64,
+			Chipset:        "piix3",
+			Firmware:       "efi64",
+			BootWait:       "10s",
+			SSHWaitTimeout: "2h",
+		})
+		template, err := newPackerTemplate(hypervisor.VirtualBox{}, hardware, ProvisionStepsModel{})
+		require.NoError(t, err)
+		builder := generatedTemplateJSON(t, template)["builders"].([]interface{})[0].(map[string]interface{})
+
+		require.Equal(t, "10s", builder["boot_wait"])
+		require.Equal(t, float64(81920), builder["disk_size"])
+		require.Equal(t, "2h", builder["ssh_wait_timeout"])
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--cpus", "4"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--memory", "8192"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--vram", "64"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--chipset", "piix3"})
+		require.Contains(t, builder["vboxmanage"], []interface{}{"modifyvm", "{{.Name}}", "--firmware", "efi64"})
+	}
+
+	t.Log("every referenced user variable is declared")
+	{
+		template, err := newPackerTemplate(hypervisor.VirtualBox{}, hypervisor.DefaultHardware(hypervisor.VirtualBox{}), ProvisionStepsModel{})
+		require.NoError(t, err)
+		content, err := json.Marshal(template)
+		require.NoError(t, err)
+
+		for _, aMatch := range userVarRegexp.FindAllStringSubmatch(string(content), -1) {
+			_, isDeclared := template.Variables[aMatch[1]]
+			require.True(t, isDeclared, aMatch[1])
+		}
+	}
+}
+
+func Test_newPackerTemplate_providers(t *testing.T) {
+	for _, aProviderName := range hypervisor.ProviderNames {
+		provider, err := hypervisor.NewProvider(aProviderName, hypervisor.Options{})
+		require.NoError(t, err)
+
+		template, err := newPackerTemplate(provider, hypervisor.DefaultHardware(provider), ProvisionStepsModel{})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(template.Builders))
+		require.Equal(t, hypervisor.VagrantBoxFileName(provider, template.Builders[0]), template.PostProcessors[0].Output)
+
+		_, isAppleSMCOSKVariable := template.Variables[hypervisor.AppleSMCOSKVariable]
+		require.Equal(t, aProviderName == hypervisor.QEMUName, isAppleSMCOSKVariable, aProviderName)
+	}
+}
@@ -0,0 +1,181 @@
+packer {
+  required_version = ">= 1.7.0"
+}
+
+variable "apple_smc_osk" {
+  type    = string
+  default = ""
+}
+
+variable "autologin" {
+  type    = string
+  default = "true"
+}
+
+variable "clt_package" {
+  type    = string
+  default = ""
+}
+
+variable "install_vagrant_keys" {
+  type    = string
+  default = "true"
+}
+
+variable "install_xcode_cli_tools" {
+  type    = string
+  default = "true"
+}
+
+variable "iso_url" {
+  type    = string
+  default = "OSX_InstallESD_10.X.X_XXXXX.dmg"
+}
+
+variable "password" {
+  type    = string
+  default = "vagrant"
+}
+
+variable "provisioning_delay" {
+  type    = string
+  default = "0"
+}
+
+variable "username" {
+  type    = string
+  default = "vagrant"
+}
+
+source "qemu" "replica" {
+  accelerator      = "hvf"
+  boot_wait        = "2s"
+  disk_interface   = "ide"
+  disk_size        = 40960
+  format           = "qcow2"
+  iso_checksum     = "none"
+  iso_url          = "${var.iso_url}"
+  machine_type     = "q35"
+  net_device       = "e1000-82545em"
+  qemuargs         = [
+    ["-bios", "/usr/local/share/qemu/edk2-x86_64-code.fd"],
+    ["-cpu", "Penryn,vendor=GenuineIntel,+invtsc,vmware-cpuid-freq=on"],
+    ["-device", "isa-applesmc,osk=${var.apple_smc_osk}"],
+    ["-device", "usb-ehci,id=ehci"],
+    ["-device", "usb-kbd,bus=ehci.0"],
+    ["-device", "usb-tablet,bus=ehci.0"],
+    ["-m", "2048"],
+    ["-smbios", "type=2"],
+    ["-smp", "1"],
+    ["-vga", "std"],
+  ]
+  shutdown_command = "echo '${var.username}'|sudo -S shutdown -h now"
+  ssh_password     = "${var.password}"
+  ssh_port         = 22
+  ssh_timeout      = "10000s"
+  ssh_username     = "${var.username}"
+}
+
+build {
+  sources = ["source.qemu.replica"]
+
+  provisioner "shell-local" {
+    command = "sleep ${var.provisioning_delay}"
+  }
+
+  provisioner "shell" {
+    inline = ["mkdir -p /private/tmp/clt /private/tmp/trusted-ca"]
+  }
+
+  provisioner "file" {
+    destination = "/private/tmp/clt"
+    source      = "./clt/"
+  }
+
+  provisioner "file" {
+    destination = "/private/tmp/trusted-ca"
+    source      = "./trusted-ca/"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/trust-ca.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/vagrant.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/xcode-cli-tools.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/add-network-interface-detection.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/autologin.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/shrink.sh"
+  }
+
+  post-processor "vagrant" {
+    output = "packer_qemu_libvirt.box"
+  }
+}
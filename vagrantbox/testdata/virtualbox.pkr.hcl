@@ -0,0 +1,177 @@
+packer {
+  required_version = ">= 1.7.0"
+}
+
+variable "autologin" {
+  type    = string
+  default = "true"
+}
+
+variable "clt_package" {
+  type    = string
+  default = ""
+}
+
+variable "install_vagrant_keys" {
+  type    = string
+  default = "true"
+}
+
+variable "install_xcode_cli_tools" {
+  type    = string
+  default = "true"
+}
+
+variable "iso_url" {
+  type    = string
+  default = "OSX_InstallESD_10.X.X_XXXXX.dmg"
+}
+
+variable "password" {
+  type    = string
+  default = "vagrant"
+}
+
+variable "provisioning_delay" {
+  type    = string
+  default = "0"
+}
+
+variable "username" {
+  type    = string
+  default = "vagrant"
+}
+
+source "virtualbox-iso" "replica" {
+  boot_wait            = "2s"
+  disk_size            = 40960
+  guest_additions_mode = "disable"
+  guest_os_type        = "MacOS1011_64"
+  hard_drive_interface = "sata"
+  iso_checksum         = "none"
+  iso_interface        = "sata"
+  iso_url              = "${var.iso_url}"
+  shutdown_command     = "echo '${var.username}'|sudo -S shutdown -h now"
+  ssh_password         = "${var.password}"
+  ssh_port             = 22
+  ssh_timeout          = "10000s"
+  ssh_username         = "${var.username}"
+  vboxmanage           = [
+    ["modifyvm", "{{.Name}}", "--audiocontroller", "hda"],
+    ["modifyvm", "{{.Name}}", "--boot1", "dvd"],
+    ["modifyvm", "{{.Name}}", "--boot2", "disk"],
+    ["modifyvm", "{{.Name}}", "--chipset", "ich9"],
+    ["modifyvm", "{{.Name}}", "--cpus", "1"],
+    ["modifyvm", "{{.Name}}", "--firmware", "efi"],
+    ["modifyvm", "{{.Name}}", "--hpet", "on"],
+    ["modifyvm", "{{.Name}}", "--keyboard", "usb"],
+    ["modifyvm", "{{.Name}}", "--memory", "2048"],
+    ["modifyvm", "{{.Name}}", "--mouse", "usbtablet"],
+    ["modifyvm", "{{.Name}}", "--vram", "128"],
+    ["storagectl", "{{.Name}}", "--name", "IDE Controller", "--remove"],
+  ]
+}
+
+build {
+  sources = ["source.virtualbox-iso.replica"]
+
+  provisioner "shell-local" {
+    command = "sleep ${var.provisioning_delay}"
+  }
+
+  provisioner "shell" {
+    inline = ["mkdir -p /private/tmp/clt /private/tmp/trusted-ca"]
+  }
+
+  provisioner "file" {
+    destination = "/private/tmp/clt"
+    source      = "./clt/"
+  }
+
+  provisioner "file" {
+    destination = "/private/tmp/trusted-ca"
+    source      = "./trusted-ca/"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/trust-ca.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/vagrant.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/xcode-cli-tools.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/add-network-interface-detection.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/autologin.sh"
+  }
+
+  provisioner "shell" {
+    environment_vars = [
+      "AUTOLOGIN=${var.autologin}",
+      "CLT_PACKAGE=${var.clt_package}",
+      "INSTALL_VAGRANT_KEYS=${var.install_vagrant_keys}",
+      "INSTALL_XCODE_CLI_TOOLS=${var.install_xcode_cli_tools}",
+      "PASSWORD=${var.password}",
+      "USERNAME=${var.username}",
+    ]
+    execute_command  = "chmod +x {{ .Path }}; sudo {{ .Vars }} {{ .Path }}"
+    script           = "./scripts/shrink.sh"
+  }
+
+  post-processor "vagrant" {
+    output = "packer_virtualbox-iso_virtualbox.box"
+  }
+}
@@ -0,0 +1,104 @@
+package vagrantbox
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bitrise-io/go-utils/fileutil"
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+const (
+	// trustedCADirName is the directory (relative to the packer dir) which is uploaded
+	// into the VM, with the root CA certificates to trust in it (if any)
+	trustedCADirName = "trusted-ca"
+)
+
+// TrustedCAModel ...
+type TrustedCAModel struct {
+	Path        string
+	Certificate *x509.Certificate
+}
+
+// readTrustedCACertificates reads the PEM encoded certificates of the files.
+// Every certificate has to be a CA certificate, valid at the given time.
+func readTrustedCACertificates(certPaths []string, now time.Time) ([]TrustedCAModel, error) {
+	trustedCAs := []TrustedCAModel{}
+	for _, aCertPath := range certPaths {
+		content, err := fileutil.ReadBytesFromFile(aCertPath)
+		if err != nil {
+			return []TrustedCAModel{}, fmt.Errorf("Failed to read certificate file (path: %s), error: %s", aCertPath, err)
+		}
+
+		certsInFile := 0
+		for {
+			var block *pem.Block
+			block, content = pem.Decode(content)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return []TrustedCAModel{}, fmt.Errorf("Failed to parse certificate (path: %s), error: %s", aCertPath, err)
+			}
+			if err := validateTrustedCACertificate(cert, now); err != nil {
+				return []TrustedCAModel{}, fmt.Errorf("Invalid certificate (path: %s, subject: %s), error: %s", aCertPath, cert.Subject.CommonName, err)
+			}
+
+			trustedCAs = append(trustedCAs, TrustedCAModel{
+				Path:        aCertPath,
+				Certificate: cert,
+			})
+			certsInFile++
+		}
+
+		if certsInFile < 1 {
+			return []TrustedCAModel{}, fmt.Errorf("No PEM encoded certificate found in file (path: %s)", aCertPath)
+		}
+	}
+	return trustedCAs, nil
+}
+
+func validateTrustedCACertificate(cert *x509.Certificate, now time.Time) error {
+	if !cert.BasicConstraintsValid || !cert.IsCA {
+		return fmt.Errorf("not a CA certificate")
+	}
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("certificate expired at %s", cert.NotAfter)
+	}
+	if now.Before(cert.NotBefore) {
+		return fmt.Errorf("certificate is not valid before %s", cert.NotBefore)
+	}
+	return nil
+}
+
+// prepareTrustedCADir (re)creates the directory which is uploaded into the VM,
+// and writes the certificates into it, one PEM file per certificate.
+func prepareTrustedCADir(trustedCAs []TrustedCAModel, trustedCADirPath string) error {
+	if err := os.RemoveAll(trustedCADirPath); err != nil {
+		return fmt.Errorf("Failed to remove the previous certificates directory (path: %s), error: %s", trustedCADirPath, err)
+	}
+	if err := pathutil.EnsureDirExist(trustedCADirPath); err != nil {
+		return fmt.Errorf("Failed to create certificates directory (path: %s), error: %s", trustedCADirPath, err)
+	}
+
+	for idx, aTrustedCA := range trustedCAs {
+		certPEM := pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: aTrustedCA.Certificate.Raw,
+		})
+		certPath := filepath.Join(trustedCADirPath, fmt.Sprintf("ca-%d.pem", idx))
+		if err := fileutil.WriteBytesToFileWithPermission(certPath, certPEM, 0644); err != nil {
+			return fmt.Errorf("Failed to write certificate into file, error: %s", err)
+		}
+	}
+	return nil
+}
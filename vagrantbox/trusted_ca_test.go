@@ -0,0 +1,126 @@
+package vagrantbox
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCertificatePEM(t *testing.T, commonName string, isCA bool, notBefore, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func Test_readTrustedCACertificates(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	now := time.Date(2016, 11, 20, 0, 0, 0, 0, time.UTC)
+	validFrom := now.AddDate(-1, 0, 0)
+	validUntil := now.AddDate(1, 0, 0)
+
+	caPath := filepath.Join(tmpDir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caPath, createTestCertificatePEM(t, "Corp Root CA", true, validFrom, validUntil), 0600))
+
+	bundlePath := filepath.Join(tmpDir, "bundle.pem")
+	bundle := append(createTestCertificatePEM(t, "Corp Root CA 2", true, validFrom, validUntil),
+		createTestCertificatePEM(t, "Corp Root CA 3", true, validFrom, validUntil)...)
+	require.NoError(t, ioutil.WriteFile(bundlePath, bundle, 0600))
+
+	leafPath := filepath.Join(tmpDir, "leaf.pem")
+	require.NoError(t, ioutil.WriteFile(leafPath, createTestCertificatePEM(t, "example.com", false, validFrom, validUntil), 0600))
+
+	expiredPath := filepath.Join(tmpDir, "expired.pem")
+	require.NoError(t, ioutil.WriteFile(expiredPath, createTestCertificatePEM(t, "Old Root CA", true, validFrom, now.AddDate(0, 0, -1)), 0600))
+
+	emptyPath := filepath.Join(tmpDir, "empty.pem")
+	require.NoError(t, ioutil.WriteFile(emptyPath, []byte("not a certificate"), 0600))
+
+	t.Log("CA certificates")
+	{
+		trustedCAs, err := readTrustedCACertificates([]string{caPath, bundlePath}, now)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(trustedCAs))
+		require.Equal(t, "Corp Root CA", trustedCAs[0].Certificate.Subject.CommonName)
+		require.Equal(t, caPath, trustedCAs[0].Path)
+		require.Equal(t, "Corp Root CA 3", trustedCAs[2].Certificate.Subject.CommonName)
+		require.Equal(t, bundlePath, trustedCAs[2].Path)
+	}
+
+	t.Log("not a CA certificate")
+	{
+		_, err := readTrustedCACertificates([]string{caPath, leafPath}, now)
+		require.Error(t, err)
+	}
+
+	t.Log("expired CA certificate")
+	{
+		_, err := readTrustedCACertificates([]string{expiredPath}, now)
+		require.Error(t, err)
+	}
+
+	t.Log("not yet valid CA certificate")
+	{
+		_, err := readTrustedCACertificates([]string{caPath}, validFrom.AddDate(0, 0, -1))
+		require.Error(t, err)
+	}
+
+	t.Log("no certificate in file")
+	{
+		_, err := readTrustedCACertificates([]string{emptyPath}, now)
+		require.Error(t, err)
+	}
+
+	t.Log("prepare the directory")
+	{
+		trustedCAs, err := readTrustedCACertificates([]string{caPath, bundlePath}, now)
+		require.NoError(t, err)
+
+		trustedCADirPath := filepath.Join(tmpDir, "packer", "trusted-ca")
+		require.NoError(t, prepareTrustedCADir(trustedCAs, trustedCADirPath))
+
+		files, err := ioutil.ReadDir(trustedCADirPath)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(files))
+
+		reread, err := readTrustedCACertificates([]string{filepath.Join(trustedCADirPath, "ca-1.pem")}, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(reread))
+		require.Equal(t, "Corp Root CA 2", reread[0].Certificate.Subject.CommonName)
+
+		require.NoError(t, prepareTrustedCADir([]TrustedCAModel{}, trustedCADirPath))
+		files, err = ioutil.ReadDir(trustedCADirPath)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(files))
+	}
+}
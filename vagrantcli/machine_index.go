@@ -0,0 +1,92 @@
+package vagrantcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bitrise-io/go-utils/pathutil"
+)
+
+// MachineModel is a vagrant VM, from vagrant's machine index
+type MachineModel struct {
+	ID       string
+	Name     string
+	Provider string
+	State    string
+	// Dir is the directory of the Vagrantfile of the VM
+	Dir string
+	// Box is the box the VM was created from
+	Box BoxModel
+}
+
+// machineIndexModel is the format of vagrant's machine index file
+type machineIndexModel struct {
+	Machines map[string]struct {
+		Name            string `json:"name"`
+		Provider        string `json:"provider"`
+		State           string `json:"state"`
+		VagrantfilePath string `json:"vagrantfile_path"`
+		ExtraData       struct {
+			Box struct {
+				Name     string `json:"name"`
+				Provider string `json:"provider"`
+				Version  string `json:"version"`
+			} `json:"box"`
+		} `json:"extra_data"`
+	} `json:"machines"`
+}
+
+// HomeDir returns vagrant's home directory: $VAGRANT_HOME or ~/.vagrant.d
+func HomeDir() string {
+	if home := os.Getenv("VAGRANT_HOME"); home != "" {
+		return home
+	}
+	return filepath.Join(pathutil.UserHomeDir(), ".vagrant.d")
+}
+
+// parseMachineIndex parses vagrant's machine index, the machines are sorted by directory
+func parseMachineIndex(content []byte) ([]MachineModel, error) {
+	var index machineIndexModel
+	if err := json.Unmarshal(content, &index); err != nil {
+		return nil, fmt.Errorf("Failed to parse vagrant machine index, error: %s", err)
+	}
+
+	machines := []MachineModel{}
+	for anID, aMachine := range index.Machines {
+		machines = append(machines, MachineModel{
+			ID:       anID,
+			Name:     aMachine.Name,
+			Provider: aMachine.Provider,
+			State:    aMachine.State,
+			Dir:      aMachine.VagrantfilePath,
+			Box: BoxModel{
+				Name:     aMachine.ExtraData.Box.Name,
+				Provider: aMachine.ExtraData.Box.Provider,
+				Version:  aMachine.ExtraData.Box.Version,
+			},
+		})
+	}
+	sort.Slice(machines, func(i, j int) bool {
+		if machines[i].Dir != machines[j].Dir {
+			return machines[i].Dir < machines[j].Dir
+		}
+		return machines[i].Name < machines[j].Name
+	})
+	return machines, nil
+}
+
+// ListMachines returns the VMs of vagrant's machine index (the VMs vagrant global-status knows about)
+func ListMachines() ([]MachineModel, error) {
+	pth := filepath.Join(HomeDir(), "data", "machine-index", "index")
+	content, err := ioutil.ReadFile(pth)
+	if os.IsNotExist(err) {
+		return []MachineModel{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("Failed to read vagrant machine index (%s), error: %s", pth, err)
+	}
+	return parseMachineIndex(content)
+}
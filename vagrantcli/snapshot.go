@@ -0,0 +1,35 @@
+package vagrantcli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// parseSnapshotList parses the output of: vagrant snapshot list --machine-readable
+// vagrant prints every snapshot name as a ui detail line
+func parseSnapshotList(output string) []string {
+	names := []string{}
+	for _, anEvent := range ParseMachineReadable(output) {
+		if anEvent.Type != "ui" || len(anEvent.Data) < 2 || anEvent.Data[0] != "detail" {
+			continue
+		}
+		for _, aName := range strings.Split(anEvent.Data[1], "\n") {
+			if aName = strings.TrimSpace(aName); aName != "" {
+				names = append(names, aName)
+			}
+		}
+	}
+	return names
+}
+
+// ListSnapshots returns the names of the snapshots of the vagrant VM of the directory
+func ListSnapshots(vagrantVMDir string) ([]string, error) {
+	cmd := cmdex.NewCommand("vagrant", "snapshot", "list", "--machine-readable").SetDir(vagrantVMDir)
+	output, err := cmd.RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list vagrant snapshots, output: %s, error: %s", output, err)
+	}
+	return parseSnapshotList(output), nil
+}
@@ -0,0 +1,17 @@
+package vagrantcli
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// RunScript runs the shell script in the vagrant VM of the directory (with vagrant ssh), returns its output
+func RunScript(vagrantVMDir, script string) (string, error) {
+	cmd := cmdex.NewCommand("vagrant", "ssh", "--no-tty", "-c", script).SetDir(vagrantVMDir)
+	output, err := cmd.RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return output, fmt.Errorf("Failed to run the script in the VM, output: %s, error: %s", output, err)
+	}
+	return output, nil
+}
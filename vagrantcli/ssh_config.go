@@ -0,0 +1,68 @@
+package vagrantcli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// SSHConfigModel is the SSH connection of a vagrant VM, from vagrant ssh-config
+type SSHConfigModel struct {
+	// Host is the name of the VM in the SSH config, e.g. default
+	Host         string
+	HostName     string
+	User         string
+	Port         int
+	IdentityFile string
+	// Content is the whole SSH config, which can be used with: ssh -F
+	Content string
+}
+
+// Endpoint returns the SSH endpoint of the VM, in the format: user@host:port
+func (config SSHConfigModel) Endpoint() string {
+	return fmt.Sprintf("%s@%s:%d", config.User, config.HostName, config.Port)
+}
+
+// parseSSHConfig parses the output of: vagrant ssh-config
+func parseSSHConfig(output string) (SSHConfigModel, error) {
+	config := SSHConfigModel{Content: output}
+	for _, aLine := range strings.Split(output, "\n") {
+		fields := strings.Fields(aLine)
+		if len(fields) < 2 {
+			continue
+		}
+		value := strings.Trim(strings.Join(fields[1:], " "), `"`)
+		switch fields[0] {
+		case "Host":
+			config.Host = value
+		case "HostName":
+			config.HostName = value
+		case "User":
+			config.User = value
+		case "IdentityFile":
+			config.IdentityFile = value
+		case "Port":
+			port, err := strconv.Atoi(value)
+			if err != nil {
+				return SSHConfigModel{}, fmt.Errorf("Invalid port (%s) in the vagrant SSH config", value)
+			}
+			config.Port = port
+		}
+	}
+	if config.Host == "" || config.HostName == "" || config.Port == 0 {
+		return SSHConfigModel{}, fmt.Errorf("Invalid vagrant SSH config, no host, host name or port defined: %s", output)
+	}
+	return config, nil
+}
+
+// SSHConfig returns the SSH connection of the vagrant VM of the directory
+func SSHConfig(vagrantVMDir string) (SSHConfigModel, error) {
+	cmd := cmdex.NewCommand("vagrant", "ssh-config").SetDir(vagrantVMDir)
+	output, err := cmd.RunAndReturnTrimmedOutput()
+	if err != nil {
+		return SSHConfigModel{}, fmt.Errorf("Failed to get the vagrant SSH config, error: %s", err)
+	}
+	return parseSSHConfig(output)
+}
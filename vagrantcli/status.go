@@ -0,0 +1,45 @@
+package vagrantcli
+
+import (
+	"fmt"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// StatusModel is the state of a vagrant VM, from vagrant status
+type StatusModel struct {
+	// State is e.g. running, poweroff, saved, not_created
+	State string
+	// Provider is the vagrant provider of the VM, e.g. virtualbox
+	Provider string
+}
+
+// parseStatus parses the output of: vagrant status --machine-readable
+func parseStatus(output string) (StatusModel, error) {
+	status := StatusModel{}
+	for _, anEvent := range ParseMachineReadable(output) {
+		if len(anEvent.Data) < 1 {
+			continue
+		}
+		switch anEvent.Type {
+		case "state":
+			status.State = anEvent.Data[0]
+		case "provider-name":
+			status.Provider = anEvent.Data[0]
+		}
+	}
+	if status.State == "" {
+		return StatusModel{}, fmt.Errorf("No state found in the vagrant status output: %s", output)
+	}
+	return status, nil
+}
+
+// Status returns the state of the vagrant VM of the directory
+func Status(vagrantVMDir string) (StatusModel, error) {
+	cmd := cmdex.NewCommand("vagrant", "status", "--machine-readable").SetDir(vagrantVMDir)
+	output, err := cmd.RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return StatusModel{}, fmt.Errorf("Failed to get the vagrant status, output: %s, error: %s", output, err)
+	}
+	return parseStatus(output)
+}
@@ -0,0 +1,95 @@
+package vagrantcli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// EventModel is a line of vagrant's machine-readable output:
+// timestamp,target,type,data...
+type EventModel struct {
+	Timestamp time.Time
+	Target    string
+	Type      string
+	Data      []string
+}
+
+// BoxModel is a box registered in vagrant
+type BoxModel struct {
+	Name     string
+	Provider string
+	Version  string
+}
+
+// unescapeData reverts the escaping of vagrant's machine-readable output
+func unescapeData(data string) string {
+	return strings.NewReplacer(
+		"%!(VAGRANT_COMMA)", ",",
+		`\n`, "\n",
+		`\r`, "\r",
+	).Replace(data)
+}
+
+// ParseMachineReadable parses vagrant's machine-readable output,
+// the lines which are not machine-readable are skipped
+func ParseMachineReadable(output string) []EventModel {
+	events := []EventModel{}
+	for _, aLine := range strings.Split(output, "\n") {
+		fields := strings.Split(strings.TrimRight(aLine, "\r"), ",")
+		if len(fields) < 3 {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		data := []string{}
+		for _, aField := range fields[3:] {
+			data = append(data, unescapeData(aField))
+		}
+		events = append(events, EventModel{
+			Timestamp: time.Unix(timestamp, 0),
+			Target:    fields[1],
+			Type:      fields[2],
+			Data:      data,
+		})
+	}
+	return events
+}
+
+// parseBoxList parses the output of: vagrant box list --machine-readable
+func parseBoxList(output string) []BoxModel {
+	boxes := []BoxModel{}
+	for _, anEvent := range ParseMachineReadable(output) {
+		if len(anEvent.Data) < 1 {
+			continue
+		}
+		switch anEvent.Type {
+		case "box-name":
+			boxes = append(boxes, BoxModel{Name: anEvent.Data[0]})
+		case "box-provider":
+			if len(boxes) > 0 {
+				boxes[len(boxes)-1].Provider = anEvent.Data[0]
+			}
+		case "box-version":
+			if len(boxes) > 0 {
+				boxes[len(boxes)-1].Version = anEvent.Data[0]
+			}
+		}
+	}
+	return boxes
+}
+
+// ListBoxes returns the boxes registered in vagrant
+func ListBoxes() ([]BoxModel, error) {
+	cmd := cmdex.NewCommand("vagrant", "box", "list", "--machine-readable")
+	output, err := cmd.RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list vagrant boxes, output: %s, error: %s", output, err)
+	}
+	return parseBoxList(output), nil
+}
@@ -0,0 +1,99 @@
+package vagrantcli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMachineReadable(t *testing.T) {
+	events := ParseMachineReadable(`1490000000,default,state,running
+not a machine-readable line
+1490000001,,ui,info,a%!(VAGRANT_COMMA) b\nc
+`)
+	require.Equal(t, []EventModel{
+		{Timestamp: time.Unix(1490000000, 0), Target: "default", Type: "state", Data: []string{"running"}},
+		{Timestamp: time.Unix(1490000001, 0), Type: "ui", Data: []string{"info", "a, b\nc"}},
+	}, events)
+}
+
+func Test_parseBoxList(t *testing.T) {
+	require.Equal(t, []BoxModel{}, parseBoxList(""))
+
+	require.Equal(t, []BoxModel{
+		{Name: "bitrise-replica-macos", Provider: "virtualbox", Version: "0"},
+		{Name: "hashicorp/bionic64", Provider: "vmware_desktop", Version: "1.0.282"},
+	}, parseBoxList(`1490000000,,ui,info,bitrise-replica-macos (virtualbox%!(VAGRANT_COMMA) 0)
+1490000000,,box-name,bitrise-replica-macos
+1490000000,,box-provider,virtualbox
+1490000000,,box-version,0
+1490000000,,ui,info,hashicorp/bionic64 (vmware_desktop%!(VAGRANT_COMMA) 1.0.282)
+1490000000,,box-name,hashicorp/bionic64
+1490000000,,box-provider,vmware_desktop
+1490000000,,box-version,1.0.282`))
+}
+
+func Test_parseMachineIndex(t *testing.T) {
+	machines, err := parseMachineIndex([]byte(`{"version":1,"machines":{
+"b8a1":{"local_data_path":"/Users/ci/vm2/.vagrant","name":"default","provider":"virtualbox","state":"poweroff","vagrantfile_name":null,"vagrantfile_path":"/Users/ci/vm2","updated_at":null,"extra_data":{"box":{"name":"bitrise-replica-macos","provider":"virtualbox","version":"0"}}},
+"0f3c":{"local_data_path":"/Users/ci/vm1/.vagrant","name":"default","provider":"virtualbox","state":"running","vagrantfile_name":null,"vagrantfile_path":"/Users/ci/vm1","updated_at":null,"extra_data":{"box":{"name":"bitrise-replica-macos","provider":"virtualbox","version":"0"}}}
+}}`))
+	require.NoError(t, err)
+	box := BoxModel{Name: "bitrise-replica-macos", Provider: "virtualbox", Version: "0"}
+	require.Equal(t, []MachineModel{
+		{ID: "0f3c", Name: "default", Provider: "virtualbox", State: "running", Dir: "/Users/ci/vm1", Box: box},
+		{ID: "b8a1", Name: "default", Provider: "virtualbox", State: "poweroff", Dir: "/Users/ci/vm2", Box: box},
+	}, machines)
+
+	_, err = parseMachineIndex([]byte("not json"))
+	require.Error(t, err)
+}
+
+func Test_parseSSHConfig(t *testing.T) {
+	output := `Host default
+  HostName 127.0.0.1
+  User vagrant
+  Port 2301
+  UserKnownHostsFile /dev/null
+  StrictHostKeyChecking no
+  IdentityFile "/Users/ci/fleet/replica-01/replica_private_key"
+  IdentitiesOnly yes
+  LogLevel FATAL`
+	config, err := parseSSHConfig(output)
+	require.NoError(t, err)
+	require.Equal(t, SSHConfigModel{
+		Host:         "default",
+		HostName:     "127.0.0.1",
+		User:         "vagrant",
+		Port:         2301,
+		IdentityFile: "/Users/ci/fleet/replica-01/replica_private_key",
+		Content:      output,
+	}, config)
+	require.Equal(t, "vagrant@127.0.0.1:2301", config.Endpoint())
+
+	_, err = parseSSHConfig("The VM is not running")
+	require.Error(t, err)
+}
+
+func Test_parseSnapshotList(t *testing.T) {
+	require.Equal(t, []string{}, parseSnapshotList(`1490000000,default,ui,output,==> default: No snapshots have been taken yet!`))
+
+	require.Equal(t, []string{"bitrise-replica-initial", "replica-xcode-sync-20170102-150405"}, parseSnapshotList(`1490000000,default,metadata,provider,virtualbox
+1490000000,default,ui,output,==> default: 
+1490000000,default,ui,detail,bitrise-replica-initial
+1490000000,default,ui,detail,replica-xcode-sync-20170102-150405`))
+}
+
+func Test_parseStatus(t *testing.T) {
+	status, err := parseStatus(`1490000000,default,metadata,provider,virtualbox
+1490000000,default,provider-name,virtualbox
+1490000000,default,state,running
+1490000000,default,state-human-short,running
+1490000000,default,state-human-long,The VM is running.`)
+	require.NoError(t, err)
+	require.Equal(t, StatusModel{State: "running", Provider: "virtualbox"}, status)
+
+	_, err = parseStatus("A Vagrant environment or target machine is required to run this command.")
+	require.Error(t, err)
+}
@@ -0,0 +1,271 @@
+package vagrantfile
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+const (
+	// DefaultBoxName is the name the vagrant box is registered with
+	DefaultBoxName = "bitrise-replica-macos"
+
+	minCPUs     = 2
+	minMemoryMB = 4096
+
+	privateNetworkDHCP = "dhcp"
+)
+
+// PortForwardModel forwards a port of the host to a port of the VM
+type PortForwardModel struct {
+	// ID identifies the port forward in vagrant, the ssh ID replaces vagrant's default SSH port forward
+	ID    string `json:"id,omitempty"`
+	Host  int    `json:"host"`
+	Guest int    `json:"guest"`
+	// Protocol is tcp (default) or udp
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// PrivateNetworkModel is a host-only network of the VM
+type PrivateNetworkModel struct {
+	// IP is the static IP address of the VM in the network, or dhcp
+	IP string `json:"ip"`
+}
+
+// SyncedFolderModel shares a directory of the host with the VM
+type SyncedFolderModel struct {
+	Host  string `json:"host"`
+	Guest string `json:"guest"`
+	// Type is the vagrant synced folder type, e.g. rsync or nfs, default: the provider's default
+	Type string `json:"type,omitempty"`
+}
+
+// SettingsModel are the settings of the generated Vagrantfile. Zero values mean: use the default.
+type SettingsModel struct {
+	BoxName string `json:"box,omitempty"`
+	// BoxVersion is the version constraint of the box, e.g. >= 1.2, default: any version
+	BoxVersion string `json:"box_version,omitempty"`
+	// Hostname is the hostname of the VM, default: the hostname of the box
+	Hostname string `json:"hostname,omitempty"`
+	// MACAddress is the MAC address of the first network interface, default: generated by the provider
+	MACAddress string `json:"mac_address,omitempty"`
+	CPUs       int    `json:"cpus,omitempty"`
+	// MemoryMB is the size of the RAM, in MB
+	MemoryMB int `json:"memory,omitempty"`
+	// IsLinkedClone if true the VM is a linked clone of the box, instead of a full copy of it
+	IsLinkedClone   *bool                 `json:"linked_clone,omitempty"`
+	PortForwards    []PortForwardModel    `json:"forwarded_ports,omitempty"`
+	PrivateNetworks []PrivateNetworkModel `json:"private_networks,omitempty"`
+	SyncedFolders   []SyncedFolderModel   `json:"synced_folders,omitempty"`
+	// Customizations are provider specific settings, by name: VBoxManage modifyvm options with VirtualBox,
+	// .vmx entries with VMware, prlctl set options with Parallels and provider settings with libvirt
+	Customizations map[string]string `json:"customize,omitempty"`
+}
+
+// HostModel is the hardware of the host machine
+type HostModel struct {
+	CPUs int
+	// MemoryMB is the size of the RAM, in MB
+	MemoryMB int
+}
+
+// DetectHost returns the number of CPUs and the RAM size of the host machine
+func DetectHost() (HostModel, error) {
+	host := HostModel{CPUs: runtime.NumCPU()}
+
+	out, err := cmdex.NewCommand("sysctl", "-n", "hw.memsize").RunAndReturnTrimmedOutput()
+	if err != nil {
+		return host, fmt.Errorf("Failed to get the memory size of the host, error: %s", err)
+	}
+	memSize, err := strconv.ParseInt(out, 10, 64)
+	if err != nil {
+		return host, fmt.Errorf("Failed to parse the memory size of the host (%s), error: %s", out, err)
+	}
+	host.MemoryMB = int(memSize / 1024 / 1024)
+	return host, nil
+}
+
+// DefaultSettings returns the default settings, sized from the host:
+// half of the host's CPUs and half of its RAM (rounded to GB), but at least 2 CPUs and 4096 MB
+func DefaultSettings(host HostModel) SettingsModel {
+	isLinkedClone := false
+	settings := SettingsModel{
+		BoxName:       DefaultBoxName,
+		CPUs:          host.CPUs / 2,
+		MemoryMB:      host.MemoryMB / 2 / 1024 * 1024,
+		IsLinkedClone: &isLinkedClone,
+	}
+	if settings.CPUs < minCPUs {
+		settings.CPUs = minCPUs
+	}
+	if settings.MemoryMB < minMemoryMB {
+		settings.MemoryMB = minMemoryMB
+	}
+	return settings
+}
+
+// Merge returns the settings, with the non zero values of other overriding the values of settings.
+// The port forwards, private networks and synced folders of other are added to the ones of settings.
+func (settings SettingsModel) Merge(other SettingsModel) SettingsModel {
+	if other.BoxName != "" {
+		settings.BoxName = other.BoxName
+	}
+	if other.BoxVersion != "" {
+		settings.BoxVersion = other.BoxVersion
+	}
+	if other.Hostname != "" {
+		settings.Hostname = other.Hostname
+	}
+	if other.MACAddress != "" {
+		settings.MACAddress = other.MACAddress
+	}
+	if other.CPUs != 0 {
+		settings.CPUs = other.CPUs
+	}
+	if other.MemoryMB != 0 {
+		settings.MemoryMB = other.MemoryMB
+	}
+	if other.IsLinkedClone != nil {
+		settings.IsLinkedClone = other.IsLinkedClone
+	}
+	settings.PortForwards = append(append([]PortForwardModel{}, settings.PortForwards...), other.PortForwards...)
+	settings.PrivateNetworks = append(append([]PrivateNetworkModel{}, settings.PrivateNetworks...), other.PrivateNetworks...)
+	settings.SyncedFolders = append(append([]SyncedFolderModel{}, settings.SyncedFolders...), other.SyncedFolders...)
+
+	customizations := map[string]string{}
+	for name, value := range settings.Customizations {
+		customizations[name] = value
+	}
+	for name, value := range other.Customizations {
+		customizations[name] = value
+	}
+	settings.Customizations = customizations
+	return settings
+}
+
+// NormalizePaths makes the relative host paths of the synced folders relative to baseDir
+func (settings *SettingsModel) NormalizePaths(baseDir string) {
+	for idx, aFolder := range settings.SyncedFolders {
+		if aFolder.Host != "" && !filepath.IsAbs(aFolder.Host) {
+			settings.SyncedFolders[idx].Host = filepath.Join(baseDir, aFolder.Host)
+		}
+	}
+}
+
+var (
+	hostnameRegexp             = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+	customizationNameRegexp    = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+	syncedFolderTypeNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+func validatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port (%d), should be between 1 and 65535", port)
+	}
+	return nil
+}
+
+// Validate ...
+func (settings SettingsModel) Validate() error {
+	if settings.BoxName == "" {
+		return fmt.Errorf("no box name defined")
+	}
+	if settings.Hostname != "" && !hostnameRegexp.MatchString(settings.Hostname) {
+		return fmt.Errorf("invalid hostname (%s), only letters, numbers and '-' are allowed", settings.Hostname)
+	}
+	if settings.MACAddress != "" {
+		if mac, err := net.ParseMAC(settings.MACAddress); err != nil || len(mac) != 6 {
+			return fmt.Errorf("invalid MAC address (%s), should be in the format: 08:00:27:1a:2b:3c", settings.MACAddress)
+		}
+	}
+	if settings.CPUs < 1 || settings.CPUs > 32 {
+		return fmt.Errorf("invalid number of CPUs (%d), should be between 1 and 32", settings.CPUs)
+	}
+	if settings.MemoryMB < 2048 {
+		return fmt.Errorf("invalid memory size (%d MB), should be at least 2048 MB", settings.MemoryMB)
+	}
+
+	hostPorts := map[string]bool{}
+	portForwardIDs := map[string]bool{}
+	for _, aPortForward := range settings.PortForwards {
+		if aPortForward.ID != "" {
+			if portForwardIDs[aPortForward.ID] {
+				return fmt.Errorf("more than one port forward has the ID (%s)", aPortForward.ID)
+			}
+			portForwardIDs[aPortForward.ID] = true
+		}
+		if err := validatePort(aPortForward.Host); err != nil {
+			return fmt.Errorf("invalid port forward, %s", err)
+		}
+		if err := validatePort(aPortForward.Guest); err != nil {
+			return fmt.Errorf("invalid port forward, %s", err)
+		}
+		if aPortForward.Protocol != "" && aPortForward.Protocol != "tcp" && aPortForward.Protocol != "udp" {
+			return fmt.Errorf("invalid port forward protocol (%s), should be one of: tcp, udp", aPortForward.Protocol)
+		}
+		hostPort := fmt.Sprintf("%d/%s", aPortForward.Host, aPortForward.Protocol)
+		if hostPorts[hostPort] {
+			return fmt.Errorf("the host port (%d) is forwarded more than once", aPortForward.Host)
+		}
+		hostPorts[hostPort] = true
+	}
+	for _, aNetwork := range settings.PrivateNetworks {
+		if aNetwork.IP != privateNetworkDHCP && net.ParseIP(aNetwork.IP) == nil {
+			return fmt.Errorf("invalid private network IP address (%s), should be an IP address or %s", aNetwork.IP, privateNetworkDHCP)
+		}
+	}
+	for _, aFolder := range settings.SyncedFolders {
+		if aFolder.Host == "" || aFolder.Guest == "" {
+			return fmt.Errorf("invalid synced folder, both the host and the guest path have to be defined")
+		}
+		if aFolder.Type != "" && !syncedFolderTypeNameRegexp.MatchString(aFolder.Type) {
+			return fmt.Errorf("invalid synced folder type (%s)", aFolder.Type)
+		}
+	}
+	for name := range settings.Customizations {
+		if !customizationNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid customization name (%s), only letters, numbers, '.', ':', '_' and '-' are allowed", name)
+		}
+	}
+	return nil
+}
+
+// ParsePortForward parses a port forward, in the format: HOST_PORT:GUEST_PORT[/PROTOCOL], e.g. 8080:80 or 5353:53/udp
+func ParsePortForward(value string) (PortForwardModel, error) {
+	ports, protocol := value, ""
+	if idx := strings.LastIndex(value, "/"); idx >= 0 {
+		ports, protocol = value[:idx], value[idx+1:]
+	}
+	split := strings.Split(ports, ":")
+	if len(split) != 2 {
+		return PortForwardModel{}, fmt.Errorf("Invalid port forward (%s), should be in the format: HOST_PORT:GUEST_PORT[/PROTOCOL]", value)
+	}
+	hostPort, err := strconv.Atoi(split[0])
+	if err != nil {
+		return PortForwardModel{}, fmt.Errorf("Invalid host port in port forward (%s)", value)
+	}
+	guestPort, err := strconv.Atoi(split[1])
+	if err != nil {
+		return PortForwardModel{}, fmt.Errorf("Invalid guest port in port forward (%s)", value)
+	}
+	return PortForwardModel{Host: hostPort, Guest: guestPort, Protocol: protocol}, nil
+}
+
+// ParseSyncedFolder parses a synced folder, in the format: HOST_PATH:GUEST_PATH[:TYPE], e.g. ./src:/Users/vagrant/src:rsync
+func ParseSyncedFolder(value string) (SyncedFolderModel, error) {
+	split := strings.Split(value, ":")
+	if len(split) != 2 && len(split) != 3 {
+		return SyncedFolderModel{}, fmt.Errorf("Invalid synced folder (%s), should be in the format: HOST_PATH:GUEST_PATH[:TYPE]", value)
+	}
+	folder := SyncedFolderModel{Host: split[0], Guest: split[1]}
+	if len(split) == 3 {
+		folder.Type = split[2]
+	}
+	return folder, nil
+}
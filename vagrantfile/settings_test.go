@@ -0,0 +1,124 @@
+package vagrantfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSettings(t *testing.T) {
+	t.Log("half of the host")
+	{
+		settings := DefaultSettings(HostModel{CPUs: 12, MemoryMB: 32768})
+		require.Equal(t, DefaultBoxName, settings.BoxName)
+		require.Equal(t, 6, settings.CPUs)
+		require.Equal(t, 16384, settings.MemoryMB)
+		require.False(t, *settings.IsLinkedClone)
+		require.NoError(t, settings.Validate())
+	}
+
+	t.Log("memory rounded to GB")
+	{
+		require.Equal(t, 9216, DefaultSettings(HostModel{CPUs: 8, MemoryMB: 19000}).MemoryMB)
+	}
+
+	t.Log("minimum size, e.g. if the host could not be detected")
+	{
+		settings := DefaultSettings(HostModel{})
+		require.Equal(t, 2, settings.CPUs)
+		require.Equal(t, 4096, settings.MemoryMB)
+	}
+}
+
+func TestSettingsModel_Merge(t *testing.T) {
+	isLinkedClone := true
+	base := SettingsModel{
+		BoxName:        "base",
+		CPUs:           2,
+		PortForwards:   []PortForwardModel{{Host: 8080, Guest: 80}},
+		Customizations: map[string]string{"vram": "64", "audio": "none"},
+	}
+	merged := base.Merge(SettingsModel{
+		CPUs:           4,
+		IsLinkedClone:  &isLinkedClone,
+		PortForwards:   []PortForwardModel{{Host: 2222, Guest: 22}},
+		Customizations: map[string]string{"vram": "128"},
+	})
+	require.Equal(t, SettingsModel{
+		BoxName:         "base",
+		CPUs:            4,
+		IsLinkedClone:   &isLinkedClone,
+		PortForwards:    []PortForwardModel{{Host: 8080, Guest: 80}, {Host: 2222, Guest: 22}},
+		PrivateNetworks: []PrivateNetworkModel{},
+		SyncedFolders:   []SyncedFolderModel{},
+		Customizations:  map[string]string{"vram": "128", "audio": "none"},
+	}, merged)
+
+	t.Log("the original settings are not changed")
+	{
+		require.Equal(t, "64", base.Customizations["vram"])
+		require.Equal(t, 1, len(base.PortForwards))
+	}
+}
+
+func TestSettingsModel_Validate(t *testing.T) {
+	valid := DefaultSettings(HostModel{})
+	require.NoError(t, valid.Validate())
+
+	for _, invalid := range []SettingsModel{
+		valid.Merge(SettingsModel{CPUs: 64}),
+		valid.Merge(SettingsModel{Hostname: "replica_01"}),
+		valid.Merge(SettingsModel{MACAddress: "08:00:27:1a:2b"}),
+		valid.Merge(SettingsModel{PortForwards: []PortForwardModel{{ID: "ssh", Host: 2222, Guest: 22}, {ID: "ssh", Host: 2223, Guest: 22}}}),
+		valid.Merge(SettingsModel{MemoryMB: 1024}),
+		valid.Merge(SettingsModel{PortForwards: []PortForwardModel{{Host: 0, Guest: 22}}}),
+		valid.Merge(SettingsModel{PortForwards: []PortForwardModel{{Host: 2222, Guest: 22, Protocol: "sctp"}}}),
+		valid.Merge(SettingsModel{PortForwards: []PortForwardModel{{Host: 2222, Guest: 22}, {Host: 2222, Guest: 80}}}),
+		valid.Merge(SettingsModel{PrivateNetworks: []PrivateNetworkModel{{IP: "192.168.500.1"}}}),
+		valid.Merge(SettingsModel{SyncedFolders: []SyncedFolderModel{{Host: "/src"}}}),
+		valid.Merge(SettingsModel{SyncedFolders: []SyncedFolderModel{{Host: "/src", Guest: "/src", Type: "rsync; rm"}}}),
+		valid.Merge(SettingsModel{Customizations: map[string]string{`vram"]`: "128"}}),
+	} {
+		require.Error(t, invalid.Validate())
+	}
+}
+
+func TestParsePortForward(t *testing.T) {
+	portForward, err := ParsePortForward("8080:80")
+	require.NoError(t, err)
+	require.Equal(t, PortForwardModel{Host: 8080, Guest: 80}, portForward)
+
+	portForward, err = ParsePortForward("5353:53/udp")
+	require.NoError(t, err)
+	require.Equal(t, PortForwardModel{Host: 5353, Guest: 53, Protocol: "udp"}, portForward)
+
+	for _, invalid := range []string{"8080", "8080:80:22", "http:80", "8080:"} {
+		_, err := ParsePortForward(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParseSyncedFolder(t *testing.T) {
+	folder, err := ParseSyncedFolder("./src:/Users/vagrant/src")
+	require.NoError(t, err)
+	require.Equal(t, SyncedFolderModel{Host: "./src", Guest: "/Users/vagrant/src"}, folder)
+
+	folder, err = ParseSyncedFolder("./src:/Users/vagrant/src:rsync")
+	require.NoError(t, err)
+	require.Equal(t, SyncedFolderModel{Host: "./src", Guest: "/Users/vagrant/src", Type: "rsync"}, folder)
+
+	_, err = ParseSyncedFolder("./src")
+	require.Error(t, err)
+}
+
+func TestSettingsModel_NormalizePaths(t *testing.T) {
+	settings := SettingsModel{SyncedFolders: []SyncedFolderModel{
+		{Host: "src", Guest: "/src"},
+		{Host: "/abs", Guest: "/abs"},
+	}}
+	settings.NormalizePaths("/config")
+	require.Equal(t, []SyncedFolderModel{
+		{Host: "/config/src", Guest: "/src"},
+		{Host: "/abs", Guest: "/abs"},
+	}, settings.SyncedFolders)
+}
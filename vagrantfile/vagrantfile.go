@@ -0,0 +1,66 @@
+package vagrantfile
+
+import (
+	"text/template"
+
+	"github.com/bitrise-io/go-utils/templateutil"
+	"github.com/bitrise-io/replica/hypervisor"
+)
+
+const vagrantfileTemplate = `# -*- mode: ruby -*-
+# vi: set ft=ruby :
+
+Vagrant.configure("2") do |config|
+  config.vm.box = {{ ruby .Settings.BoxName }}
+{{- if .Settings.BoxVersion }}
+  config.vm.box_version = {{ ruby .Settings.BoxVersion }}
+{{- end }}
+{{- if .Settings.Hostname }}
+  config.vm.hostname = {{ ruby .Settings.Hostname }}
+{{- end }}
+  config.vm.synced_folder ".", "/vagrant", :disabled => true
+{{- range .Settings.SyncedFolders }}
+  config.vm.synced_folder {{ ruby .Host }}, {{ ruby .Guest }}{{ if .Type }}, type: {{ ruby .Type }}{{ end }}
+{{- end }}
+{{- range .Settings.PortForwards }}
+  config.vm.network "forwarded_port", guest: {{ .Guest }}, host: {{ .Host }}{{ if .Protocol }}, protocol: {{ ruby .Protocol }}{{ end }}{{ if .ID }}, id: {{ ruby .ID }}{{ end }}
+{{- end }}
+{{- range .Settings.PrivateNetworks }}
+  config.vm.network "private_network", {{ if eq .IP "dhcp" }}type: "dhcp"{{ else }}ip: {{ ruby .IP }}{{ end }}
+{{- end }}
+  config.ssh.insert_key = false
+{{- if .PrivateKeyFileName }}
+  config.ssh.private_key_path = {{ ruby .PrivateKeyFileName }}
+{{- end }}
+
+  config.vm.provider "{{ .VagrantProvider }}" do |v|
+{{ .ProviderConfiguration }}  end
+end
+`
+
+// Render generates the Vagrantfile of the VM. privateKeyFileName is the SSH private key,
+// relative to the Vagrantfile, or empty to use vagrant's insecure key.
+func Render(provider hypervisor.Provider, settings SettingsModel, privateKeyFileName string) (string, error) {
+	type VagrantfileInventory struct {
+		Settings              SettingsModel
+		PrivateKeyFileName    string
+		VagrantProvider       string
+		ProviderConfiguration string
+	}
+	inv := VagrantfileInventory{
+		Settings:           settings,
+		PrivateKeyFileName: privateKeyFileName,
+		VagrantProvider:    provider.VagrantProvider(),
+		ProviderConfiguration: provider.VagrantfileProviderConfig(hypervisor.VagrantVMModel{
+			CPUs:           settings.CPUs,
+			MemoryMB:       settings.MemoryMB,
+			IsLinkedClone:  settings.IsLinkedClone != nil && *settings.IsLinkedClone,
+			MACAddress:     settings.MACAddress,
+			Customizations: settings.Customizations,
+		}),
+	}
+
+	return templateutil.EvaluateTemplateStringToString(vagrantfileTemplate, inv, template.FuncMap{
+		"ruby": hypervisor.RubyString,
+	})
+}
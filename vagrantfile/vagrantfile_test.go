@@ -0,0 +1,104 @@
+package vagrantfile
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/replica/hypervisor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	defaults := DefaultSettings(HostModel{})
+
+	t.Log("defaults, vagrant insecure key")
+	{
+		result, err := Render(hypervisor.VirtualBox{}, defaults, "")
+		require.NoError(t, err)
+		require.Equal(t, `# -*- mode: ruby -*-
+# vi: set ft=ruby :
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "bitrise-replica-macos"
+  config.vm.synced_folder ".", "/vagrant", :disabled => true
+  config.ssh.insert_key = false
+
+  config.vm.provider "virtualbox" do |v|
+    v.linked_clone = false
+    v.cpus = 2
+    v.memory = 4096
+  end
+end
+`, result)
+	}
+
+	t.Log("box specific private key")
+	{
+		result, err := Render(hypervisor.VMware{}, defaults, "replica_private_key")
+		require.NoError(t, err)
+		require.Equal(t, `# -*- mode: ruby -*-
+# vi: set ft=ruby :
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "bitrise-replica-macos"
+  config.vm.synced_folder ".", "/vagrant", :disabled => true
+  config.ssh.insert_key = false
+  config.ssh.private_key_path = "replica_private_key"
+
+  config.vm.provider "vmware_desktop" do |v|
+    v.linked_clone = false
+    v.vmx["numvcpus"] = "2"
+    v.vmx["memsize"] = "4096"
+  end
+end
+`, result)
+	}
+
+	t.Log("every setting")
+	{
+		isLinkedClone := true
+		settings := defaults.Merge(SettingsModel{
+			BoxName:       "macos-sierra",
+			BoxVersion:    ">= 1.2",
+			Hostname:      "replica-01",
+			MACAddress:    "08:00:27:1a:2b:3c",
+			CPUs:          4,
+			MemoryMB:      8192,
+			IsLinkedClone: &isLinkedClone,
+			PortForwards: []PortForwardModel{
+				{Host: 8080, Guest: 80},
+				{Host: 5353, Guest: 53, Protocol: "udp"},
+				{ID: "ssh", Host: 2301, Guest: 22},
+			},
+			PrivateNetworks: []PrivateNetworkModel{{IP: "192.168.50.4"}, {IP: "dhcp"}},
+			SyncedFolders:   []SyncedFolderModel{{Host: "/Users/ci/src", Guest: "/Users/vagrant/src", Type: "rsync"}},
+			Customizations:  map[string]string{"vram": "128"},
+		})
+		result, err := Render(hypervisor.VirtualBox{}, settings, "")
+		require.NoError(t, err)
+		require.Equal(t, `# -*- mode: ruby -*-
+# vi: set ft=ruby :
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "macos-sierra"
+  config.vm.box_version = ">= 1.2"
+  config.vm.hostname = "replica-01"
+  config.vm.synced_folder ".", "/vagrant", :disabled => true
+  config.vm.synced_folder "/Users/ci/src", "/Users/vagrant/src", type: "rsync"
+  config.vm.network "forwarded_port", guest: 80, host: 8080
+  config.vm.network "forwarded_port", guest: 53, host: 5353, protocol: "udp"
+  config.vm.network "forwarded_port", guest: 22, host: 2301, id: "ssh"
+  config.vm.network "private_network", ip: "192.168.50.4"
+  config.vm.network "private_network", type: "dhcp"
+  config.ssh.insert_key = false
+
+  config.vm.provider "virtualbox" do |v|
+    v.linked_clone = true
+    v.cpus = 4
+    v.memory = 8192
+    v.customize ["modifyvm", :id, "--macaddress1", "0800271A2B3C"]
+    v.customize ["modifyvm", :id, "--vram", "128"]
+  end
+end
+`, result)
+	}
+}
@@ -0,0 +1,78 @@
+package vmsync
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Push syncs from the host into the VM
+	Push = "push"
+	// Pull syncs from the VM to the host
+	Pull = "pull"
+)
+
+// OptionsModel are the options of an rsync between the host and a VM
+type OptionsModel struct {
+	// Direction is Push or Pull
+	Direction string
+	// Includes are rsync include patterns, the files matching them are never excluded
+	Includes []string
+	// Excludes are rsync exclude patterns
+	Excludes []string
+	// IsDelete removes the files of the destination, which do not exist in the source
+	IsDelete bool
+	// IsChecksum compares the files by checksum, instead of by size and modification time
+	IsChecksum bool
+	// BandwidthLimit is the maximum transfer rate in KB/s, 0 means no limit
+	BandwidthLimit int
+}
+
+// Validate ...
+func (opts OptionsModel) Validate() error {
+	if opts.Direction != Push && opts.Direction != Pull {
+		return fmt.Errorf("Invalid sync direction (%s), should be %s or %s", opts.Direction, Push, Pull)
+	}
+	if opts.BandwidthLimit < 0 {
+		return errors.New("Invalid bandwidth limit, should be a positive number (KB/s)")
+	}
+	return nil
+}
+
+// RsyncArgs returns the arguments of rsync, which syncs between the path of the host and the path of the VM,
+// in the direction of the options. The VM is connected to as the host of the SSH config.
+// The paths are passed to rsync as they are, so a trailing / syncs the content of a directory,
+// and --protect-args keeps the remote shell of the VM from splitting them (e.g. at spaces).
+func RsyncArgs(opts OptionsModel, sshConfigPath, sshHost, hostPath, vmPath string) []string {
+	args := []string{"-avhP", "--protect-args"}
+	if opts.IsChecksum {
+		args = append(args, "--checksum")
+	}
+	if opts.IsDelete {
+		args = append(args, "--delete")
+	}
+	if opts.BandwidthLimit > 0 {
+		args = append(args, fmt.Sprintf("--bwlimit=%d", opts.BandwidthLimit))
+	}
+	// rsync applies the first matching pattern, so the includes have to precede the excludes
+	for _, anInclude := range opts.Includes {
+		args = append(args, "--include="+anInclude)
+	}
+	for _, anExclude := range opts.Excludes {
+		args = append(args, "--exclude="+anExclude)
+	}
+	// rsync splits the remote shell command into words, honoring the quotes
+	args = append(args, "-e", "ssh -F "+quoteShellWord(sshConfigPath))
+
+	remotePath := sshHost + ":" + vmPath
+	if opts.Direction == Pull {
+		return append(args, remotePath, hostPath)
+	}
+	return append(args, hostPath, remotePath)
+}
+
+// quoteShellWord single quotes the word, for rsync (and sh) to keep it as one word
+func quoteShellWord(word string) string {
+	return "'" + strings.Replace(word, "'", `'"'"'`, -1) + "'"
+}
@@ -0,0 +1,55 @@
+package vmsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, OptionsModel{Direction: Push}.Validate())
+	require.NoError(t, OptionsModel{Direction: Pull, BandwidthLimit: 1024}.Validate())
+	require.Error(t, OptionsModel{}.Validate())
+	require.Error(t, OptionsModel{Direction: "both"}.Validate())
+	require.Error(t, OptionsModel{Direction: Push, BandwidthLimit: -1}.Validate())
+}
+
+func TestRsyncArgs(t *testing.T) {
+	t.Log("push")
+	{
+		args := RsyncArgs(OptionsModel{Direction: Push}, "/tmp/ssh.config", "default", "src/", "/Users/vagrant/src/")
+		require.Equal(t, []string{"-avhP", "--protect-args", "-e", "ssh -F '/tmp/ssh.config'", "src/", "default:/Users/vagrant/src/"}, args)
+	}
+
+	t.Log("pull, with every option")
+	{
+		opts := OptionsModel{
+			Direction:      Pull,
+			Includes:       []string{"*.log"},
+			Excludes:       []string{"*", ".git"},
+			IsDelete:       true,
+			IsChecksum:     true,
+			BandwidthLimit: 1024,
+		}
+		args := RsyncArgs(opts, "/tmp/ssh.config", "replica-01", "logs/", "/Users/vagrant/logs/")
+		require.Equal(t, []string{
+			"-avhP", "--protect-args", "--checksum", "--delete", "--bwlimit=1024",
+			"--include=*.log", "--exclude=*", "--exclude=.git",
+			"-e", "ssh -F '/tmp/ssh.config'",
+			"replica-01:/Users/vagrant/logs/", "logs/",
+		}, args)
+	}
+
+	t.Log("paths with spaces")
+	{
+		args := RsyncArgs(OptionsModel{Direction: Push}, "/Users/ci/My VMs/ssh.config", "default", "My Project/", "/Users/vagrant/My Project/")
+		require.Equal(t, []string{
+			"-avhP", "--protect-args",
+			"-e", "ssh -F '/Users/ci/My VMs/ssh.config'",
+			"My Project/", "default:/Users/vagrant/My Project/",
+		}, args)
+
+		args = RsyncArgs(OptionsModel{Direction: Push}, "/Users/ci/Bob's VMs/ssh.config", "default", "src/", "/Users/vagrant/src/")
+		require.Equal(t, `ssh -F '/Users/ci/Bob'"'"'s VMs/ssh.config'`, args[3])
+	}
+}
@@ -0,0 +1,111 @@
+package xcode
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// AppsDir is the directory of the Xcode.apps in the VM
+const AppsDir = "/Applications"
+
+// ListAppsScript prints the selected developer directory and the Xcode.apps of the VM (see: ParseAppList)
+var ListAppsScript = `printf 'selected\t%s\n' "$(xcode-select --print-path 2>/dev/null)"
+` + printAppsScript(AppsDir+"/Xcode*.app")
+
+var versionRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*(-[A-Za-z0-9.]+)?$`)
+
+// AppModel is an Xcode.app in the VM
+type AppModel struct {
+	VersionModel
+	Path string `json:"path"`
+	// IsSelected is true if the Xcode.app is the default one, selected with xcode-select
+	IsSelected bool `json:"selected"`
+}
+
+// ValidateVersion ...
+func ValidateVersion(version string) error {
+	if !versionRegexp.MatchString(version) {
+		return fmt.Errorf("Invalid Xcode version: %s", version)
+	}
+	return nil
+}
+
+// VersionedAppPath returns the path of the Xcode.app of the version in the VM, e.g. /Applications/Xcode-8.3.2.app
+func VersionedAppPath(version string) string {
+	return filepath.Join(AppsDir, "Xcode-"+version+".app")
+}
+
+// DeveloperDir returns the developer directory of the Xcode.app, which xcode-select selects
+func DeveloperDir(appPath string) string {
+	return filepath.Join(appPath, "Contents", "Developer")
+}
+
+// shellQuote quotes the value for the shell of the VM
+func shellQuote(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
+
+// printAppsScript prints an app line, with the path, version and build, of every Xcode.app matching the pattern
+func printAppsScript(pattern string) string {
+	return `for app in ` + pattern + `; do
+  plist="$app/Contents/version.plist"
+  [ -f "$plist" ] || continue
+  printf 'app\t%s\t%s\t%s\n' "$app" \
+    "$(/usr/libexec/PlistBuddy -c 'Print :CFBundleShortVersionString' "$plist" 2>/dev/null)" \
+    "$(/usr/libexec/PlistBuddy -c 'Print :ProductBuildVersion' "$plist" 2>/dev/null)"
+done`
+}
+
+// SelectScript selects the Xcode.app as the default one of the VM
+func SelectScript(appPath string) string {
+	return "sudo xcode-select --switch " + shellQuote(DeveloperDir(appPath))
+}
+
+// RemoveScript removes the Xcode.app from the VM
+func RemoveScript(appPath string) string {
+	return "sudo rm -rf " + shellQuote(appPath)
+}
+
+// ParseAppList parses the output of ListAppsScript, returns the Xcode.apps sorted by path
+func ParseAppList(output string) ([]AppModel, error) {
+	selectedDir := ""
+	apps := []AppModel{}
+	for _, aLine := range strings.Split(output, "\n") {
+		fields := strings.Split(strings.TrimRight(aLine, "\r"), "\t")
+		switch fields[0] {
+		case "selected":
+			if len(fields) > 1 {
+				selectedDir = filepath.Clean(fields[1])
+			}
+		case "app":
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("Invalid Xcode.app line: %s", aLine)
+			}
+			app := AppModel{Path: fields[1], VersionModel: VersionModel{Version: fields[2]}}
+			if len(fields) > 3 {
+				app.Build = fields[3]
+			}
+			apps = append(apps, app)
+		}
+	}
+	for idx := range apps {
+		apps[idx].IsSelected = selectedDir != "" && (selectedDir == DeveloperDir(apps[idx].Path) || selectedDir == apps[idx].Path)
+	}
+	sort.SliceStable(apps, func(i, j int) bool {
+		return apps[i].Path < apps[j].Path
+	})
+	return apps, nil
+}
+
+// FindApp returns the Xcode.app by version or by path
+func FindApp(apps []AppModel, versionOrPath string) (AppModel, bool) {
+	for _, anApp := range apps {
+		if anApp.Path == filepath.Clean(versionOrPath) || anApp.Version == versionOrPath {
+			return anApp, true
+		}
+	}
+	return AppModel{}, false
+}
@@ -0,0 +1,66 @@
+package xcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedAppPath(t *testing.T) {
+	require.Equal(t, "/Applications/Xcode-8.3.2.app", VersionedAppPath("8.3.2"))
+	require.Equal(t, "/Applications/Xcode-8.3.2.app/Contents/Developer", DeveloperDir(VersionedAppPath("8.3.2")))
+}
+
+func TestValidateVersion(t *testing.T) {
+	require.NoError(t, ValidateVersion("8.3.2"))
+	require.NoError(t, ValidateVersion("9.0-beta.2"))
+	require.Error(t, ValidateVersion(""))
+	require.Error(t, ValidateVersion("8.3/2"))
+}
+
+func TestScripts(t *testing.T) {
+	require.Equal(t, "sudo xcode-select --switch '/Applications/Xcode-8.3.2.app/Contents/Developer'", SelectScript("/Applications/Xcode-8.3.2.app"))
+	require.Equal(t, `sudo rm -rf '/Applications/Xcode'\''s.app'`, RemoveScript("/Applications/Xcode's.app"))
+}
+
+func TestParseAppList(t *testing.T) {
+	output := "selected\t/Applications/Xcode-8.3.2.app/Contents/Developer\r\n" +
+		"app\t/Applications/Xcode-8.3.2.app\t8.3.2\t8E2002\r\n" +
+		"app\t/Applications/Xcode-8.2.1.app\t8.2.1\t8C1002\r\n" +
+		"app\t/Applications/Xcode.app\t7.3.1\t\r\n"
+
+	apps, err := ParseAppList(output)
+	require.NoError(t, err)
+	require.Equal(t, []AppModel{
+		{Path: "/Applications/Xcode-8.2.1.app", VersionModel: VersionModel{Version: "8.2.1", Build: "8C1002"}},
+		{Path: "/Applications/Xcode-8.3.2.app", VersionModel: VersionModel{Version: "8.3.2", Build: "8E2002"}, IsSelected: true},
+		{Path: "/Applications/Xcode.app", VersionModel: VersionModel{Version: "7.3.1"}},
+	}, apps)
+
+	t.Log("find by version or path")
+	{
+		app, isFound := FindApp(apps, "8.2.1")
+		require.True(t, isFound)
+		require.Equal(t, "/Applications/Xcode-8.2.1.app", app.Path)
+
+		app, isFound = FindApp(apps, "/Applications/Xcode.app/")
+		require.True(t, isFound)
+		require.Equal(t, "7.3.1", app.Version)
+
+		_, isFound = FindApp(apps, "9.0")
+		require.False(t, isFound)
+	}
+
+	t.Log("no Xcode.apps, the command line tools are selected")
+	{
+		apps, err := ParseAppList("selected\t/Library/Developer/CommandLineTools\n")
+		require.NoError(t, err)
+		require.Equal(t, []AppModel{}, apps)
+	}
+
+	t.Log("invalid line")
+	{
+		_, err := ParseAppList("app\t/Applications/Xcode.app\n")
+		require.Error(t, err)
+	}
+}
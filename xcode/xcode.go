@@ -0,0 +1,53 @@
+package xcode
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/DHowett/go-plist"
+)
+
+// VersionModel is the version of an Xcode.app
+type VersionModel struct {
+	// Version is the marketing version, e.g. 8.3.2
+	Version string `json:"version"`
+	// Build is the build version, e.g. 8E2002
+	Build string `json:"build,omitempty"`
+}
+
+type versionPlistModel struct {
+	ShortVersion string `plist:"CFBundleShortVersionString"`
+	BuildVersion string `plist:"ProductBuildVersion"`
+}
+
+// VersionPlistPath returns the path of the version.plist of the Xcode.app
+func VersionPlistPath(appPath string) string {
+	return filepath.Join(appPath, "Contents", "version.plist")
+}
+
+// ParseVersionPlist parses the version.plist of an Xcode.app
+func ParseVersionPlist(content []byte) (VersionModel, error) {
+	var versionPlist versionPlistModel
+	if _, err := plist.Unmarshal(content, &versionPlist); err != nil {
+		return VersionModel{}, fmt.Errorf("Not a valid version.plist, error: %s", err)
+	}
+	if versionPlist.ShortVersion == "" {
+		return VersionModel{}, fmt.Errorf("No CFBundleShortVersionString defined in the version.plist")
+	}
+	return VersionModel{Version: versionPlist.ShortVersion, Build: versionPlist.BuildVersion}, nil
+}
+
+// ReadAppVersion reads the version of the Xcode.app from its version.plist
+func ReadAppVersion(appPath string) (VersionModel, error) {
+	pth := VersionPlistPath(appPath)
+	content, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return VersionModel{}, fmt.Errorf("Failed to read the version of the Xcode.app (%s), error: %s", appPath, err)
+	}
+	version, err := ParseVersionPlist(content)
+	if err != nil {
+		return VersionModel{}, fmt.Errorf("Failed to read the version of the Xcode.app (%s), error: %s", appPath, err)
+	}
+	return version, nil
+}
@@ -0,0 +1,64 @@
+package xcode
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const versionPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>BuildVersion</key>
+	<string>2</string>
+	<key>CFBundleShortVersionString</key>
+	<string>8.3.2</string>
+	<key>CFBundleVersion</key>
+	<string>12175</string>
+	<key>ProductBuildVersion</key>
+	<string>8E2002</string>
+	<key>ProjectName</key>
+	<string>IDEFrameworks</string>
+</dict>
+</plist>`
+
+func TestParseVersionPlist(t *testing.T) {
+	version, err := ParseVersionPlist([]byte(versionPlist))
+	require.NoError(t, err)
+	require.Equal(t, VersionModel{Version: "8.3.2", Build: "8E2002"}, version)
+
+	t.Log("no version")
+	{
+		_, err := ParseVersionPlist([]byte(`<plist version="1.0"><dict><key>ProjectName</key><string>IDEFrameworks</string></dict></plist>`))
+		require.Error(t, err)
+	}
+
+	t.Log("not a plist")
+	{
+		_, err := ParseVersionPlist([]byte("not a plist"))
+		require.Error(t, err)
+	}
+}
+
+func TestReadAppVersion(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	appPath := filepath.Join(tmpDir, "Xcode.app")
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "Contents"), 0755))
+	require.NoError(t, ioutil.WriteFile(VersionPlistPath(appPath), []byte(versionPlist), 0644))
+
+	version, err := ReadAppVersion(appPath)
+	require.NoError(t, err)
+	require.Equal(t, "8.3.2", version.Version)
+
+	_, err = ReadAppVersion(filepath.Join(tmpDir, "Missing.app"))
+	require.Error(t, err)
+}
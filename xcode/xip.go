@@ -0,0 +1,115 @@
+package xcode
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/cmdex"
+)
+
+// XipExtension is the extension of the Xcode archives, distributed by Apple
+const XipExtension = ".xip"
+
+// XipCheckScript succeeds if the xip tool is available in the VM, to expand the archives in the VM
+const XipCheckScript = "command -v xip"
+
+var xipNameRegexp = regexp.MustCompile(`^Xcode[_-]([0-9]+(\.[0-9]+)*)([_-].*)?\.xip$`)
+
+// IsXip returns true if the path is a .xip archive
+func IsXip(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), XipExtension)
+}
+
+// VersionOfXipName returns the version in the name of the .xip archive,
+// e.g. 8.3.2 of Xcode_8.3.2.xip, empty if the name has no version
+func VersionOfXipName(xipPath string) string {
+	matches := xipNameRegexp.FindStringSubmatch(filepath.Base(xipPath))
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
+}
+
+// IsSameVersion returns true if the versions only differ in their trailing zero components, e.g. 9 and 9.0
+func IsSameVersion(version, otherVersion string) bool {
+	trim := func(version string) string {
+		for strings.HasSuffix(version, ".0") {
+			version = strings.TrimSuffix(version, ".0")
+		}
+		return version
+	}
+	return trim(version) == trim(otherVersion)
+}
+
+// appleSoftwareSignatureStatus is the signature status of the archives signed by Apple
+const appleSoftwareSignatureStatus = "Status: signed Apple Software"
+
+// parseSignatureCheck parses the output of: pkgutil --check-signature
+// Only the archives signed by Apple are accepted, not the ones signed by any (e.g. an untrusted or an expired) certificate.
+func parseSignatureCheck(output string) error {
+	for _, aLine := range strings.Split(output, "\n") {
+		if strings.TrimSpace(aLine) == appleSoftwareSignatureStatus {
+			return nil
+		}
+	}
+	return fmt.Errorf("The archive is not signed by Apple, output: %s", output)
+}
+
+// VerifyXip checks the signature of the .xip archive
+func VerifyXip(xipPath string) error {
+	output, err := cmdex.NewCommand("pkgutil", "--check-signature", xipPath).RunAndReturnTrimmedCombinedOutput()
+	if err != nil {
+		return fmt.Errorf("Failed to verify the .xip archive (%s), output: %s, error: %s", xipPath, output, err)
+	}
+	if err := parseSignatureCheck(output); err != nil {
+		return fmt.Errorf("Invalid .xip archive (%s), error: %s", xipPath, err)
+	}
+	return nil
+}
+
+// ExpandXipScript expands the .xip archive of the VM into the (empty) directory, then removes the archive.
+// Prints the expanded Xcode.app, in the format of ParseAppList. The stderr of xip is kept, so that the output
+// of a failed script includes the error of xip (ParseAppList ignores its lines).
+func ExpandXipScript(xipPath, expandDir string) string {
+	return strings.Join([]string{
+		"set -e",
+		"rm -rf " + shellQuote(expandDir),
+		"mkdir -p " + shellQuote(expandDir),
+		"cd " + shellQuote(expandDir),
+		"xip --expand " + shellQuote(xipPath) + " >/dev/null",
+		"rm -f " + shellQuote(xipPath),
+		printAppsScript(shellQuote(expandDir) + "/*.app"),
+	}, "\n")
+}
+
+// InstallScript moves the expanded Xcode.app of the VM to its path (replacing the existing one), then removes the expand directory
+func InstallScript(expandedAppPath, appPath, expandDir string) string {
+	return strings.Join([]string{
+		"set -e",
+		"sudo rm -rf " + shellQuote(appPath),
+		"sudo mv " + shellQuote(expandedAppPath) + " " + shellQuote(appPath),
+		"rm -rf " + shellQuote(expandDir),
+	}, "\n")
+}
+
+// ExpandXip expands the .xip archive on the host into the directory, returns the path of the expanded Xcode.app
+func ExpandXip(xipPath, expandDir string) (string, error) {
+	absXipPath, err := filepath.Abs(xipPath)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get the absolute path of the .xip archive, error: %s", err)
+	}
+	if output, err := cmdex.NewCommand("xip", "--expand", absXipPath).SetDir(expandDir).RunAndReturnTrimmedCombinedOutput(); err != nil {
+		return "", fmt.Errorf("Failed to expand the .xip archive (%s), output: %s, error: %s", xipPath, output, err)
+	}
+
+	appPaths, err := filepath.Glob(filepath.Join(expandDir, "*.app"))
+	if err != nil {
+		return "", fmt.Errorf("Failed to search for the expanded Xcode.app, error: %s", err)
+	}
+	if len(appPaths) != 1 {
+		return "", fmt.Errorf("The .xip archive (%s) should contain exactly one app, found: %d", xipPath, len(appPaths))
+	}
+	return appPaths[0], nil
+}
@@ -0,0 +1,64 @@
+package xcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsXip(t *testing.T) {
+	require.True(t, IsXip("/Downloads/Xcode_8.3.2.xip"))
+	require.True(t, IsXip("Xcode.XIP"))
+	require.False(t, IsXip("/Applications/Xcode.app"))
+}
+
+func TestVersionOfXipName(t *testing.T) {
+	require.Equal(t, "8.3.2", VersionOfXipName("/Downloads/Xcode_8.3.2.xip"))
+	require.Equal(t, "9", VersionOfXipName("Xcode_9_beta_2.xip"))
+	require.Equal(t, "", VersionOfXipName("Xcode.xip"))
+	require.Equal(t, "", VersionOfXipName("Xcode_latest.xip"))
+}
+
+func TestIsSameVersion(t *testing.T) {
+	require.True(t, IsSameVersion("8.3.2", "8.3.2"))
+	require.True(t, IsSameVersion("9", "9.0"))
+	require.True(t, IsSameVersion("10.0.0", "10"))
+	require.False(t, IsSameVersion("8.3", "8.3.2"))
+	require.False(t, IsSameVersion("10", "1"))
+}
+
+func TestParseSignatureCheck(t *testing.T) {
+	require.NoError(t, parseSignatureCheck(`Package "Xcode_8.3.2.xip":
+   Status: signed Apple Software
+   Certificate Chain:
+    1. Software Signing`))
+
+	t.Log("not signed by Apple")
+	{
+		require.Error(t, parseSignatureCheck(`Package "Xcode_8.3.2.xip":
+   Status: no signature`))
+		require.Error(t, parseSignatureCheck(`Package "Xcode_8.3.2.xip":
+   Status: signed by untrusted certificate
+   Certificate Chain:
+    1. Developer ID Installer: Example Ltd`))
+		require.Error(t, parseSignatureCheck(`Package "Xcode_8.3.2.xip":
+   Status: signed by a certificate that has since expired
+   Certificate Chain:
+    1. Software Signing`))
+		require.Error(t, parseSignatureCheck(`Package "Xcode_8.3.2.xip":
+   Status: signed by a certificate trusted by Mac OS X
+   Certificate Chain:
+    1. Developer ID Installer: Example Ltd`))
+	}
+}
+
+func TestParseExpandXipScriptOutput(t *testing.T) {
+	script := ExpandXipScript("/tmp/Xcode_8.3.2.xip", "/tmp/replica-xip")
+	require.Contains(t, script, "xip --expand '/tmp/Xcode_8.3.2.xip' >/dev/null\n")
+	require.Contains(t, script, "for app in '/tmp/replica-xip'/*.app; do")
+
+	// the output of xip (on stderr) is mixed into the output of the script
+	apps, err := ParseAppList("xip: expanded items from \"/tmp/Xcode_8.3.2.xip\"\napp\t/tmp/replica-xip/Xcode.app\t8.3.2\t8E2002\n")
+	require.NoError(t, err)
+	require.Equal(t, []AppModel{{Path: "/tmp/replica-xip/Xcode.app", VersionModel: VersionModel{Version: "8.3.2", Build: "8E2002"}}}, apps)
+}